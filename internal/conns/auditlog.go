@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// auditLogRecord is a single entry in the AWS API call audit log.
+type auditLogRecord struct {
+	Time           time.Time `json:"time"`
+	Service        string    `json:"service"`
+	Operation      string    `json:"operation"`
+	Region         string    `json:"region"`
+	RequestID      string    `json:"request_id,omitempty"`
+	HTTPStatusCode int       `json:"http_status_code,omitempty"`
+	Attempts       int       `json:"attempts"`
+	DurationMS     int64     `json:"duration_ms"`
+	Error          string    `json:"error,omitempty"`
+}
+
+// auditLogger writes a JSON Lines record of every AWS API call.
+type auditLogger struct {
+	lock sync.Mutex
+	w    io.Writer
+}
+
+// newAuditLogger returns an auditLogger that appends to the file at the specified path, creating it if necessary.
+// The logger is owned by the AWSClient that it is configured for and must be closed when that client is no longer used.
+func newAuditLogger(path string) (*auditLogger, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	return &auditLogger{w: f}, nil
+}
+
+// close closes the logger's underlying file.
+func (l *auditLogger) close() error {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	if v, ok := l.w.(io.Closer); ok {
+		return v.Close()
+	}
+
+	return nil
+}
+
+func (l *auditLogger) log(ctx context.Context, record auditLogRecord) {
+	b, err := json.Marshal(record)
+	if err != nil {
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	// Audit logging is best effort and never fails an API call.
+	if _, err := l.w.Write(append(b, '\n')); err != nil {
+		tflog.Warn(ctx, "writing audit log record", map[string]any{
+			"error": err.Error(),
+		})
+	}
+}
+
+// addSDKv2Middleware adds the audit logging middleware to an AWS SDK for Go v2 middleware stack.
+// The middleware is added to the Initialize step so that a single record covers all retry attempts.
+func (l *auditLogger) addSDKv2Middleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("TFAuditLog", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		start := time.Now()
+
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		record := auditLogRecord{
+			Time:       start.UTC(),
+			Service:    awsmiddleware.GetServiceID(ctx),
+			Operation:  awsmiddleware.GetOperationName(ctx),
+			Region:     awsmiddleware.GetRegion(ctx),
+			Attempts:   1,
+			DurationMS: time.Since(start).Milliseconds(),
+		}
+
+		if v, ok := awsmiddleware.GetRequestIDMetadata(metadata); ok {
+			record.RequestID = v
+		}
+
+		if v, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok && v.Response != nil {
+			record.HTTPStatusCode = v.StatusCode
+		}
+
+		if v, ok := retry.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+			record.Attempts = len(v.Results)
+		}
+
+		if err != nil {
+			record.Error = err.Error()
+
+			var re *awshttp.ResponseError
+			if errors.As(err, &re) {
+				record.HTTPStatusCode = re.HTTPStatusCode()
+				record.RequestID = re.ServiceRequestID()
+			}
+		}
+
+		l.log(ctx, record)
+
+		return out, metadata, err
+	}), middleware.After)
+}
+
+// sdkv1CompleteHandler is an AWS SDK for Go v1 request handler that logs completed requests.
+func (l *auditLogger) sdkv1CompleteHandler() request_sdkv1.NamedHandler {
+	return request_sdkv1.NamedHandler{
+		Name: "TFAuditLog",
+		Fn: func(r *request_sdkv1.Request) {
+			record := auditLogRecord{
+				Time:       r.Time.UTC(),
+				Service:    r.ClientInfo.ServiceID,
+				RequestID:  r.RequestID,
+				Attempts:   r.RetryCount + 1,
+				DurationMS: time.Since(r.Time).Milliseconds(),
+			}
+
+			if r.Operation != nil {
+				record.Operation = r.Operation.Name
+			}
+
+			if r.Config.Region != nil {
+				record.Region = *r.Config.Region
+			}
+
+			if r.HTTPResponse != nil {
+				record.HTTPStatusCode = r.HTTPResponse.StatusCode
+			}
+
+			if r.Error != nil {
+				record.Error = r.Error.Error()
+			}
+
+			l.log(r.Context(), record)
+		},
+	}
+}
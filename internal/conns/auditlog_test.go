@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func TestAuditLoggerSDKv2(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		statusCode     int
+		body           string
+		withoutRetries bool
+		expectedError  bool
+		expectedRecord auditLogRecord
+	}{
+		"success": {
+			statusCode: http.StatusOK,
+			body: `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::123456789012:user/test</Arn>
+    <UserId>AIDACKCEVSQ6C2EXAMPLE</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+</GetCallerIdentityResponse>`,
+			expectedRecord: auditLogRecord{
+				Service:        "STS",
+				Operation:      "GetCallerIdentity",
+				Region:         "us-west-2", //lintignore:AWSAT003
+				RequestID:      "test-request-id",
+				HTTPStatusCode: http.StatusOK,
+				Attempts:       1,
+			},
+		},
+		"success without retries": {
+			statusCode:     http.StatusOK,
+			withoutRetries: true,
+			body: `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>arn:aws:iam::123456789012:user/test</Arn>
+    <UserId>AIDACKCEVSQ6C2EXAMPLE</UserId>
+    <Account>123456789012</Account>
+  </GetCallerIdentityResult>
+</GetCallerIdentityResponse>`,
+			expectedRecord: auditLogRecord{
+				Service:        "STS",
+				Operation:      "GetCallerIdentity",
+				Region:         "us-west-2", //lintignore:AWSAT003
+				RequestID:      "test-request-id",
+				HTTPStatusCode: http.StatusOK,
+				Attempts:       1,
+			},
+		},
+		"error": {
+			statusCode: http.StatusForbidden,
+			body: `<ErrorResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <Error>
+    <Type>Sender</Type>
+    <Code>AccessDenied</Code>
+    <Message>Access denied</Message>
+  </Error>
+  <RequestId>test-request-id</RequestId>
+</ErrorResponse>`,
+			expectedError: true,
+			expectedRecord: auditLogRecord{
+				Service:        "STS",
+				Operation:      "GetCallerIdentity",
+				Region:         "us-west-2", //lintignore:AWSAT003
+				RequestID:      "test-request-id",
+				HTTPStatusCode: http.StatusForbidden,
+				Attempts:       1,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var buf bytes.Buffer
+			logger := &auditLogger{w: &buf}
+
+			apiOptions := []func(*middleware.Stack) error{logger.addSDKv2Middleware}
+			if testCase.withoutRetries {
+				apiOptions = append(apiOptions, func(stack *middleware.Stack) error {
+					_, err := stack.Finalize.Remove("Retry")
+					return err
+				})
+			}
+
+			client := sts_sdkv2.NewFromConfig(aws_sdkv2.Config{
+				Region:      "us-west-2", //lintignore:AWSAT003
+				Credentials: credentials.NewStaticCredentialsProvider("AKID", "SECRET", ""),
+				HTTPClient: smithyhttp.ClientDoFunc(func(r *http.Request) (*http.Response, error) {
+					return &http.Response{
+						StatusCode: testCase.statusCode,
+						Header:     http.Header{"X-Amzn-Requestid": []string{"test-request-id"}},
+						Body:       io.NopCloser(strings.NewReader(testCase.body)),
+					}, nil
+				}),
+				APIOptions: apiOptions,
+			})
+
+			_, err := client.GetCallerIdentity(context.Background(), &sts_sdkv2.GetCallerIdentityInput{})
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("GetCallerIdentity error = %v, expected error: %t", err, want)
+			}
+
+			lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+			if got, want := len(lines), 1; got != want {
+				t.Fatalf("got %d records, expected %d", got, want)
+			}
+
+			var record auditLogRecord
+			if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+				t.Fatalf("unmarshaling record: %s", err)
+			}
+
+			if record.Time.IsZero() {
+				t.Error("expected record time to be set")
+			}
+			if got, want := record.Error != "", testCase.expectedError; got != want {
+				t.Errorf("got record error %q, expected error: %t", record.Error, want)
+			}
+
+			record.Time = testCase.expectedRecord.Time
+			record.DurationMS = testCase.expectedRecord.DurationMS
+			record.Error = testCase.expectedRecord.Error
+
+			if record != testCase.expectedRecord {
+				t.Errorf("got record %+v, expected %+v", record, testCase.expectedRecord)
+			}
+		})
+	}
+}
+
+func TestAuditLoggerClose(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	logger, err := newAuditLogger(path)
+	if err != nil {
+		t.Fatalf("opening audit log: %s", err)
+	}
+
+	client := &AWSClient{auditLogger: logger}
+
+	logger.log(context.Background(), auditLogRecord{Service: "STS", Operation: "GetCallerIdentity", Attempts: 1})
+
+	if err := client.Close(); err != nil {
+		t.Fatalf("closing client: %s", err)
+	}
+
+	if client.auditLogger != nil {
+		t.Error("expected audit logger to be released")
+	}
+
+	if err := client.Close(); err != nil {
+		t.Errorf("closing client again: %s", err)
+	}
+
+	if _, err := logger.w.Write(nil); !errors.Is(err, os.ErrClosed) {
+		t.Errorf("got error %v writing to audit log file, expected %v", err, os.ErrClosed)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading audit log: %s", err)
+	}
+
+	if got, want := strings.Count(string(b), "\n"), 1; got != want {
+		t.Errorf("got %d records, expected %d", got, want)
+	}
+}
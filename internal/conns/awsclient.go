@@ -24,32 +24,39 @@ import (
 	rds_sdkv1 "github.com/aws/aws-sdk-go/service/rds"
 	baselogging "github.com/hashicorp/aws-sdk-go-base/v2/logging"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 type AWSClient struct {
-	AccountID         string
-	DefaultTagsConfig *tftags.DefaultConfig
-	IgnoreTagsConfig  *tftags.IgnoreConfig
-	Partition         string
-	Region            string
-	ServicePackages   map[string]ServicePackage
-
+	AccountID               string
+	DeletionProtectionRules []DeletionProtectionRule
+	IgnoreTagsConfig        *tftags.IgnoreConfig
+	Partition               string
+	Region                  string
+	ServicePackages         map[string]ServicePackage
+	TagPolicyConfig         *tftags.PolicyConfig
+
+	auditLogger               *auditLogger
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
 	conns                     map[string]any
+	defaultTagsConfig         *tftags.DefaultConfig
 	dnsSuffix                 string
 	endpoints                 map[string]string // From provider configuration.
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	serviceQuotaChecker       *serviceQuotaChecker
 	session                   *session_sdkv1.Session
 	s3ExpressClient           *s3_sdkv2.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	s3UsePathStyle            bool                              // From provider configuration.
+	s3USEast1RegionalEndpoint string                            // From provider configuration.
+	serviceEndpointOptions    map[string]ServiceEndpointOptions // From provider configuration.
+	stsRegion                 string                            // From provider configuration.
+	testMode                  bool                              // From provider configuration.
 }
 
 // CredentialsProvider returns the AWS SDK for Go v2 credentials provider.
@@ -141,8 +148,52 @@ func (c *AWSClient) S3ExpressClient(ctx context.Context) *s3_sdkv2.Client {
 }
 
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
-func (c *AWSClient) S3UsePathStyle(context.Context) bool {
-	return c.s3UsePathStyle
+// Path-style addressing is always used in test mode.
+func (c *AWSClient) S3UsePathStyle(ctx context.Context) bool {
+	return c.s3UsePathStyle || c.TestMode(ctx)
+}
+
+// CheckServiceQuota counts a planned resource against the specified Service Quota within the specified scope
+// and returns a diagnostic if the planned resources would exceed the quota's applied value.
+// The diagnostic is a warning if check_service_quotas_mode is "warning", otherwise an error.
+// current returns the usage of the quota before any planned resources are created.
+// Returns no diagnostics if Service Quotas checks are not enabled in the provider configuration.
+func (c *AWSClient) CheckServiceQuota(ctx context.Context, serviceCode, quotaCode, scope string, current func(context.Context) (int, error)) diag.Diagnostics {
+	if c.serviceQuotaChecker == nil {
+		return nil
+	}
+
+	return c.serviceQuotaChecker.check(ctx, serviceCode, quotaCode, scope, current)
+}
+
+// TestMode returns the test_mode provider configuration value.
+func (c *AWSClient) TestMode(context.Context) bool {
+	return c.testMode
+}
+
+// Close releases the resources held by the client, such as an open audit log file.
+func (c *AWSClient) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.auditLogger == nil {
+		return nil
+	}
+
+	err := c.auditLogger.close()
+	c.auditLogger = nil
+
+	return err
+}
+
+// DefaultTagsConfig returns the provider's default tags configuration.
+// If the context holds a configuration scoped to the current resource type, that is returned instead.
+func (c *AWSClient) DefaultTagsConfig(ctx context.Context) *tftags.DefaultConfig {
+	if v, ok := tftags.FromContext(ctx); ok {
+		return v.DefaultConfig
+	}
+
+	return c.defaultTagsConfig
 }
 
 // SetHTTPClient sets the http.Client used for AWS API calls.
@@ -272,9 +323,18 @@ func (c *AWSClient) apiClientConfig(ctx context.Context, servicePackageName stri
 		"partition":        c.Partition,
 		"session":          c.session,
 	}
+	if v, ok := c.serviceEndpointOptions[servicePackageName]; ok {
+		// Per-service endpoint options take precedence over all other configuration sources.
+		cfg := c.awsConfig.Copy()
+		cfg.ConfigSources = append([]any{v}, cfg.ConfigSources...)
+		m["aws_sdkv2_config"] = &cfg
+		m["session"] = c.session.Copy(v.sdkv1Config())
+	}
 	switch servicePackageName {
 	case names.S3:
-		m["s3_use_path_style"] = c.s3UsePathStyle
+		m["s3_use_path_style"] = c.S3UsePathStyle(ctx)
+		// Local emulators and S3-compatible APIs do not implement S3 Express One Zone session authentication.
+		m["s3_disable_express_session_auth"] = c.TestMode(ctx)
 		// AWS SDK for Go v2 does not use the AWS_S3_US_EAST_1_REGIONAL_ENDPOINT environment variable during configuration.
 		// For compatibility, read it now.
 		if c.s3USEast1RegionalEndpoint == "" {
@@ -31,8 +31,12 @@ type Config struct {
 	AllowedAccountIds              []string
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
+	AuditLogFile                   string
+	CheckServiceQuotas             bool
+	CheckServiceQuotasMode         string
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DeletionProtectionRules        []DeletionProtectionRule
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
@@ -50,6 +54,7 @@ type Config struct {
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	ServiceEndpointOptions         map[string]ServiceEndpointOptions
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -57,7 +62,9 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
+	TestMode                       bool
 	Token                          string
 	TokenBucketRateLimiterCapacity int
 	UseDualStackEndpoint           bool
@@ -70,6 +77,15 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	ctx, logger := logging.NewTfLogger(ctx)
 
+	if c.TestMode {
+		// The account ID is not retrieved in test mode, so it cannot be checked.
+		if len(c.AllowedAccountIds) > 0 || len(c.ForbiddenAccountIds) > 0 {
+			return nil, sdkdiag.AppendErrorf(diags, "allowed_account_ids and forbidden_account_ids cannot be set when test_mode is enabled")
+		}
+
+		c = c.testModeConfig()
+	}
+
 	const (
 		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
 	)
@@ -183,6 +199,25 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	// Release the audit log file opened by any previous configuration of this client.
+	if err := client.Close(); err != nil {
+		tflog.Warn(ctx, "closing audit log file", map[string]any{
+			"error": err.Error(),
+		})
+	}
+
+	if c.AuditLogFile != "" {
+		auditLogger, err := newAuditLogger(c.AuditLogFile)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "opening audit log file (%s): %s", c.AuditLogFile, err)
+		}
+
+		client.auditLogger = auditLogger
+
+		cfg.APIOptions = append(cfg.APIOptions, auditLogger.addSDKv2Middleware)
+		session.Handlers.Complete.PushBackNamed(auditLogger.sdkv1CompleteHandler())
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -193,15 +228,20 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		})
 	}
 
-	if accountID == "" {
-		diags = append(diags, errs.NewWarningDiagnostic(
-			"AWS account ID not found for provider",
-			"See https://registry.terraform.io/providers/hashicorp/aws/latest/docs#skip_requesting_account_id for implications."))
-	}
+	if accountID == "" && c.TestMode {
+		// Ensure that ARNs constructed by resources are well-formed.
+		accountID = testModeAccountID
+	} else {
+		if accountID == "" {
+			diags = append(diags, errs.NewWarningDiagnostic(
+				"AWS account ID not found for provider",
+				"See https://registry.terraform.io/providers/hashicorp/aws/latest/docs#skip_requesting_account_id for implications."))
+		}
 
-	err := awsbaseConfig.VerifyAccountIDAllowed(accountID)
-	if err != nil {
-		return nil, sdkdiag.AppendErrorf(diags, err.Error())
+		err := awsbaseConfig.VerifyAccountIDAllowed(accountID)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, err.Error())
+		}
 	}
 
 	dnsSuffix := "amazonaws.com"
@@ -210,11 +250,13 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	client.AccountID = accountID
-	client.DefaultTagsConfig = c.DefaultTagsConfig
+	client.defaultTagsConfig = c.DefaultTagsConfig
+	client.DeletionProtectionRules = c.DeletionProtectionRules
 	client.dnsSuffix = dnsSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
 	client.Region = c.Region
+	client.TagPolicyConfig = c.TagPolicyConfig
 	client.SetHTTPClient(ctx, session.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.session = session
 
@@ -226,11 +268,37 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.serviceEndpointOptions = c.ServiceEndpointOptions
 	client.stsRegion = c.STSRegion
+	client.testMode = c.TestMode
+
+	if c.CheckServiceQuotas {
+		client.serviceQuotaChecker = newServiceQuotaChecker(client, c.CheckServiceQuotasMode)
+	}
 
 	return client, diags
 }
 
+// testModeConfig returns a copy of the configuration with the validations that
+// local emulators and S3-compatible APIs cannot satisfy relaxed.
+// The receiver is not modified.
+func (c *Config) testModeConfig() *Config {
+	config := *c
+	config.S3UsePathStyle = true
+	config.SkipCredsValidation = true
+	config.SkipRegionValidation = true
+	config.SkipRequestingAccountId = true
+	if config.EC2MetadataServiceEnableState == imds_sdkv2.ClientDefaultEnableState {
+		config.EC2MetadataServiceEnableState = imds_sdkv2.ClientDisabled
+	}
+
+	return &config
+}
+
+// testModeAccountID is the AWS account ID used in test mode when the account ID is not retrieved.
+// It matches the default account ID used by local AWS emulators such as LocalStack.
+const testModeAccountID = "000000000000"
+
 func baseSeverityToSDKSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/aws-sdk-go-base/v2/servicemocks"
@@ -508,3 +509,117 @@ func TestProxyConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestTestModeConfig(t *testing.T) {
+	ctx := context.Background()
+
+	config := map[string]any{
+		"access_key": "StaticAccessKey",
+		"secret_key": servicemocks.MockStaticSecretKey,
+		"region":     "us-east-1-emulator",
+		"test_mode":  true,
+	}
+
+	p, err := provider.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(config))
+
+	if diff := cmp.Diff(diags, diag.Diagnostics(nil), cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	meta := p.Meta().(*conns.AWSClient)
+
+	if got, want := meta.AccountID, "000000000000"; got != want {
+		t.Errorf("AccountID = %q, want %q", got, want)
+	}
+	if got, want := meta.S3UsePathStyle(ctx), true; got != want {
+		t.Errorf("S3UsePathStyle = %t, want %t", got, want)
+	}
+	if got, want := meta.TestMode(ctx), true; got != want {
+		t.Errorf("TestMode = %t, want %t", got, want)
+	}
+
+	// Framework resources such as aws_s3_directory_bucket use the S3 Express client.
+	options := meta.S3ExpressClient(ctx).Options()
+
+	if got, want := options.UsePathStyle, true; got != want {
+		t.Errorf("S3ExpressClient UsePathStyle = %t, want %t", got, want)
+	}
+	if got, want := aws.ToBool(options.DisableS3ExpressSessionAuth), true; got != want {
+		t.Errorf("S3ExpressClient DisableS3ExpressSessionAuth = %t, want %t", got, want)
+	}
+}
+
+func TestTestModeConfigAccountIDs(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := map[string]struct {
+		key   string
+		value []any
+	}{
+		"allowed_account_ids": {
+			key:   "allowed_account_ids",
+			value: []any{"123456789012"},
+		},
+		"forbidden_account_ids": {
+			key:   "forbidden_account_ids",
+			value: []any{"123456789012"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			config := map[string]any{
+				"access_key": "StaticAccessKey",
+				testCase.key: testCase.value,
+				"secret_key": servicemocks.MockStaticSecretKey,
+				"region":     "us-east-1-emulator",
+				"test_mode":  true,
+			}
+
+			p, err := provider.New(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(config))
+
+			expectedDiags := diag.Diagnostics{
+				errs.NewErrorDiagnostic(
+					"allowed_account_ids and forbidden_account_ids cannot be set when test_mode is enabled",
+					"",
+				),
+			}
+
+			if diff := cmp.Diff(diags, expectedDiags, cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+				t.Errorf("unexpected diagnostics difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestTestModeConfigNotModified(t *testing.T) {
+	ctx := context.Background()
+
+	config := &conns.Config{
+		AccessKey: "StaticAccessKey",
+		Region:    "us-east-1-emulator",
+		SecretKey: servicemocks.MockStaticSecretKey,
+		TestMode:  true,
+	}
+	want := *config
+
+	_, diags := config.ConfigureProvider(ctx, &conns.AWSClient{})
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if diff := cmp.Diff(*config, want); diff != "" {
+		t.Errorf("unexpected Config difference: %s", diff)
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DeletionProtectionRule identifies resources that must never be destroyed.
+// ResourceType and NamePatterns are glob patterns: `*` matches any sequence of characters, including `/`,
+// `?` matches any single character and `[...]` matches a character class, as in path.Match.
+// A rule with no NamePatterns protects every resource of the matching types.
+type DeletionProtectionRule struct {
+	NamePatterns []string
+	ResourceType string
+}
+
+// Validate returns an error if any of the rule's patterns is malformed.
+func (r DeletionProtectionRule) Validate() error {
+	for _, pattern := range append([]string{r.ResourceType}, r.NamePatterns...) {
+		if _, err := globRegexp(pattern); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// Matches returns whether the rule protects a resource of the specified type
+// identified by any of the specified names.
+func (r DeletionProtectionRule) Matches(resourceType string, names ...string) bool {
+	if !globMatch(r.ResourceType, resourceType) {
+		return false
+	}
+
+	if len(r.NamePatterns) == 0 {
+		return true
+	}
+
+	for _, pattern := range r.NamePatterns {
+		for _, name := range names {
+			if name == "" {
+				continue
+			}
+
+			if globMatch(pattern, name) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// DeletionProtectionRuleFor returns the first deletion protection rule that protects
+// a resource of the specified type identified by any of the specified names.
+func (c *AWSClient) DeletionProtectionRuleFor(resourceType string, names ...string) (DeletionProtectionRule, bool) {
+	for _, rule := range c.DeletionProtectionRules {
+		if rule.Matches(resourceType, names...) {
+			return rule, true
+		}
+	}
+
+	return DeletionProtectionRule{}, false
+}
+
+// globMatch returns whether s matches the glob pattern. A malformed pattern matches nothing.
+func globMatch(pattern, s string) bool {
+	re, err := globRegexp(pattern)
+
+	if err != nil {
+		return false
+	}
+
+	return re.MatchString(s)
+}
+
+// globRegexp returns a regular expression equivalent to the glob pattern.
+// Unlike path.Match, `*` and `?` also match `/`, so that patterns can be matched against ARNs and IAM paths.
+func globRegexp(pattern string) (*regexp.Regexp, error) {
+	var sb strings.Builder
+
+	sb.WriteString(`^`)
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '*':
+			sb.WriteString(`.*`)
+		case '?':
+			sb.WriteString(`.`)
+		case '\\':
+			i++
+			if i == len(pattern) {
+				return nil, errors.New("trailing escape character")
+			}
+			sb.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		case '[':
+			j := strings.IndexByte(pattern[i+1:], ']')
+			if j < 1 {
+				return nil, errors.New("malformed character class")
+			}
+			sb.WriteString(pattern[i : i+j+2])
+			i += j + 1
+		default:
+			sb.WriteString(regexp.QuoteMeta(pattern[i : i+1]))
+		}
+	}
+	sb.WriteString(`$`)
+
+	return regexp.Compile(sb.String())
+}
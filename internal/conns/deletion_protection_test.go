@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestDeletionProtectionRuleMatches(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rule         DeletionProtectionRule
+		resourceType string
+		names        []string
+		expected     bool
+	}{
+		"resource type only": {
+			rule:         DeletionProtectionRule{ResourceType: "aws_db_instance"},
+			resourceType: "aws_db_instance",
+			names:        []string{"db-ABCDEFGHIJKL"},
+			expected:     true,
+		},
+		"resource type mismatch": {
+			rule:         DeletionProtectionRule{ResourceType: "aws_db_instance"},
+			resourceType: "aws_rds_cluster",
+			names:        []string{"prod"},
+			expected:     false,
+		},
+		"resource type glob": {
+			rule:         DeletionProtectionRule{ResourceType: "aws_rds_*"},
+			resourceType: "aws_rds_cluster",
+			expected:     true,
+		},
+		"name pattern match": {
+			rule:         DeletionProtectionRule{ResourceType: "aws_db_instance", NamePatterns: []string{"staging-*", "prod-*"}},
+			resourceType: "aws_db_instance",
+			names:        []string{"db-ABCDEFGHIJKL", "prod-orders"},
+			expected:     true,
+		},
+		"name pattern mismatch": {
+			rule:         DeletionProtectionRule{ResourceType: "aws_db_instance", NamePatterns: []string{"prod-*"}},
+			resourceType: "aws_db_instance",
+			names:        []string{"db-ABCDEFGHIJKL", "dev-orders"},
+			expected:     false,
+		},
+		"name pattern ARN with slash": {
+			rule:         DeletionProtectionRule{ResourceType: "aws_vpc", NamePatterns: []string{"arn:aws:ec2:*:vpc/vpc-*"}},
+			resourceType: "aws_vpc",
+			names:        []string{"vpc-12345678", "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-12345678"}, //lintignore:AWSAT003,AWSAT005
+			expected:     true,
+		},
+		"name pattern ARN with slash mismatch": {
+			rule:         DeletionProtectionRule{ResourceType: "aws_vpc", NamePatterns: []string{"arn:aws:ec2:*:vpc/vpc-*"}},
+			resourceType: "aws_vpc",
+			names:        []string{"arn:aws:ec2:us-west-2:123456789012:subnet/subnet-12345678"}, //lintignore:AWSAT003,AWSAT005
+			expected:     false,
+		},
+		"name pattern IAM path": {
+			rule:         DeletionProtectionRule{ResourceType: "aws_iam_role", NamePatterns: []string{"arn:aws:iam::*:role/prod/*"}},
+			resourceType: "aws_iam_role",
+			names:        []string{"deploy", "arn:aws:iam::123456789012:role/prod/ci/deploy"}, //lintignore:AWSAT005
+			expected:     true,
+		},
+		"name pattern IAM path mismatch": {
+			rule:         DeletionProtectionRule{ResourceType: "aws_iam_role", NamePatterns: []string{"arn:aws:iam::*:role/prod/*"}},
+			resourceType: "aws_iam_role",
+			names:        []string{"deploy", "arn:aws:iam::123456789012:role/dev/prod/deploy"}, //lintignore:AWSAT005
+			expected:     false,
+		},
+		"name pattern log group": {
+			rule:         DeletionProtectionRule{ResourceType: "aws_cloudwatch_log_group", NamePatterns: []string{"/aws/lambda/prod-*"}},
+			resourceType: "aws_cloudwatch_log_group",
+			names:        []string{"/aws/lambda/prod-orders/handler"},
+			expected:     true,
+		},
+		"name pattern single character wildcard": {
+			rule:         DeletionProtectionRule{ResourceType: "aws_cloudwatch_log_group", NamePatterns: []string{"/aws/lambda?prod"}},
+			resourceType: "aws_cloudwatch_log_group",
+			names:        []string{"/aws/lambda/prod"},
+			expected:     true,
+		},
+		"name pattern character class": {
+			rule:         DeletionProtectionRule{ResourceType: "aws_s3_bucket", NamePatterns: []string{"prod-[0-9]*"}},
+			resourceType: "aws_s3_bucket",
+			names:        []string{"prod-orders"},
+			expected:     false,
+		},
+		"name pattern special characters": {
+			rule:         DeletionProtectionRule{ResourceType: "aws_s3_bucket", NamePatterns: []string{"prod.orders+*"}},
+			resourceType: "aws_s3_bucket",
+			names:        []string{"prod-orders+1"},
+			expected:     false,
+		},
+		"name pattern empty name": {
+			rule:         DeletionProtectionRule{ResourceType: "aws_db_instance", NamePatterns: []string{"*"}},
+			resourceType: "aws_db_instance",
+			names:        []string{""},
+			expected:     false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := testCase.rule.Matches(testCase.resourceType, testCase.names...), testCase.expected; got != want {
+				t.Errorf("Matches(%q, %q) = %t, want %t", testCase.resourceType, testCase.names, got, want)
+			}
+		})
+	}
+}
+
+func TestDeletionProtectionRuleValidate(t *testing.T) {
+	t.Parallel()
+
+	if err := (DeletionProtectionRule{ResourceType: "aws_s3_*", NamePatterns: []string{"prod-[a-z]*"}}).Validate(); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if err := (DeletionProtectionRule{ResourceType: "aws_s3_bucket", NamePatterns: []string{"prod-[a-z"}}).Validate(); err == nil {
+		t.Error("expected error, got none")
+	}
+
+	if err := (DeletionProtectionRule{ResourceType: "aws_s3_bucket", NamePatterns: []string{"prod-[z-a]*"}}).Validate(); err == nil {
+		t.Error("expected error, got none")
+	}
+}
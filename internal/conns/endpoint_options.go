@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// ServiceEndpointOptions overrides the provider-level endpoint settings for a single service.
+// An unset state leaves the provider-level setting in effect.
+// ServiceEndpointOptions is an AWS SDK for Go v2 configuration source.
+type ServiceEndpointOptions struct {
+	UseDualStackEndpoint aws_sdkv2.DualStackEndpointState
+	UseFIPSEndpoint      aws_sdkv2.FIPSEndpointState
+}
+
+func (o ServiceEndpointOptions) GetUseDualStackEndpoint(context.Context) (aws_sdkv2.DualStackEndpointState, bool, error) {
+	return o.UseDualStackEndpoint, o.UseDualStackEndpoint != aws_sdkv2.DualStackEndpointStateUnset, nil
+}
+
+func (o ServiceEndpointOptions) GetUseFIPSEndpoint(context.Context) (aws_sdkv2.FIPSEndpointState, bool, error) {
+	return o.UseFIPSEndpoint, o.UseFIPSEndpoint != aws_sdkv2.FIPSEndpointStateUnset, nil
+}
+
+// sdkv1Config returns the equivalent AWS SDK for Go v1 configuration.
+func (o ServiceEndpointOptions) sdkv1Config() *aws_sdkv1.Config {
+	cfg := &aws_sdkv1.Config{}
+
+	switch o.UseDualStackEndpoint {
+	case aws_sdkv2.DualStackEndpointStateEnabled:
+		cfg.UseDualStackEndpoint = endpoints_sdkv1.DualStackEndpointStateEnabled
+	case aws_sdkv2.DualStackEndpointStateDisabled:
+		cfg.UseDualStackEndpoint = endpoints_sdkv1.DualStackEndpointStateDisabled
+	}
+
+	switch o.UseFIPSEndpoint {
+	case aws_sdkv2.FIPSEndpointStateEnabled:
+		cfg.UseFIPSEndpoint = endpoints_sdkv1.FIPSEndpointStateEnabled
+	case aws_sdkv2.FIPSEndpointStateDisabled:
+		cfg.UseFIPSEndpoint = endpoints_sdkv1.FIPSEndpointStateDisabled
+	}
+
+	return cfg
+}
+
+type useDualStackEndpointProvider interface {
+	GetUseDualStackEndpoint(context.Context) (aws_sdkv2.DualStackEndpointState, bool, error)
+}
+
+type useFIPSEndpointProvider interface {
+	GetUseFIPSEndpoint(context.Context) (aws_sdkv2.FIPSEndpointState, bool, error)
+}
+
+// UseDualStackEndpoint returns whether the specified service's AWS API is called via a dual-stack endpoint.
+func (c *AWSClient) UseDualStackEndpoint(ctx context.Context, servicePackageName string) bool {
+	for _, cs := range c.endpointConfigSources(servicePackageName) {
+		if p, ok := cs.(useDualStackEndpointProvider); ok {
+			if v, found, err := p.GetUseDualStackEndpoint(ctx); err == nil && found {
+				return v == aws_sdkv2.DualStackEndpointStateEnabled
+			}
+		}
+	}
+
+	return false
+}
+
+// UseFIPSEndpoint returns whether the specified service's AWS API is called via a FIPS endpoint.
+func (c *AWSClient) UseFIPSEndpoint(ctx context.Context, servicePackageName string) bool {
+	for _, cs := range c.endpointConfigSources(servicePackageName) {
+		if p, ok := cs.(useFIPSEndpointProvider); ok {
+			if v, found, err := p.GetUseFIPSEndpoint(ctx); err == nil && found {
+				return v == aws_sdkv2.FIPSEndpointStateEnabled
+			}
+		}
+	}
+
+	return false
+}
+
+// ConfiguredEndpoint returns any custom endpoint configured for the specified service's AWS API.
+func (c *AWSClient) ConfiguredEndpoint(ctx context.Context, servicePackageName string) string {
+	return c.resolveEndpoint(ctx, servicePackageName)
+}
+
+// endpointConfigSources returns the configuration sources, in precedence order, used to resolve the specified service's endpoint settings.
+func (c *AWSClient) endpointConfigSources(servicePackageName string) []any {
+	var configSources []any
+
+	if v, ok := c.serviceEndpointOptions[servicePackageName]; ok {
+		configSources = append(configSources, v)
+	}
+
+	if c.awsConfig != nil {
+		configSources = append(configSources, c.awsConfig.ConfigSources...)
+	}
+
+	return configSources
+}
@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	servicequotas_sdkv2 "github.com/aws/aws-sdk-go-v2/service/servicequotas"
+	servicequotastypes_sdkv2 "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+const (
+	// ServiceQuotasCheckModeError fails planning if planned resources would exceed a Service Quota.
+	ServiceQuotasCheckModeError = "error"
+	// ServiceQuotasCheckModeWarning only warns if planned resources would exceed a Service Quota.
+	ServiceQuotasCheckModeWarning = "warning"
+)
+
+// ServiceQuotasCheckMode_Values returns all valid Service Quotas check modes.
+func ServiceQuotasCheckMode_Values() []string {
+	return []string{
+		ServiceQuotasCheckModeError,
+		ServiceQuotasCheckModeWarning,
+	}
+}
+
+// serviceQuota is the applied value of a Service Quota.
+type serviceQuota struct {
+	name  string
+	value float64
+}
+
+// serviceQuotaUsage tracks usage of a Service Quota within a scope.
+type serviceQuotaUsage struct {
+	quota   serviceQuota
+	current int // Usage before any planned resources are created.
+	planned int // Number of planned resources counted against the quota.
+}
+
+// serviceQuotaChecker checks planned resources against Service Quotas.
+// The current usage and quota value are retrieved once per quota and scope.
+// The lock only guards the cache; API calls are made without holding it.
+type serviceQuotaChecker struct {
+	findQuota func(ctx context.Context, serviceCode, quotaCode string) (serviceQuota, error)
+	warn      bool
+	lock      sync.Mutex
+	usages    map[string]*serviceQuotaUsage
+}
+
+func newServiceQuotaChecker(client *AWSClient, mode string) *serviceQuotaChecker {
+	return &serviceQuotaChecker{
+		findQuota: func(ctx context.Context, serviceCode, quotaCode string) (serviceQuota, error) {
+			return findServiceQuota(ctx, client.ServiceQuotasClient(ctx), serviceCode, quotaCode)
+		},
+		warn:   mode == ServiceQuotasCheckModeWarning,
+		usages: make(map[string]*serviceQuotaUsage),
+	}
+}
+
+// check counts one more resource against the specified Service Quota within the specified scope
+// and returns a diagnostic if the total would exceed the quota's applied value.
+// The diagnostic is a warning if the checker is in warning mode, otherwise an error.
+// An empty scope indicates that the quota applies to the whole account and Region.
+func (c *serviceQuotaChecker) check(ctx context.Context, serviceCode, quotaCode, scope string, current func(context.Context) (int, error)) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	key := fmt.Sprintf("%s/%s/%s", serviceCode, quotaCode, scope)
+
+	c.lock.Lock()
+	_, ok := c.usages[key]
+	c.lock.Unlock()
+
+	if !ok {
+		quota, err := c.findQuota(ctx, serviceCode, quotaCode)
+
+		if err != nil {
+			// Don't fail planning if the quota can't be determined, e.g. because of missing permissions.
+			tflog.Warn(ctx, "reading Service Quota, skipping check", map[string]any{
+				"tf_aws.service_quota.service_code": serviceCode,
+				"tf_aws.service_quota.quota_code":   quotaCode,
+				"error":                             err.Error(),
+			})
+
+			return diags
+		}
+
+		n, err := current(ctx)
+
+		if err != nil {
+			tflog.Warn(ctx, "reading Service Quota usage, skipping check", map[string]any{
+				"tf_aws.service_quota.service_code": serviceCode,
+				"tf_aws.service_quota.quota_code":   quotaCode,
+				"tf_aws.service_quota.scope":        scope,
+				"error":                             err.Error(),
+			})
+
+			return diags
+		}
+
+		c.lock.Lock()
+		// Another check may have populated the cache while the lock was released.
+		if _, ok := c.usages[key]; !ok {
+			c.usages[key] = &serviceQuotaUsage{
+				quota:   quota,
+				current: n,
+			}
+		}
+		c.lock.Unlock()
+	}
+
+	c.lock.Lock()
+	usage := c.usages[key]
+	usage.planned++
+	quota, currentUsage, planned := usage.quota, usage.current, usage.planned
+	c.lock.Unlock()
+
+	if total := currentUsage + planned; float64(total) > quota.value {
+		d := diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Service Quota exceeded",
+			Detail: fmt.Sprintf("planned resources exceed Service Quota %q (%s/%s): quota value %g, current usage %d, planned %d",
+				quota.name, serviceCode, quotaCode, quota.value, currentUsage, planned),
+		}
+		if c.warn {
+			d.Severity = diag.Warning
+		}
+		diags = append(diags, d)
+	}
+
+	return diags
+}
+
+// findServiceQuota returns the applied value of the specified Service Quota,
+// falling back to the AWS default value if no value has been applied to the account.
+func findServiceQuota(ctx context.Context, conn *servicequotas_sdkv2.Client, serviceCode, quotaCode string) (serviceQuota, error) {
+	output, err := conn.GetServiceQuota(ctx, &servicequotas_sdkv2.GetServiceQuotaInput{
+		QuotaCode:   aws_sdkv2.String(quotaCode),
+		ServiceCode: aws_sdkv2.String(serviceCode),
+	})
+
+	if err != nil && !errs.IsA[*servicequotastypes_sdkv2.NoSuchResourceException](err) {
+		return serviceQuota{}, err
+	}
+
+	if err == nil && output.Quota != nil && output.Quota.ErrorReason == nil && output.Quota.Value != nil {
+		return serviceQuota{name: aws_sdkv2.ToString(output.Quota.QuotaName), value: aws_sdkv2.ToFloat64(output.Quota.Value)}, nil
+	}
+
+	defaultOutput, err := conn.GetAWSDefaultServiceQuota(ctx, &servicequotas_sdkv2.GetAWSDefaultServiceQuotaInput{
+		QuotaCode:   aws_sdkv2.String(quotaCode),
+		ServiceCode: aws_sdkv2.String(serviceCode),
+	})
+
+	if err != nil {
+		return serviceQuota{}, err
+	}
+
+	if defaultOutput.Quota == nil || defaultOutput.Quota.Value == nil {
+		return serviceQuota{}, fmt.Errorf("Service Quota (%s/%s) has no value", serviceCode, quotaCode)
+	}
+
+	return serviceQuota{name: aws_sdkv2.ToString(defaultOutput.Quota.QuotaName), value: aws_sdkv2.ToFloat64(defaultOutput.Quota.Value)}, nil
+}
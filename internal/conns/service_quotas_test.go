@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+func TestServiceQuotaChecker(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	var findQuotaCalls, currentCalls int
+	checker := &serviceQuotaChecker{
+		findQuota: func(ctx context.Context, serviceCode, quotaCode string) (serviceQuota, error) {
+			findQuotaCalls++
+			if quotaCode == "L-ERROR" {
+				return serviceQuota{}, errors.New("AccessDeniedException")
+			}
+			return serviceQuota{name: "Test quota", value: 3}, nil
+		},
+		usages: make(map[string]*serviceQuotaUsage),
+	}
+	current := func(context.Context) (int, error) {
+		currentCalls++
+		return 1, nil
+	}
+
+	for i := 0; i < 2; i++ {
+		if diags := checker.check(ctx, "vpc", "L-TEST", "", current); len(diags) > 0 {
+			t.Fatalf("check %d: unexpected diagnostics: %v", i, diags)
+		}
+	}
+	if diags := checker.check(ctx, "vpc", "L-TEST", "", current); !diags.HasError() {
+		t.Fatal("expected error when planned resources exceed the quota")
+	}
+
+	// Usage is tracked independently per scope.
+	if diags := checker.check(ctx, "vpc", "L-TEST", "sg-12345678", current); len(diags) > 0 {
+		t.Fatalf("scoped check: unexpected diagnostics: %v", diags)
+	}
+
+	if got, want := findQuotaCalls, 2; got != want {
+		t.Errorf("quota retrieved %d times, want %d", got, want)
+	}
+	if got, want := currentCalls, 2; got != want {
+		t.Errorf("usage retrieved %d times, want %d", got, want)
+	}
+
+	// Checks are skipped if the quota can't be retrieved.
+	if diags := checker.check(ctx, "vpc", "L-ERROR", "", current); len(diags) > 0 {
+		t.Errorf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestServiceQuotaCheckerWarningMode(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	checker := &serviceQuotaChecker{
+		findQuota: func(ctx context.Context, serviceCode, quotaCode string) (serviceQuota, error) {
+			return serviceQuota{name: "Test quota", value: 1}, nil
+		},
+		warn:   true,
+		usages: make(map[string]*serviceQuotaUsage),
+	}
+	current := func(context.Context) (int, error) {
+		return 1, nil
+	}
+
+	diags := checker.check(ctx, "vpc", "L-TEST", "", current)
+
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if got, want := len(diags), 1; got != want {
+		t.Fatalf("diagnostics: got %d, want %d", got, want)
+	}
+	if got, want := diags[0].Severity, diag.Warning; got != want {
+		t.Errorf("severity: got %v, want %v", got, want)
+	}
+}
+
+func TestServiceQuotaCheckerConcurrent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	checker := &serviceQuotaChecker{
+		findQuota: func(ctx context.Context, serviceCode, quotaCode string) (serviceQuota, error) {
+			return serviceQuota{name: "Test quota", value: 10}, nil
+		},
+		usages: make(map[string]*serviceQuotaUsage),
+	}
+	current := func(context.Context) (int, error) {
+		return 0, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			checker.check(ctx, "vpc", "L-TEST", "", current)
+		}()
+	}
+	wg.Wait()
+
+	if diags := checker.check(ctx, "vpc", "L-TEST", "", current); !diags.HasError() {
+		t.Fatal("expected error when planned resources exceed the quota")
+	}
+}
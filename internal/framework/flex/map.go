@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 )
 
 func ExpandFrameworkStringMap(ctx context.Context, v basetypes.MapValuable) map[string]*string {
@@ -59,6 +60,10 @@ func FlattenFrameworkStringValueMap(ctx context.Context, v map[string]string) ty
 	return output
 }
 
+func FlattenFrameworkStringValueMapOfString(ctx context.Context, v map[string]string) fwtypes.MapValueOf[basetypes.StringValue] {
+	return fwtypes.MapValueOf[basetypes.StringValue]{MapValue: FlattenFrameworkStringValueMap(ctx, v)}
+}
+
 // FlattenFrameworkStringValueMapLegacy has no Plugin SDK equivalent as schema.ResourceData.Set can be passed string value maps directly.
 // A nil map is converted to an empty (non-null) Map.
 func FlattenFrameworkStringValueMapLegacy(_ context.Context, m map[string]string) types.Map {
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
@@ -74,6 +75,10 @@ func FlattenFrameworkStringValueSet[T ~string](ctx context.Context, v []T) types
 	return output
 }
 
+func FlattenFrameworkStringValueSetOfString(ctx context.Context, vs []string) fwtypes.SetValueOf[basetypes.StringValue] {
+	return fwtypes.SetValueOf[basetypes.StringValue]{SetValue: FlattenFrameworkStringValueSet(ctx, vs)}
+}
+
 // FlattenFrameworkStringValueSetLegacy is the Plugin Framework variant of FlattenStringValueSet.
 // A nil slice is converted to an empty (non-null) Set.
 func FlattenFrameworkStringValueSetLegacy[T ~string](_ context.Context, vs []T) types.Set {
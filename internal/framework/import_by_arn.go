@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// ResourceWithImportIDFromARN is implemented by resources with an ARN identity whose import ID is not the ARN.
+// When such a resource is imported by ARN, the ARN is converted to the resource's import ID before the resource's ImportState method is called.
+// See the ARNIdentity annotation.
+type ResourceWithImportIDFromARN interface {
+	ImportIDFromARN(context.Context, arn.ARN) (string, error)
+}
+
+// ARNResourceParts returns the parts of an ARN's resource that match the `*` wildcards in pattern.
+// A wildcard matches one or more characters other than `/`.
+// For example, the resource "application/a-1/index/i-1" matches the pattern "application/*/index/*" with parts "a-1" and "i-1".
+func ARNResourceParts(arn arn.ARN, service, pattern string) ([]string, error) {
+	re := regexache.MustCompile(`^` + strings.ReplaceAll(regexp.QuoteMeta(pattern), `\*`, `([^/]+)`) + `$`)
+
+	if m := re.FindStringSubmatch(arn.Resource); arn.Service == service && m != nil {
+		return m[1:], nil
+	}
+
+	return nil, fmt.Errorf("unexpected format for ARN (%s), expected arn:PARTITION:%s:REGION:ACCOUNT:%s", arn, service, pattern)
+}
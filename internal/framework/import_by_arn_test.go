@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestARNResourceParts(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn           string
+		service       string
+		pattern       string
+		expected      []string
+		expectedError bool
+	}{
+		"single part": {
+			arn:      "arn:aws:ec2:us-west-2:123456789012:security-group-rule/sgr-12345678", //lintignore:AWSAT003,AWSAT005
+			service:  "ec2",
+			pattern:  "security-group-rule/*",
+			expected: []string{"sgr-12345678"},
+		},
+		"multiple parts": {
+			arn:      "arn:aws:qbusiness:us-west-2:123456789012:application/a-1/index/i-1/data-source/ds-1", //lintignore:AWSAT003,AWSAT005
+			service:  "qbusiness",
+			pattern:  "application/*/index/*/data-source/*",
+			expected: []string{"a-1", "i-1", "ds-1"},
+		},
+		"non-slash separator": {
+			arn:      "arn:aws:elasticache:us-west-2:123456789012:serverlesscache:example", //lintignore:AWSAT003,AWSAT005
+			service:  "elasticache",
+			pattern:  "serverlesscache:*",
+			expected: []string{"example"},
+		},
+		"wrong service": {
+			arn:           "arn:aws:ec2:us-west-2:123456789012:security-group-rule/sgr-12345678", //lintignore:AWSAT003,AWSAT005
+			service:       "vpc",
+			pattern:       "security-group-rule/*",
+			expectedError: true,
+		},
+		"wrong resource type": {
+			arn:           "arn:aws:ec2:us-west-2:123456789012:security-group/sg-12345678", //lintignore:AWSAT003,AWSAT005
+			service:       "ec2",
+			pattern:       "security-group-rule/*",
+			expectedError: true,
+		},
+		"extra part": {
+			arn:           "arn:aws:qbusiness:us-west-2:123456789012:application/a-1/index/i-1", //lintignore:AWSAT003,AWSAT005
+			service:       "qbusiness",
+			pattern:       "application/*",
+			expectedError: true,
+		},
+		"empty part": {
+			arn:           "arn:aws:qbusiness:us-west-2:123456789012:application//index/i-1", //lintignore:AWSAT003,AWSAT005
+			service:       "qbusiness",
+			pattern:       "application/*/index/*",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			arn, err := arn.Parse(testCase.arn)
+			if err != nil {
+				t.Fatalf("parsing ARN: %s", err)
+			}
+
+			got, err := ARNResourceParts(arn, testCase.service, testCase.pattern)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("error: got %v, want error %t", err, want)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -35,7 +35,7 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 		return
 	}
 
-	defaultTagsConfig := r.Meta().DefaultTagsConfig
+	defaultTagsConfig := r.Meta().DefaultTagsConfig(ctx)
 	ignoreTagsConfig := r.Meta().IgnoreTagsConfig
 
 	var planTags types.Map
@@ -49,7 +49,16 @@ func (r *ResourceWithConfigure) SetTagsAll(ctx context.Context, request resource
 	if !planTags.IsUnknown() {
 		if !mapHasUnknownElements(planTags) {
 			resourceTags := tftags.New(ctx, planTags)
-			allTags := defaultTagsConfig.MergeTags(resourceTags).IgnoreConfig(ignoreTagsConfig)
+			mergedTags := defaultTagsConfig.MergeTags(resourceTags)
+
+			// Required tag keys may also be ignored, so validate before ignoring tags.
+			if err := r.Meta().TagPolicyConfig.Validate(mergedTags); err != nil {
+				response.Diagnostics.AddAttributeError(path.Root(names.AttrTags), "Tag Policy Violation", err.Error())
+
+				return
+			}
+
+			allTags := mergedTags.IgnoreConfig(ignoreTagsConfig)
 
 			response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrTagsAll), flex.FlattenFrameworkStringValueMapLegacy(ctx, allTags.Map()))...)
 		} else {
@@ -109,6 +109,8 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	ARNIdentity             bool
+	ARNIdentityAttribute    string
 }
 
 type ServiceDatum struct {
@@ -209,6 +211,21 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				d.TagsResourceType = attr
 			}
 		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "ARNIdentity" {
+			args := common.ParseArgs(m[3])
+
+			if d.ARNIdentity {
+				v.errs = append(v.errs, fmt.Errorf("multiple ARNIdentity annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			d.ARNIdentity = true
+			d.ARNIdentityAttribute = namesgen.ConstOrQuote("arn")
+
+			if attr, ok := args.Keyword["attribute"]; ok {
+				d.ARNIdentityAttribute = namesgen.ConstOrQuote(attr)
+			}
+		}
 	}
 
 	for _, line := range funcDecl.Doc.List {
@@ -262,7 +279,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "ARNIdentity", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
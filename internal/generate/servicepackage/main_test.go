@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+func TestFrameworkResourceARNIdentity(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		src               string
+		expectedIdentity  bool
+		expectedAttribute string
+		expectError       bool
+	}{
+		"no annotation": {
+			src: `
+package example
+
+// @FrameworkResource("aws_example_thing", name="Thing")
+func newThingResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &thingResource{}, nil
+}
+
+func (data *thingResourceModel) setID() {
+	data.ID = data.ThingARN
+}
+`,
+			expectedIdentity: false,
+		},
+		"annotation": {
+			src: `
+package example
+
+// @FrameworkResource("aws_example_thing", name="Thing")
+// @ARNIdentity
+func newThingResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &thingResource{}, nil
+}
+`,
+			expectedIdentity:  true,
+			expectedAttribute: "names.AttrARN",
+		},
+		"annotation with attribute": {
+			src: `
+package example
+
+// @FrameworkResource("aws_example_thing", name="Thing")
+// @ARNIdentity(attribute="thing_arn")
+func newThingResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &thingResource{}, nil
+}
+`,
+			expectedIdentity:  true,
+			expectedAttribute: `"thing_arn"`,
+		},
+		"multiple annotations": {
+			src: `
+package example
+
+// @FrameworkResource("aws_example_thing", name="Thing")
+// @ARNIdentity
+// @ARNIdentity(attribute="thing_arn")
+func newThingResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &thingResource{}, nil
+}
+`,
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			file, err := parser.ParseFile(token.NewFileSet(), "example.go", testCase.src, parser.ParseComments)
+			if err != nil {
+				t.Fatalf("parsing source: %s", err)
+			}
+
+			v := &visitor{
+				g: common.NewGenerator(),
+
+				frameworkDataSources: make([]ResourceDatum, 0),
+				frameworkResources:   make([]ResourceDatum, 0),
+				sdkDataSources:       make(map[string]ResourceDatum),
+				sdkResources:         make(map[string]ResourceDatum),
+			}
+
+			v.processFile(file)
+
+			if got, want := len(v.errs) > 0, testCase.expectError; got != want {
+				t.Fatalf("errors: got %v, want error %t", v.errs, want)
+			}
+			if testCase.expectError {
+				return
+			}
+
+			if got, want := len(v.frameworkResources), 1; got != want {
+				t.Fatalf("framework resources: got %d, want %d", got, want)
+			}
+
+			if got, want := v.frameworkResources[0].ARNIdentity, testCase.expectedIdentity; got != want {
+				t.Errorf("ARNIdentity: got %t, want %t", got, want)
+			}
+			if got, want := v.frameworkResources[0].ARNIdentityAttribute, testCase.expectedAttribute; got != want {
+				t.Errorf("ARNIdentityAttribute: got %s, want %s", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// deletionProtectionProviderServer is a terraform-plugin-go protocol v5 provider server that fails
+// any plan that destroys or replaces a resource protected by the provider's deletion_protection configuration.
+// Terraform calls PlanResourceChange for resource destruction from v1.3 onwards.
+type deletionProtectionProviderServer struct {
+	tfprotov5.ProviderServer
+
+	meta func() any
+
+	lock       sync.Mutex
+	valueTypes map[string]tftypes.Type // Resource type name to resource value type.
+}
+
+func newDeletionProtectionProviderServer(server tfprotov5.ProviderServer, meta func() any) *deletionProtectionProviderServer {
+	return &deletionProtectionProviderServer{
+		ProviderServer: server,
+		meta:           meta,
+	}
+}
+
+func (s *deletionProtectionProviderServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+
+	if err != nil || resp == nil || req.PriorState == nil {
+		return resp, err
+	}
+
+	client, ok := s.meta().(*conns.AWSClient)
+
+	if !ok || len(client.DeletionProtectionRules) == 0 {
+		return resp, nil
+	}
+
+	valueType, err := s.resourceValueType(ctx, req.TypeName)
+
+	if err != nil {
+		tflog.Warn(ctx, "reading resource schema, skipping deletion protection check", map[string]any{
+			"tf_resource_type": req.TypeName,
+			"error":            err.Error(),
+		})
+
+		return resp, nil
+	}
+
+	priorState, err := req.PriorState.Unmarshal(valueType)
+
+	if err != nil || priorState.IsNull() {
+		// Resource creation.
+		return resp, nil
+	}
+
+	var action string
+
+	if destroy, err := isNullDynamicValue(resp.PlannedState, valueType); err == nil && destroy {
+		action = "destroy"
+	} else if len(resp.RequiresReplace) > 0 {
+		action = "replace"
+	} else {
+		return resp, nil
+	}
+
+	identifiers := stringAttributeValues(priorState, names.AttrID, names.AttrARN, names.AttrName)
+
+	if rule, ok := client.DeletionProtectionRuleFor(req.TypeName, identifiers...); ok {
+		resp.Diagnostics = append(resp.Diagnostics, &tfprotov5.Diagnostic{
+			Severity: tfprotov5.DiagnosticSeverityError,
+			Summary:  "Resource is protected from deletion",
+			Detail: fmt.Sprintf("The plan would %s %s %v, which matches the provider deletion_protection configuration for resource_type %q. "+
+				"Remove the matching deletion_protection configuration block to allow the resource to be destroyed.", action, req.TypeName, identifiers, rule.ResourceType),
+		})
+	}
+
+	return resp, nil
+}
+
+// resourceValueType returns the value type of the specified resource type's schema.
+func (s *deletionProtectionProviderServer) resourceValueType(ctx context.Context, typeName string) (tftypes.Type, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.valueTypes == nil {
+		resp, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov5.GetProviderSchemaRequest{})
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, diag := range resp.Diagnostics {
+			if diag.Severity == tfprotov5.DiagnosticSeverityError {
+				return nil, fmt.Errorf("%s: %s", diag.Summary, diag.Detail)
+			}
+		}
+
+		s.valueTypes = make(map[string]tftypes.Type, len(resp.ResourceSchemas))
+		for k, v := range resp.ResourceSchemas {
+			s.valueTypes[k] = v.ValueType()
+		}
+	}
+
+	valueType, ok := s.valueTypes[typeName]
+
+	if !ok {
+		return nil, fmt.Errorf("resource type %q not found", typeName)
+	}
+
+	return valueType, nil
+}
+
+func isNullDynamicValue(v *tfprotov5.DynamicValue, valueType tftypes.Type) (bool, error) {
+	if v == nil {
+		return true, nil
+	}
+
+	value, err := v.Unmarshal(valueType)
+
+	if err != nil {
+		return false, err
+	}
+
+	return value.IsNull(), nil
+}
+
+// stringAttributeValues returns the known, non-empty values of the specified top-level string attributes.
+func stringAttributeValues(object tftypes.Value, attributeNames ...string) []string {
+	var attributes map[string]tftypes.Value
+
+	if err := object.As(&attributes); err != nil {
+		return nil
+	}
+
+	var values []string
+
+	for _, attributeName := range attributeNames {
+		v, ok := attributes[attributeName]
+
+		if !ok || !v.Type().Is(tftypes.String) || !v.IsKnown() || v.IsNull() {
+			continue
+		}
+
+		var s string
+
+		if err := v.As(&s); err == nil && s != "" {
+			values = append(values, s)
+		}
+	}
+
+	return values
+}
@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+type mockPlanProviderServer struct {
+	tfprotov5.ProviderServer
+
+	schema          *tfprotov5.Schema
+	plannedState    *tfprotov5.DynamicValue
+	requiresReplace []*tftypes.AttributePath
+}
+
+func (s *mockPlanProviderServer) GetProviderSchema(context.Context, *tfprotov5.GetProviderSchemaRequest) (*tfprotov5.GetProviderSchemaResponse, error) {
+	return &tfprotov5.GetProviderSchemaResponse{
+		ResourceSchemas: map[string]*tfprotov5.Schema{
+			"aws_db_instance": s.schema,
+		},
+	}, nil
+}
+
+func (s *mockPlanProviderServer) PlanResourceChange(context.Context, *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	return &tfprotov5.PlanResourceChangeResponse{
+		PlannedState:    s.plannedState,
+		RequiresReplace: s.requiresReplace,
+	}, nil
+}
+
+func TestDeletionProtectionProviderServerPlanResourceChange(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	schema := &tfprotov5.Schema{
+		Block: &tfprotov5.SchemaBlock{
+			Attributes: []*tfprotov5.SchemaAttribute{
+				{Name: "id", Type: tftypes.String, Computed: true},
+				{Name: "identifier", Type: tftypes.String, Optional: true},
+				{Name: "name", Type: tftypes.String, Optional: true},
+			},
+		},
+	}
+	valueType := schema.ValueType()
+	dynamicValue := func(t *testing.T, v tftypes.Value) *tfprotov5.DynamicValue {
+		t.Helper()
+
+		dv, err := tfprotov5.NewDynamicValue(valueType, v)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return &dv
+	}
+	state := func(name string) tftypes.Value {
+		return tftypes.NewValue(valueType, map[string]tftypes.Value{
+			"id":         tftypes.NewValue(tftypes.String, "db-ABCDEFGHIJKL"),
+			"identifier": tftypes.NewValue(tftypes.String, name),
+			"name":       tftypes.NewValue(tftypes.String, name),
+		})
+	}
+	nullState := tftypes.NewValue(valueType, nil)
+	rules := []conns.DeletionProtectionRule{{ResourceType: "aws_db_*", NamePatterns: []string{"prod-*"}}}
+
+	testCases := map[string]struct {
+		rules           []conns.DeletionProtectionRule
+		priorState      tftypes.Value
+		plannedState    tftypes.Value
+		requiresReplace []*tftypes.AttributePath
+		expectError     bool
+	}{
+		"no rules": {
+			priorState:   state("prod-orders"),
+			plannedState: nullState,
+		},
+		"create": {
+			rules:        rules,
+			priorState:   nullState,
+			plannedState: state("prod-orders"),
+		},
+		"update": {
+			rules:        rules,
+			priorState:   state("prod-orders"),
+			plannedState: state("prod-orders"),
+		},
+		"destroy protected": {
+			rules:        rules,
+			priorState:   state("prod-orders"),
+			plannedState: nullState,
+			expectError:  true,
+		},
+		"destroy unprotected": {
+			rules:        rules,
+			priorState:   state("dev-orders"),
+			plannedState: nullState,
+		},
+		"replace protected": {
+			rules:           rules,
+			priorState:      state("prod-orders"),
+			plannedState:    state("prod-invoices"),
+			requiresReplace: []*tftypes.AttributePath{tftypes.NewAttributePath().WithAttributeName("identifier")},
+			expectError:     true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := newDeletionProtectionProviderServer(&mockPlanProviderServer{
+				schema:          schema,
+				plannedState:    dynamicValue(t, testCase.plannedState),
+				requiresReplace: testCase.requiresReplace,
+			}, func() any {
+				return &conns.AWSClient{DeletionProtectionRules: testCase.rules}
+			})
+
+			resp, err := server.PlanResourceChange(ctx, &tfprotov5.PlanResourceChangeRequest{
+				TypeName:   "aws_db_instance",
+				PriorState: dynamicValue(t, testCase.priorState),
+			})
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var hasError bool
+			for _, diag := range resp.Diagnostics {
+				if diag.Severity == tfprotov5.DiagnosticSeverityError {
+					hasError = true
+				}
+			}
+
+			if got, want := hasError, testCase.expectError; got != want {
+				t.Errorf("error diagnostic = %t, want %t (diagnostics: %v)", got, want, resp.Diagnostics)
+			}
+		})
+	}
+}
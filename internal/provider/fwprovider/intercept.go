@@ -7,14 +7,17 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
@@ -252,14 +255,16 @@ func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
+	identity         *types.ServicePackageResourceIdentity
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, identity *types.ServicePackageResourceIdentity) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
+		identity:         identity,
 		inner:            inner,
 		interceptors:     interceptors,
 	}
@@ -326,6 +331,13 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+
+		if w.identity != nil && arn.IsARN(request.ID) {
+			w.importStateByARN(ctx, v, request, response)
+
+			return
+		}
+
 		v.ImportState(ctx, request, response)
 
 		return
@@ -337,6 +349,65 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 	)
 }
 
+// importStateByARN imports a resource with an ARN identity using its ARN.
+func (w *wrappedResource) importStateByARN(ctx context.Context, inner resource.ResourceWithImportState, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	arn, err := arn.Parse(request.ID)
+
+	if err != nil {
+		response.Diagnostics.AddError("Invalid Import ARN", err.Error())
+
+		return
+	}
+
+	if w.meta != nil {
+		if arn.Partition != w.meta.Partition {
+			response.Diagnostics.AddError(
+				"Invalid Import ARN",
+				fmt.Sprintf("ARN (%s) partition %q does not match the provider's partition %q", request.ID, arn.Partition, w.meta.Partition),
+			)
+
+			return
+		}
+
+		// Global resources' ARNs have no Region.
+		if arn.Region != "" && arn.Region != w.meta.Region {
+			response.Diagnostics.AddError(
+				"Invalid Import ARN",
+				fmt.Sprintf("ARN (%s) Region %q does not match the provider's Region %q", request.ID, arn.Region, w.meta.Region),
+			)
+
+			return
+		}
+	}
+
+	if v, ok := inner.(framework.ResourceWithImportIDFromARN); ok {
+		id, err := v.ImportIDFromARN(ctx, arn)
+
+		if err != nil {
+			response.Diagnostics.AddError("Invalid Import ARN", err.Error())
+
+			return
+		}
+
+		request.ID = id
+	}
+
+	inner.ImportState(ctx, request, response)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Don't overwrite an ARN set by the resource's ImportState method.
+	if v, _, err := tftypes.WalkAttributePath(response.State.Raw, tftypes.NewAttributePath().WithAttributeName(w.identity.ARNAttribute)); err == nil {
+		if v, ok := v.(tftypes.Value); ok && !v.IsNull() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(w.identity.ARNAttribute), arn.String())...)
+}
+
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
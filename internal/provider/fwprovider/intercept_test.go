@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type importTestResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*importTestResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_test_example"
+}
+
+func (*importTestResource) Schema(_ context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = importTestSchema
+}
+
+func (*importTestResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {
+}
+
+func (*importTestResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {}
+
+func (*importTestResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
+}
+
+func (*importTestResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+type importTestResourceWithImportIDFromARN struct {
+	importTestResource
+}
+
+func (*importTestResourceWithImportIDFromARN) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	id, found := strings.CutPrefix(arn.Resource, "example/")
+
+	if !found {
+		return "", fmt.Errorf("unexpected format for ARN resource (%s)", arn.Resource)
+	}
+
+	return id, nil
+}
+
+var importTestSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		names.AttrARN: schema.StringAttribute{
+			Computed: true,
+		},
+		names.AttrID: schema.StringAttribute{
+			Computed: true,
+		},
+	},
+}
+
+func TestWrappedResourceImportStateByARN(t *testing.T) {
+	t.Parallel()
+
+	const (
+		exampleARN = "arn:aws:test:us-west-2:123456789012:example/abc123" //lintignore:AWSAT003,AWSAT005
+	)
+
+	testCases := map[string]struct {
+		inner     resource.ResourceWithConfigure
+		importID  string
+		expectErr bool
+		expectID  string
+		expectARN string
+	}{
+		"ARN is ID": {
+			inner:     &importTestResource{},
+			importID:  exampleARN,
+			expectID:  exampleARN,
+			expectARN: exampleARN,
+		},
+		"ImportIDFromARN": {
+			inner:     &importTestResourceWithImportIDFromARN{},
+			importID:  exampleARN,
+			expectID:  "abc123",
+			expectARN: exampleARN,
+		},
+		"ImportIDFromARN error": {
+			inner:     &importTestResourceWithImportIDFromARN{},
+			importID:  "arn:aws:test:us-west-2:123456789012:other/abc123", //lintignore:AWSAT003,AWSAT005
+			expectErr: true,
+		},
+		"global ARN": {
+			inner:     &importTestResource{},
+			importID:  "arn:aws:test::123456789012:example/abc123", //lintignore:AWSAT005
+			expectID:  "arn:aws:test::123456789012:example/abc123", //lintignore:AWSAT005
+			expectARN: "arn:aws:test::123456789012:example/abc123", //lintignore:AWSAT005
+		},
+		"bad partition": {
+			inner:     &importTestResource{},
+			importID:  "arn:aws-cn:test:us-west-2:123456789012:example/abc123", //lintignore:AWSAT003,AWSAT005
+			expectErr: true,
+		},
+		"bad Region": {
+			inner:     &importTestResource{},
+			importID:  "arn:aws:test:us-east-1:123456789012:example/abc123", //lintignore:AWSAT003,AWSAT005
+			expectErr: true,
+		},
+		"not an ARN": {
+			inner:    &importTestResourceWithImportIDFromARN{},
+			importID: "abc123",
+			expectID: "abc123",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			w := &wrappedResource{
+				bootstrapContext: func(ctx context.Context, _ *conns.AWSClient) context.Context { return ctx },
+				identity:         &types.ServicePackageResourceIdentity{ARNAttribute: names.AttrARN},
+				inner:            testCase.inner,
+				meta: &conns.AWSClient{
+					Partition: "aws",
+					Region:    "us-west-2", //lintignore:AWSAT003
+				},
+			}
+
+			request := resource.ImportStateRequest{
+				ID: testCase.importID,
+			}
+			response := resource.ImportStateResponse{
+				State: tfsdk.State{
+					Raw:    tftypes.NewValue(importTestSchema.Type().TerraformType(ctx), nil),
+					Schema: importTestSchema,
+				},
+			}
+
+			w.ImportState(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), testCase.expectErr; got != want {
+				t.Fatalf("HasError = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+
+			if testCase.expectErr {
+				return
+			}
+
+			var id, arn fwtypes.String
+			response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root(names.AttrID), &id)...)
+			response.Diagnostics.Append(response.State.GetAttribute(ctx, path.Root(names.AttrARN), &arn)...)
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected error: %v", response.Diagnostics)
+			}
+
+			if got, want := id.ValueString(), testCase.expectID; got != want {
+				t.Errorf("id = %q, want %q", got, want)
+			}
+			if got, want := arn.ValueString(), testCase.expectARN; got != want {
+				t.Errorf("arn = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -56,6 +56,18 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				ElementType: types.StringType,
 				Optional:    true,
 			},
+			"audit_log_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path of a file to which a JSON Lines record of every AWS API call is appended. Each record includes the service, operation, request ID and latency of the call.",
+			},
+			"check_service_quotas": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Check planned resources against the applied Service Quotas values. Plans that would exceed a quota fail, or warn if `check_service_quotas_mode` is `warning`.",
+			},
+			"check_service_quotas_mode": schema.StringAttribute{
+				Optional:    true,
+				Description: "Whether planned resources that would exceed a Service Quota fail the plan or only warn. Valid values are `error` (default) and `warning`. Only resources that support plan-time warnings honor `warning`.",
+			},
 			"custom_ca_bundle": schema.StringAttribute{
 				Optional:    true,
 				Description: "File containing custom root and intermediate certificates. Can also be configured using the `AWS_CA_BUNDLE` environment variable. (Setting `ca_bundle` in the shared config file is not supported.)",
@@ -148,6 +160,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "The region where AWS STS operations will take place. Examples\nare us-east-1 and us-west-2.", // lintignore:AWSAT003
 			},
+			"test_mode": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Relax validations for use with local AWS emulators such as LocalStack and S3-compatible APIs. Implies `skip_credentials_validation`, `skip_region_validation`, `skip_requesting_account_id` and `s3_use_path_style`.",
+			},
 			"token": schema.StringAttribute{
 				Optional:    true,
 				Description: "session token. A session token is only required if you are\nusing temporary security credentials.",
@@ -259,6 +275,15 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
+						"case_insensitive_keys": schema.BoolAttribute{
+							Optional:    true,
+							Description: "Whether a resource tag overrides any default tag whose key differs only by case.",
+						},
+						"excluded_resources": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource types that default tags are not applied to.",
+						},
 						"tags": schema.MapAttribute{
 							ElementType: types.StringType,
 							Optional:    true,
@@ -267,6 +292,22 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"deletion_protection": schema.ListNestedBlock{
+				Description: "Configuration blocks identifying resources that must never be destroyed or replaced.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name_patterns": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Glob patterns matched against the `id`, `arn` and `name` attributes of protected resources. If not set, all resources of the type are protected.",
+						},
+						"resource_type": schema.StringAttribute{
+							Required:    true,
+							Description: "Glob pattern matched against the type of protected resources, e.g. `aws_db_instance` or `aws_rds_*`.",
+						},
+					},
+				},
+			},
 			"endpoints": endpointsBlock(),
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
@@ -288,6 +329,62 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"service_endpoint_options": schema.ListNestedBlock{
+				Description: "Configuration blocks that override endpoint resolution settings for individual services.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"service": schema.StringAttribute{
+							Required:    true,
+							Description: "Service the settings apply to, as used in the `endpoints` configuration block.",
+						},
+						"use_dualstack_endpoint": schema.StringAttribute{
+							Optional:    true,
+							Description: "Resolve an endpoint with DualStack capability for the service",
+						},
+						"use_fips_endpoint": schema.StringAttribute{
+							Optional:    true,
+							Description: "Resolve an endpoint with FIPS capability for the service",
+						},
+					},
+				},
+			},
+			"tag_policy": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with rules that resource tags must satisfy across all resources.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"required_keys": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Resource tag keys that must be present on all taggable resources.",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"tag": schema.ListNestedBlock{
+							Description: "Constraints on the value of a resource tag.",
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_values": schema.SetAttribute{
+										ElementType: types.StringType,
+										Optional:    true,
+										Description: "Values the resource tag may have.",
+									},
+									names.AttrKey: schema.StringAttribute{
+										Required:    true,
+										Description: "Resource tag key the constraints apply to.",
+									},
+									"value_regex": schema.StringAttribute{
+										Optional:    true,
+										Description: "Regular expression the resource tag value must match.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -335,7 +432,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx).ForResource(typeName), meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
 				}
 
@@ -406,7 +503,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if meta != nil {
-					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig, meta.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, meta.DefaultTagsConfig(ctx).ForResource(typeName), meta.IgnoreTagsConfig)
 					ctx = meta.RegisterLogger(ctx)
 				}
 
@@ -442,8 +539,26 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 				interceptors = append(interceptors, tagsResourceInterceptor{tags: v.Tags})
 			}
 
+			identity := v.Identity
+
+			if identity != nil {
+				// The resource has an ARN identity.
+				// Ensure that the schema look OK.
+				schemaResponse := resource.SchemaResponse{}
+				inner.Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+
+				if _, ok := schemaResponse.Schema.Attributes[identity.ARNAttribute]; !ok {
+					errs = append(errs, fmt.Errorf("no `%s` attribute defined in schema: %s", identity.ARNAttribute, typeName))
+					continue
+				}
+				if _, ok := inner.(resource.ResourceWithImportState); !ok {
+					// The resource cannot be imported by ARN.
+					identity = nil
+				}
+			}
+
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, inner, interceptors, identity)
 			})
 		}
 	}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 
@@ -51,6 +52,25 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
+			"audit_log_file": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: "Path of a file to which a JSON Lines record of every AWS API call is appended. " +
+					"Each record includes the service, operation, request ID and latency of the call.",
+			},
+			"check_service_quotas": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Check planned resources against the applied Service Quotas values. " +
+					"Plans that would exceed a quota fail, or warn if `check_service_quotas_mode` is `warning`.",
+			},
+			"check_service_quotas_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(conns.ServiceQuotasCheckMode_Values(), false),
+				Description: "Whether planned resources that would exceed a Service Quota fail the plan or only warn. " +
+					"Valid values are `error` (default) and `warning`. Only resources that support plan-time warnings honor `warning`.",
+			},
 			"custom_ca_bundle": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -65,6 +85,17 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"case_insensitive_keys": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Whether a resource tag overrides any default tag whose key differs only by case.",
+						},
+						"excluded_resources": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types that default tags are not applied to.",
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -74,6 +105,26 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"deletion_protection": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks identifying resources that must never be destroyed or replaced.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name_patterns": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Glob patterns matched against the `id`, `arn` and `name` attributes of protected resources. If not set, all resources of the type are protected.",
+						},
+						"resource_type": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Glob pattern matched against the type of protected resources, e.g. `aws_db_instance` or `aws_rds_*`.",
+						},
+					},
+				},
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -185,6 +236,33 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"service_endpoint_options": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Configuration blocks that override endpoint resolution settings for individual services.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validServiceEndpointOptionsService,
+							Description:  "Service the settings apply to, as used in the `endpoints` configuration block.",
+						},
+						"use_dualstack_endpoint": {
+							Type:         nullable.TypeNullableBool,
+							Optional:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableBool,
+							Description:  "Resolve an endpoint with DualStack capability for the service",
+						},
+						"use_fips_endpoint": {
+							Type:         nullable.TypeNullableBool,
+							Optional:     true,
+							ValidateFunc: nullable.ValidateTypeStringNullableBool,
+							Description:  "Resolve an endpoint with FIPS capability for the service",
+						},
+					},
+				},
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -228,6 +306,54 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with rules that resource tags must satisfy across all resources.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tag keys that must be present on all taggable resources.",
+						},
+						"tag": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Constraints on the value of a resource tag.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"allowed_values": {
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "Values the resource tag may have.",
+									},
+									names.AttrKey: {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Resource tag key the constraints apply to.",
+									},
+									"value_regex": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsValidRegExp,
+										Description:  "Regular expression the resource tag value must match.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"test_mode": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Relax validations for use with local AWS emulators such as LocalStack and S3-compatible APIs. " +
+					"Implies `skip_credentials_validation`, `skip_region_validation`, `skip_requesting_account_id` and `s3_use_path_style`.",
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -290,7 +416,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx).ForResource(typeName), v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
 				}
 
@@ -367,7 +493,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
 				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig(ctx).ForResource(typeName), v.IgnoreTagsConfig)
 					ctx = v.RegisterLogger(ctx)
 				}
 
@@ -477,6 +603,9 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 
 	config := conns.Config{
 		AccessKey:                      d.Get("access_key").(string),
+		AuditLogFile:                   d.Get("audit_log_file").(string),
+		CheckServiceQuotas:             d.Get("check_service_quotas").(bool),
+		CheckServiceQuotasMode:         d.Get("check_service_quotas_mode").(string),
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
@@ -492,6 +621,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
 		STSRegion:                      d.Get("sts_region").(string),
 		TerraformVersion:               terraformVersion,
+		TestMode:                       d.Get("test_mode").(bool),
 		Token:                          d.Get("token").(string),
 		TokenBucketRateLimiterCapacity: d.Get("token_bucket_rate_limiter_capacity").(int),
 		UseDualStackEndpoint:           d.Get("use_dualstack_endpoint").(bool),
@@ -536,6 +666,14 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("deletion_protection"); ok && len(v.([]interface{})) > 0 {
+		rules, err := expandDeletionProtectionRules(v.([]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "expanding deletion_protection: %s", err)
+		}
+		config.DeletionProtectionRules = rules
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -567,6 +705,22 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.IgnoreTagsConfig = expandIgnoreTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		policyConfig, err := expandTagPolicy(v.([]interface{})[0].(map[string]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "expanding tag_policy: %s", err)
+		}
+		config.TagPolicyConfig = policyConfig
+	}
+
+	if v, ok := d.GetOk("service_endpoint_options"); ok && len(v.([]interface{})) > 0 {
+		endpointOptions, err := expandServiceEndpointOptions(ctx, v.([]interface{}))
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "expanding service_endpoint_options: %s", err)
+		}
+		config.ServiceEndpointOptions = endpointOptions
+	}
+
 	if v, ok := d.GetOk("max_retries"); ok {
 		config.MaxRetries = v.(int)
 	}
@@ -600,6 +754,17 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		return nil, diags
 	}
 
+	// Release the client's resources, such as the audit log file, when Terraform stops the provider.
+	if stopCtx, ok := schema.StopContext(ctx); ok { //nolint:staticcheck // The stop context is the only provider stop hook.
+		context.AfterFunc(stopCtx, func() {
+			if err := meta.Close(); err != nil {
+				tflog.Warn(ctx, "closing AWS client", map[string]any{
+					"error": err.Error(),
+				})
+			}
+		})
+	}
+
 	return meta, diags
 }
 
@@ -845,6 +1010,14 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 
 	defaultConfig := &tftags.DefaultConfig{}
 
+	if v, ok := tfMap["case_insensitive_keys"].(bool); ok {
+		defaultConfig.CaseInsensitiveKeys = v
+	}
+
+	if v, ok := tfMap["excluded_resources"].(*schema.Set); ok {
+		defaultConfig.ExcludedResources = flex.ExpandStringValueSet(v)
+	}
+
 	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
@@ -852,6 +1025,33 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return defaultConfig
 }
 
+func expandDeletionProtectionRules(tfList []interface{}) ([]conns.DeletionProtectionRule, error) {
+	var rules []conns.DeletionProtectionRule
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		rule := conns.DeletionProtectionRule{
+			ResourceType: tfMap["resource_type"].(string),
+		}
+
+		if v, ok := tfMap["name_patterns"].(*schema.Set); ok && v.Len() > 0 {
+			rule.NamePatterns = flex.ExpandStringValueSet(v)
+		}
+
+		if err := rule.Validate(); err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	if tfMap == nil {
 		return nil
@@ -870,6 +1070,103 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandTagPolicy(tfMap map[string]interface{}) (*tftags.PolicyConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{}
+
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok {
+		policyConfig.RequiredKeys = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["tag"].([]interface{}); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			rule := tftags.PolicyRule{
+				Key: tfMap[names.AttrKey].(string),
+			}
+
+			if v, ok := tfMap["allowed_values"].(*schema.Set); ok {
+				rule.AllowedValues = flex.ExpandStringValueSet(v)
+			}
+
+			if v, ok := tfMap["value_regex"].(string); ok && v != "" {
+				re, err := regexp.Compile(v)
+				if err != nil {
+					return nil, fmt.Errorf("tag %q value_regex: %w", rule.Key, err)
+				}
+				rule.ValueRegex = re
+			}
+
+			policyConfig.Rules = append(policyConfig.Rules, rule)
+		}
+	}
+
+	return policyConfig, nil
+}
+
+func expandServiceEndpointOptions(_ context.Context, tfList []interface{}) (map[string]conns.ServiceEndpointOptions, error) {
+	endpointOptions := make(map[string]conns.ServiceEndpointOptions)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		pkg, err := names.ProviderPackageForAlias(tfMap["service"].(string))
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := endpointOptions[pkg]; ok {
+			return nil, fmt.Errorf("duplicate settings for service %q", pkg)
+		}
+
+		options := conns.ServiceEndpointOptions{}
+
+		if v, null, _ := nullable.Bool(tfMap["use_dualstack_endpoint"].(string)).ValueBool(); !null {
+			if v {
+				options.UseDualStackEndpoint = aws.DualStackEndpointStateEnabled
+			} else {
+				options.UseDualStackEndpoint = aws.DualStackEndpointStateDisabled
+			}
+		}
+
+		if v, null, _ := nullable.Bool(tfMap["use_fips_endpoint"].(string)).ValueBool(); !null {
+			if v {
+				options.UseFIPSEndpoint = aws.FIPSEndpointStateEnabled
+			} else {
+				options.UseFIPSEndpoint = aws.FIPSEndpointStateDisabled
+			}
+		}
+
+		endpointOptions[pkg] = options
+	}
+
+	return endpointOptions, nil
+}
+
+func validServiceEndpointOptionsService(v interface{}, k string) (ws []string, es []error) {
+	value, ok := v.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := names.ProviderPackageForAlias(value); err != nil {
+		es = append(es, fmt.Errorf("%s: %w", k, err))
+	}
+
+	return
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
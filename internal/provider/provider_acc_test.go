@@ -11,6 +11,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
 	sts_sdkv2 "github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/aws-sdk-go/aws"
@@ -394,6 +395,111 @@ func TestAccProvider_IgnoreTagsKeys_multiple(t *testing.T) {
 	})
 }
 
+func TestAccProvider_DefaultTags_excludedResources(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig_defaultTagsExcludedResources(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Name", rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProvider_DefaultTags_caseInsensitiveKeys(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProviderConfig_defaultTagsCaseInsensitiveKeys(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.Name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.environment", "resource"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProvider_TagPolicy_requiredKeys(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig_tagPolicyRequiredKeys(rName),
+				ExpectError: regexache.MustCompile(`required tag "Owner" is missing`),
+			},
+		},
+	})
+}
+
+func TestAccProvider_TagPolicy_requiredKeysIgnored(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:             testAccProviderConfig_tagPolicyRequiredKeysIgnored(rName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccProvider_TagPolicy_allowedValues(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderConfig_tagPolicyAllowedValues(rName, "qa"),
+				ExpectError: regexache.MustCompile(`tag "Environment" value "qa" is not one of the allowed values`),
+			},
+			{
+				Config:             testAccProviderConfig_tagPolicyAllowedValues(rName, "dev"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAccProvider_Region_c2s(t *testing.T) {
 	ctx := acctest.Context(t)
 	var provider *schema.Provider
@@ -818,7 +924,7 @@ func testAccCheckProviderDefaultTags_Tags(ctx context.Context, t *testing.T, p *
 		}
 
 		providerClient := (*p).Meta().(*conns.AWSClient)
-		defaultTagsConfig := providerClient.DefaultTagsConfig
+		defaultTagsConfig := providerClient.DefaultTagsConfig(ctx)
 
 		if defaultTagsConfig == nil || len(defaultTagsConfig.Tags) == 0 {
 			if len(expectedTags) != 0 {
@@ -1118,6 +1224,126 @@ provider "aws" {
 `, tag1, tag2))
 }
 
+func testAccProviderConfig_defaultTagsExcludedResources(rName string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    excluded_resources = ["aws_vpc"]
+
+    tags = {
+      Environment = "provider"
+    }
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccProviderConfig_defaultTagsCaseInsensitiveKeys(rName string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    case_insensitive_keys = true
+
+    tags = {
+      Environment = "provider"
+    }
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name        = %[1]q
+    environment = "resource"
+  }
+}
+`, rName)
+}
+
+func testAccProviderConfig_tagPolicyRequiredKeys(rName string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  tag_policy {
+    required_keys = ["Owner"]
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccProviderConfig_tagPolicyRequiredKeysIgnored(rName string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  ignore_tags {
+    keys = ["Owner"]
+  }
+
+  tag_policy {
+    required_keys = ["Owner"]
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name  = %[1]q
+    Owner = "test"
+  }
+}
+`, rName)
+}
+
+func testAccProviderConfig_tagPolicyAllowedValues(rName, environment string) string {
+	//lintignore:AT004
+	return fmt.Sprintf(`
+provider "aws" {
+  default_tags {
+    tags = {
+      Environment = %[2]q
+    }
+  }
+
+  tag_policy {
+    required_keys = ["Environment"]
+
+    tag {
+      key            = "Environment"
+      allowed_values = ["dev", "prod"]
+    }
+  }
+}
+
+resource "aws_vpc" "test" {
+  cidr_block = "10.1.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, environment)
+}
+
 func testAccProviderConfig_ignoreTagsKeyPrefixes0() string {
 	//lintignore:AT004
 	return acctest.ConfigCompose(testAccProviderConfig_base, `
@@ -9,9 +9,11 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -59,6 +61,47 @@ func TestExpandEndpoints(t *testing.T) { //nolint:paralleltest
 	}
 }
 
+func TestExpandServiceEndpointOptions(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tfList := []interface{}{
+		map[string]interface{}{
+			"service":                "es",
+			"use_dualstack_endpoint": "",
+			"use_fips_endpoint":      "false",
+		},
+		map[string]interface{}{
+			"service":                "sqs",
+			"use_dualstack_endpoint": "true",
+			"use_fips_endpoint":      "",
+		},
+	}
+
+	results, err := expandServiceEndpointOptions(ctx, tfList)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]conns.ServiceEndpointOptions{
+		names.Elasticsearch: {
+			UseFIPSEndpoint: aws.FIPSEndpointStateDisabled,
+		},
+		names.SQS: {
+			UseDualStackEndpoint: aws.DualStackEndpointStateEnabled,
+		},
+	}
+
+	if diff := cmp.Diff(results, expected); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	_, err = expandServiceEndpointOptions(ctx, append(tfList, tfList[0]))
+	if err == nil {
+		t.Error("expected error for duplicate service, got none")
+	}
+}
+
 func TestEndpointMultipleKeys(t *testing.T) { //nolint:paralleltest
 	ctx := context.Background()
 	testcases := []struct {
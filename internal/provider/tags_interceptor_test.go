@@ -74,13 +74,13 @@ func TestTagsResourceInterceptor(t *testing.T) {
 		interceptor: tags,
 	})
 
+	defaultTagsConfig := expandDefaultTags(context.Background(), map[string]interface{}{
+		"tag": "",
+	})
 	conn := &conns.AWSClient{
 		ServicePackages: map[string]conns.ServicePackage{
 			"Test": &mockService{},
 		},
-		DefaultTagsConfig: expandDefaultTags(context.Background(), map[string]interface{}{
-			"tag": "",
-		}),
 		IgnoreTagsConfig: expandIgnoreTags(context.Background(), map[string]interface{}{
 			"tag2": "tag",
 		}),
@@ -89,7 +89,7 @@ func TestTagsResourceInterceptor(t *testing.T) {
 	bootstrapContext := func(ctx context.Context, meta any) context.Context {
 		ctx = conns.NewResourceContext(ctx, "Test", "aws_test")
 		if v, ok := meta.(*conns.AWSClient); ok {
-			ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+			ctx = tftags.NewContext(ctx, defaultTagsConfig, v.IgnoreTagsConfig)
 		}
 
 		return ctx
@@ -25,6 +25,10 @@ func TestAccAccount_serial(t *testing.T) {
 			acctest.CtBasic: testAccRegion_basic,
 			"AccountID":     testAccRegion_accountID,
 		},
+		"RegionsDataSource": {
+			acctest.CtBasic: testAccRegionsDataSource_basic,
+			"AccountID":     testAccRegionsDataSource_accountID,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
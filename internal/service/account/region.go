@@ -88,7 +88,22 @@ func resourceRegionUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		timeout = d.Timeout(schema.TimeoutUpdate)
 	}
 
-	if v := d.Get(names.AttrEnabled).(bool); v {
+	// An opt-in Region can't be enabled while it's being disabled (or vice versa).
+	// Wait for any in-progress transition to settle before requesting a new one.
+	output, err := waitRegionOptStatusStable(ctx, conn, accountID, region, timeout)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Account Region (%s) opt status: %s", id, err)
+	}
+
+	switch enabled, status := d.Get(names.AttrEnabled).(bool), output.RegionOptStatus; {
+	case status == types.RegionOptStatusEnabledByDefault:
+		if !enabled {
+			return sdkdiag.AppendErrorf(diags, "disabling Account Region (%s): Region is enabled by default and can't be disabled", id)
+		}
+	case enabled && status == types.RegionOptStatusEnabled, !enabled && status == types.RegionOptStatusDisabled:
+		// Nothing to do.
+	case enabled:
 		input := &account.EnableRegionInput{
 			RegionName: aws.String(region),
 		}
@@ -96,16 +111,18 @@ func resourceRegionUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.AccountId = aws.String(accountID)
 		}
 
-		_, err := conn.EnableRegion(ctx, input)
+		_, err := tfresource.RetryWhenIsA[*types.ConflictException](ctx, timeout, func() (interface{}, error) {
+			return conn.EnableRegion(ctx, input)
+		})
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "enabling Account Region (%s): %s", id, err)
 		}
 
 		if _, err := waitRegionEnabled(ctx, conn, accountID, region, timeout); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Account Region (%s) enable: %s", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "waiting for Account Region (%s) enable: %s", id, err)
 		}
-	} else {
+	default:
 		input := &account.DisableRegionInput{
 			RegionName: aws.String(region),
 		}
@@ -113,14 +130,16 @@ func resourceRegionUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			input.AccountId = aws.String(accountID)
 		}
 
-		_, err := conn.DisableRegion(ctx, input)
+		_, err := tfresource.RetryWhenIsA[*types.ConflictException](ctx, timeout, func() (interface{}, error) {
+			return conn.DisableRegion(ctx, input)
+		})
 
 		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "enabling Account Region (%s): %s", id, err)
+			return sdkdiag.AppendErrorf(diags, "disabling Account Region (%s): %s", id, err)
 		}
 
 		if _, err := waitRegionDisabled(ctx, conn, accountID, region, timeout); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Account Region (%s) disable: %s", d.Id(), err)
+			return sdkdiag.AppendErrorf(diags, "waiting for Account Region (%s) disable: %s", id, err)
 		}
 	}
 
@@ -199,6 +218,24 @@ func statusRegionOptStatus(ctx context.Context, conn *account.Client, accountID,
 	}
 }
 
+func waitRegionOptStatusStable(ctx context.Context, conn *account.Client, accountID, region string, timeout time.Duration) (*account.GetRegionOptStatusOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:      enum.Slice(types.RegionOptStatusEnabling, types.RegionOptStatusDisabling),
+		Target:       enum.Slice(types.RegionOptStatusEnabled, types.RegionOptStatusEnabledByDefault, types.RegionOptStatusDisabled),
+		Refresh:      statusRegionOptStatus(ctx, conn, accountID, region),
+		Timeout:      timeout,
+		PollInterval: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*account.GetRegionOptStatusOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 func waitRegionEnabled(ctx context.Context, conn *account.Client, accountID, region string, timeout time.Duration) (*account.GetRegionOptStatusOutput, error) {
 	stateConf := &retry.StateChangeConf{
 		Pending:      enum.Slice(types.RegionOptStatusEnabling),
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package account
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/account"
+	"github.com/aws/aws-sdk-go-v2/service/account/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_account_regions", name="Regions")
+func dataSourceRegions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceRegionsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAccountID: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"region_opt_status_contains": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[types.RegionOptStatus](),
+				},
+			},
+			"regions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"opt_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRegionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccountClient(ctx)
+
+	input := &account.ListRegionsInput{}
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	if v, ok := d.GetOk(names.AttrAccountID); ok {
+		accountID = v.(string)
+		input.AccountId = aws.String(accountID)
+	}
+
+	if v, ok := d.GetOk("region_opt_status_contains"); ok && v.(*schema.Set).Len() > 0 {
+		input.RegionOptStatusContains = flex.ExpandStringyValueSet[types.RegionOptStatus](v.(*schema.Set))
+	}
+
+	output, err := findRegions(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Account Regions (%s): %s", accountID, err)
+	}
+
+	d.SetId(accountID)
+	if err := d.Set("regions", flattenRegions(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting regions: %s", err)
+	}
+
+	return diags
+}
+
+func findRegions(ctx context.Context, conn *account.Client, input *account.ListRegionsInput) ([]types.Region, error) {
+	var output []types.Region
+
+	pages := account.NewListRegionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Regions...)
+	}
+
+	return output, nil
+}
+
+func flattenRegions(apiObjects []types.Region) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"opt_status":  string(apiObject.RegionOptStatus),
+			"region_name": aws.ToString(apiObject.RegionName),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package account_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccRegionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_account_regions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccountServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegionsDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "regions.#", 0),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "regions.*", map[string]string{
+						"opt_status":  "ENABLED_BY_DEFAULT",
+						"region_name": names.USEast1RegionID,
+					}),
+				),
+			},
+			{
+				Config: testAccRegionsDataSourceConfig_optStatus,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "regions.#", 0),
+					resource.TestCheckResourceAttr(dataSourceName, "regions.0.opt_status", "ENABLED_BY_DEFAULT"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRegionsDataSource_accountID(t *testing.T) { // nosemgrep:ci.account-in-func-name
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_account_regions.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccountServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRegionsDataSourceConfig_organization(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrAccountID, "data.aws_caller_identity.test", names.AttrAccountID),
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "regions.#", 0),
+				),
+			},
+		},
+	})
+}
+
+const testAccRegionsDataSourceConfig_basic = `
+data "aws_account_regions" "test" {}
+`
+
+const testAccRegionsDataSourceConfig_optStatus = `
+data "aws_account_regions" "test" {
+  region_opt_status_contains = ["ENABLED_BY_DEFAULT"]
+}
+`
+
+func testAccRegionsDataSourceConfig_organization() string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), `
+data "aws_caller_identity" "test" {
+  provider = "awsalternate"
+}
+
+data "aws_account_regions" "test" {
+  account_id = data.aws_caller_identity.test.account_id
+}
+`)
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/amp"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/amp/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -36,6 +37,7 @@ import (
 
 // @FrameworkResource("aws_prometheus_scraper", name="Scraper")
 // @Tags(identifierAttribute="arn")
+// @ARNIdentity
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/amp/types;types.ScraperDescription")
 func newScraperResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &scraperResource{}
@@ -396,6 +398,16 @@ func (r *scraperResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 	r.SetTagsAll(ctx, req, resp)
 }
 
+func (r *scraperResource) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "aps", "scraper/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return parts[0], nil
+}
+
 type scraperResourceModel struct {
 	Alias               types.String                                             `tfsdk:"alias"`
 	ARN                 types.String                                             `tfsdk:"arn"`
@@ -28,6 +28,7 @@ var (
 	ResourceStage                = resourceStage
 	ResourceUsagePlan            = resourceUsagePlan
 	ResourceUsagePlanKey         = resourceUsagePlanKey
+	ResourceUsagePlanKeys        = resourceUsagePlanKeys
 	ResourceVPCLink              = resourceVPCLink
 
 	DefaultAuthorizerTTL                 = defaultAuthorizerTTL
@@ -52,5 +53,7 @@ var (
 	FindStageByTwoPartKey                = findStageByTwoPartKey
 	FindUsagePlanByID                    = findUsagePlanByID
 	FindUsagePlanKeyByTwoPartKey         = findUsagePlanKeyByTwoPartKey
+	FindUsagePlanKeysByUsagePlanID       = findUsagePlanKeysByUsagePlanID
 	FindVPCLinkByID                      = findVPCLinkByID
+	RestAPIBodyChanges                   = restAPIBodyChanges
 )
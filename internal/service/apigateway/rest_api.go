@@ -5,8 +5,11 @@ package apigateway
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -17,6 +20,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -31,6 +35,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"gopkg.in/yaml.v2"
 )
 
 // @SDKResource("aws_api_gateway_rest_api", name="REST API")
@@ -71,6 +76,11 @@ func resourceRestAPI() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"body_changes": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			names.AttrCreatedDate: {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -174,7 +184,10 @@ func resourceRestAPI() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			restAPIBodyChangesCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -298,6 +311,10 @@ func resourceRestAPIRead(ctx context.Context, d *schema.ResourceData, meta inter
 	d.Set("api_key_source", api.ApiKeySource)
 	d.Set(names.AttrARN, apiARN(meta.(*conns.AWSClient), d.Id()))
 	d.Set("binary_media_types", api.BinaryMediaTypes)
+	// body_changes only describes a change being applied. Clear it on refresh.
+	if !d.HasChange("body") {
+		d.Set("body_changes", nil)
+	}
 	d.Set(names.AttrCreatedDate, api.CreatedDate.Format(time.RFC3339))
 	d.Set(names.AttrDescription, api.Description)
 	d.Set("disable_execute_api_endpoint", api.DisableExecuteApiEndpoint)
@@ -799,3 +816,142 @@ func apiInvokeARN(c *conns.AWSClient, apiID string) string {
 		Resource:  apiID,
 	}.String()
 }
+
+// restAPIBodyChangesCustomizeDiff previews the operations that a change to the OpenAPI body adds, removes or modifies.
+func restAPIBodyChangesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("body") {
+		return nil
+	}
+
+	// The changes can't be summarized until the new definition is known.
+	if !d.NewValueKnown("body") {
+		return d.SetNewComputed("body_changes")
+	}
+
+	o, n := d.GetChange("body")
+
+	return d.SetNew("body_changes", restAPIBodyChanges(o.(string), n.(string)))
+}
+
+// openAPIIntegrationExtension is the OpenAPI extension that defines an operation's API Gateway integration.
+const openAPIIntegrationExtension = "x-amazon-apigateway-integration"
+
+// restAPIBodyChanges returns a sorted summary of the operations that differ between two OpenAPI definitions.
+// Entries have the form "+ GET /pets" (added), "- GET /pets" (removed) or "~ GET /pets (integration)" (modified).
+// Definitions that cannot be parsed are treated as having no operations.
+func restAPIBodyChanges(oldBody, newBody string) []string {
+	oldOperations, newOperations := openAPIOperations(oldBody), openAPIOperations(newBody)
+	changes := make([]string, 0)
+
+	for k := range oldOperations {
+		if _, ok := newOperations[k]; !ok {
+			changes = append(changes, "- "+k)
+		}
+	}
+
+	for k, newOperation := range newOperations {
+		oldOperation, ok := oldOperations[k]
+		if !ok {
+			changes = append(changes, "+ "+k)
+			continue
+		}
+
+		var parts []string
+		if !reflect.DeepEqual(openAPIWithout(oldOperation, openAPIIntegrationExtension), openAPIWithout(newOperation, openAPIIntegrationExtension)) {
+			parts = append(parts, "method")
+		}
+		if !reflect.DeepEqual(oldOperation[openAPIIntegrationExtension], newOperation[openAPIIntegrationExtension]) {
+			parts = append(parts, "integration")
+		}
+
+		if len(parts) > 0 {
+			changes = append(changes, fmt.Sprintf("~ %s (%s)", k, strings.Join(parts, ", ")))
+		}
+	}
+
+	slices.SortFunc(changes, func(a, b string) int {
+		// Sort by path and method, ignoring the change marker.
+		return strings.Compare(restAPIBodyChangeSortKey(a), restAPIBodyChangeSortKey(b))
+	})
+
+	return changes
+}
+
+func restAPIBodyChangeSortKey(change string) string {
+	method, path, _ := strings.Cut(change[2:], " ")
+
+	return path + " " + method
+}
+
+// openAPIOperations returns the operations of a JSON or YAML OpenAPI definition, keyed by "METHOD /path".
+func openAPIOperations(body string) map[string]map[string]interface{} {
+	operations := make(map[string]map[string]interface{})
+
+	var document interface{}
+	if err := json.Unmarshal([]byte(body), &document); err != nil {
+		if err := yaml.Unmarshal([]byte(body), &document); err != nil {
+			return operations
+		}
+		document = openAPINormalize(document)
+	}
+
+	paths := openAPIObject(openAPIObject(document)["paths"])
+	for path, v := range paths {
+		for method, v := range openAPIObject(v) {
+			operation := openAPIObject(v)
+			if operation == nil {
+				continue
+			}
+
+			switch method = strings.ToUpper(method); method {
+			case "X-AMAZON-APIGATEWAY-ANY-METHOD":
+				method = "ANY"
+			case "DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT", "TRACE":
+			default:
+				// Path-level fields such as parameters and summary.
+				continue
+			}
+
+			operations[method+" "+path] = operation
+		}
+	}
+
+	return operations
+}
+
+// openAPIObject returns the value as an object, or nil if the value is not an object.
+func openAPIObject(v interface{}) map[string]interface{} {
+	m, _ := v.(map[string]interface{})
+
+	return m
+}
+
+// openAPINormalize converts the map[interface{}]interface{} values produced by the YAML decoder to map[string]interface{}.
+func openAPINormalize(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, v := range v {
+			m[fmt.Sprint(k)] = openAPINormalize(v)
+		}
+		return m
+	case []interface{}:
+		for i, e := range v {
+			v[i] = openAPINormalize(e)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func openAPIWithout(m map[string]interface{}, key string) map[string]interface{} {
+	out := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if k != key {
+			out[k] = v
+		}
+	}
+
+	return out
+}
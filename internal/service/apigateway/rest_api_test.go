@@ -12,6 +12,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
 	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -23,6 +25,55 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestRestAPIBodyChanges(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oldBody, newBody string
+		expected         []string
+	}{
+		"no change": {
+			oldBody: `{"openapi":"3.0.1","paths":{"/pets":{"get":{}}}}`,
+			newBody: `{"openapi":"3.0.1","paths":{"/pets":{"get":{}}}}`,
+		},
+		"added": {
+			oldBody: "",
+			newBody: `{"openapi":"3.0.1","paths":{"/pets":{"get":{},"post":{}}}}`,
+			expected: []string{
+				"+ GET /pets",
+				"+ POST /pets",
+			},
+		},
+		"removed and modified": {
+			oldBody: `{"openapi":"3.0.1","paths":{"/pets":{"get":{"x-amazon-apigateway-integration":{"type":"MOCK"}}},"/owners":{"x-amazon-apigateway-any-method":{}}}}`,
+			newBody: `{"openapi":"3.0.1","paths":{"/pets":{"get":{"x-amazon-apigateway-integration":{"type":"HTTP_PROXY"}}}}}`,
+			expected: []string{
+				"- ANY /owners",
+				"~ GET /pets (integration)",
+			},
+		},
+		"yaml": {
+			oldBody: "openapi: 3.0.1\npaths:\n  /pets:\n    get:\n      summary: list\n",
+			newBody: "openapi: 3.0.1\npaths:\n  /pets:\n    get:\n      summary: list all\n",
+			expected: []string{
+				"~ GET /pets (method)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tfapigateway.RestAPIBodyChanges(testCase.oldBody, testCase.newBody)
+
+			if diff := cmp.Diff(got, testCase.expected, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
 func TestAccAPIGatewayRestAPI_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf apigateway.GetRestApiOutput
@@ -284,7 +335,7 @@ func TestAccAPIGatewayRestAPI_APIKeySource_overrideBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 			// Verify updated API key source still overrides
 			{
@@ -329,7 +380,7 @@ func TestAccAPIGatewayRestAPI_APIKeySource_setByBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 		},
 	})
@@ -359,7 +410,7 @@ func TestAccAPIGatewayRestAPI_binaryMediaTypes(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 			{
 				Config: testAccRestAPIConfig_binaryMediaTypes1(rName, "application/octet"),
@@ -397,7 +448,7 @@ func TestAccAPIGatewayRestAPI_BinaryMediaTypes_overrideBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 			// Verify updated minimum compression size still overrides
 			{
@@ -445,7 +496,7 @@ func TestAccAPIGatewayRestAPI_BinaryMediaTypes_setByBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 		},
 	})
@@ -471,6 +522,8 @@ func TestAccAPIGatewayRestAPI_body(t *testing.T) {
 					testAccCheckRestAPIRoutes(ctx, &conf, []string{"/", "/test"}),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, "body_changes.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "body_changes.0", "+ GET /test"),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedDate),
 					resource.TestCheckResourceAttrSet(resourceName, "execution_arn"),
 				),
@@ -479,7 +532,7 @@ func TestAccAPIGatewayRestAPI_body(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 			{
 				Config: testAccRestAPIConfig_body(rName, "/update"),
@@ -487,10 +540,20 @@ func TestAccAPIGatewayRestAPI_body(t *testing.T) {
 					testAccCheckRESTAPIExists(ctx, resourceName, &conf),
 					testAccCheckRestAPIRoutes(ctx, &conf, []string{"/", "/update"}),
 					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "body_changes.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "body_changes.0", "- GET /test"),
+					resource.TestCheckResourceAttr(resourceName, "body_changes.1", "+ GET /update"),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedDate),
 					resource.TestCheckResourceAttrSet(resourceName, "execution_arn"),
 				),
 			},
+			{
+				// body_changes is cleared once the change has been applied.
+				Config: testAccRestAPIConfig_body(rName, "/update"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "body_changes.#", acctest.Ct0),
+				),
+			},
 		},
 	})
 }
@@ -518,7 +581,7 @@ func TestAccAPIGatewayRestAPI_description(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 			{
 				Config: testAccRestAPIConfig_description(rName, "description2"),
@@ -554,7 +617,7 @@ func TestAccAPIGatewayRestAPI_Description_overrideBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 			// Verify updated description still overrides
 			{
@@ -599,7 +662,7 @@ func TestAccAPIGatewayRestAPI_Description_setByBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 		},
 	})
@@ -667,7 +730,7 @@ func TestAccAPIGatewayRestAPI_DisableExecuteAPIEndpoint_overrideBody(t *testing.
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 			// Verify override can be unset (only for body set to false)
 			{
@@ -712,7 +775,7 @@ func TestAccAPIGatewayRestAPI_DisableExecuteAPIEndpoint_setByBody(t *testing.T)
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 		},
 	})
@@ -747,7 +810,7 @@ func TestAccAPIGatewayRestAPI_Endpoint_vpcEndpointIDs(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 			{
 				Config: testAccRestAPIConfig_endpointConfigurationVPCEndpointIds2(rName),
@@ -805,7 +868,7 @@ func TestAccAPIGatewayRestAPI_EndpointVPCEndpointIDs_overrideBody(t *testing.T)
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 			// Verify updated configuration value still overrides
 			{
@@ -862,7 +925,7 @@ func TestAccAPIGatewayRestAPI_EndpointVPCEndpointIDs_mergeBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 
 			// Verify updated endpoint configuration, and endpoint from OAS is discarded.
@@ -919,7 +982,7 @@ func TestAccAPIGatewayRestAPI_EndpointVPCEndpointIDs_overrideToMergeBody(t *test
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 
 			// Add the new attribute and verify works as desired.
@@ -937,7 +1000,7 @@ func TestAccAPIGatewayRestAPI_EndpointVPCEndpointIDs_overrideToMergeBody(t *test
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 		},
 	})
@@ -969,7 +1032,7 @@ func TestAccAPIGatewayRestAPI_EndpointVPCEndpointIDs_setByBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 		},
 	})
@@ -998,7 +1061,7 @@ func TestAccAPIGatewayRestAPI_minimumCompressionSize(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 			{
 				Config: testAccRestAPIConfig_minimumCompressionSize(rName, "-1"), // -1 removes existing values
@@ -1041,7 +1104,7 @@ func TestAccAPIGatewayRestAPI_MinimumCompressionSize_overrideBody(t *testing.T)
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 			// Verify updated minimum compression size still overrides
 			{
@@ -1086,7 +1149,7 @@ func TestAccAPIGatewayRestAPI_MinimumCompressionSize_setByBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 		},
 	})
@@ -1116,7 +1179,7 @@ func TestAccAPIGatewayRestAPI_Name_overrideBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 			// Verify updated name still overrides
 			{
@@ -1168,7 +1231,7 @@ func TestAccAPIGatewayRestAPI_FailOnWarnings(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 			// Verify invalid body fails update, when fail_on_warnings is true
 			{
@@ -1352,7 +1415,7 @@ func TestAccAPIGatewayRestAPI_Policy_setByBody(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"body", "put_rest_api_mode"},
+				ImportStateVerifyIgnore: []string{"body", "body_changes", "put_rest_api_mode"},
 			},
 		},
 	})
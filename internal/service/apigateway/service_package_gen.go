@@ -65,6 +65,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_api_gateway_sdk",
 			Name:     "SDK",
 		},
+		{
+			Factory:  dataSourceUsage,
+			TypeName: "aws_api_gateway_usage",
+			Name:     "Usage",
+		},
 		{
 			Factory:  dataSourceVPCLink,
 			TypeName: "aws_api_gateway_vpc_link",
@@ -209,6 +214,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_api_gateway_usage_plan_key",
 			Name:     "Usage Plan Key",
 		},
+		{
+			Factory:  resourceUsagePlanKeys,
+			TypeName: "aws_api_gateway_usage_plan_keys",
+			Name:     "Usage Plan Keys",
+		},
 		{
 			Factory:  resourceVPCLink,
 			TypeName: "aws_api_gateway_vpc_link",
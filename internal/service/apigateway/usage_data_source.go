@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_api_gateway_usage", name="Usage")
+func dataSourceUsage() *schema.Resource {
+	validDate := validation.StringMatch(regexache.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "must be a date in the format YYYY-MM-DD")
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceUsageRead,
+
+		Schema: map[string]*schema.Schema{
+			"end_date": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validDate,
+			},
+			"items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrKeyID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"usage": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"date": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"remaining": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+									"used": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrKeyID: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"start_date": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validDate,
+			},
+			"usage_plan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceUsageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	usagePlanID := d.Get("usage_plan_id").(string)
+	startDate, endDate := d.Get("start_date").(string), d.Get("end_date").(string)
+	input := &apigateway.GetUsageInput{
+		EndDate:     aws.String(endDate),
+		StartDate:   aws.String(startDate),
+		UsagePlanId: aws.String(usagePlanID),
+	}
+
+	id := usagePlanID + ":" + startDate + ":" + endDate
+
+	if v, ok := d.GetOk(names.AttrKeyID); ok {
+		input.KeyId = aws.String(v.(string))
+		id += ":" + v.(string)
+	}
+
+	items, err := findUsage(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway Usage Plan (%s) usage: %s", usagePlanID, err)
+	}
+
+	tfList, err := flattenUsageItems(items, startDate)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+	if err := d.Set("items", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting items: %s", err)
+	}
+
+	return diags
+}
+
+func findUsage(ctx context.Context, conn *apigateway.Client, input *apigateway.GetUsageInput) (map[string][][]int64, error) {
+	output := make(map[string][][]int64)
+
+	pages := apigateway.NewGetUsagePaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for k, v := range page.Items {
+			output[k] = append(output[k], v...)
+		}
+	}
+
+	return output, nil
+}
+
+// flattenUsageItems converts the GetUsage items map, which holds a [used, remaining]
+// pair for each day starting at startDate, into a list sorted by API key ID.
+func flattenUsageItems(apiObject map[string][][]int64, startDate string) ([]interface{}, error) {
+	start, err := time.Parse(time.DateOnly, startDate)
+
+	if err != nil {
+		return nil, err
+	}
+
+	keyIDs := tfmaps.Keys(apiObject)
+	slices.Sort(keyIDs)
+
+	tfList := make([]interface{}, 0, len(keyIDs))
+	for _, keyID := range keyIDs {
+		var usage []interface{}
+
+		for i, v := range apiObject[keyID] {
+			tfMap := map[string]interface{}{
+				"date": start.AddDate(0, 0, i).Format(time.DateOnly),
+			}
+
+			if len(v) > 0 {
+				tfMap["used"] = int(v[0])
+			}
+			if len(v) > 1 {
+				tfMap["remaining"] = int(v[1])
+			}
+
+			usage = append(usage, tfMap)
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrKeyID: keyID,
+			"usage":         usage,
+		})
+	}
+
+	return tfList, nil
+}
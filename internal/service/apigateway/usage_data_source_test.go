@@ -0,0 +1,51 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAPIGatewayUsageDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_api_gateway_usage.test"
+	endDate := time.Now().UTC().Format(time.DateOnly)
+	startDate := time.Now().UTC().AddDate(0, 0, -1).Format(time.DateOnly)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsageDataSourceConfig_basic(rName, startDate, endDate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "end_date", endDate),
+					resource.TestCheckResourceAttrSet(dataSourceName, "items.#"),
+					resource.TestCheckResourceAttr(dataSourceName, "start_date", startDate),
+					resource.TestCheckResourceAttrPair(dataSourceName, "usage_plan_id", "aws_api_gateway_usage_plan.test", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+func testAccUsageDataSourceConfig_basic(rName, startDate, endDate string) string {
+	return acctest.ConfigCompose(testAccUsagePlanKeysConfig_basic(rName, 1), fmt.Sprintf(`
+data "aws_api_gateway_usage" "test" {
+  usage_plan_id = aws_api_gateway_usage_plan_keys.test.usage_plan_id
+  key_id        = aws_api_gateway_api_key.test[0].id
+  start_date    = %[1]q
+  end_date      = %[2]q
+}
+`, startDate, endDate))
+}
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	usagePlanKeyTypeAPIKey = "API_KEY"
+)
+
+// @SDKResource("aws_api_gateway_usage_plan_keys", name="Usage Plan Keys")
+func resourceUsagePlanKeys() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceUsagePlanKeysCreate,
+		ReadWithoutTimeout:   resourceUsagePlanKeysRead,
+		UpdateWithoutTimeout: resourceUsagePlanKeysUpdate,
+		DeleteWithoutTimeout: resourceUsagePlanKeysDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("usage_plan_id", d.Id())
+
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"usage_plan_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceUsagePlanKeysCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	usagePlanID := d.Get("usage_plan_id").(string)
+	keyIDs := flex.ExpandStringValueSet(d.Get("key_ids").(*schema.Set))
+
+	if err := createUsagePlanKeys(ctx, conn, usagePlanID, keyIDs); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating API Gateway Usage Plan Keys (%s): %s", usagePlanID, err)
+	}
+
+	d.SetId(usagePlanID)
+
+	return append(diags, resourceUsagePlanKeysRead(ctx, d, meta)...)
+}
+
+func resourceUsagePlanKeysRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	keys, err := findUsagePlanKeysByUsagePlanID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] API Gateway Usage Plan Keys (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading API Gateway Usage Plan Keys (%s): %s", d.Id(), err)
+	}
+
+	// Only keys managed by this resource are reported, so that other associations
+	// with the usage plan (e.g. via aws_api_gateway_usage_plan_key) don't cause a diff.
+	// On import every associated key is adopted.
+	configured := d.Get("key_ids").(*schema.Set)
+	var keyIDs []string
+	for _, key := range keys {
+		keyID := aws.ToString(key.Id)
+
+		if configured.Len() == 0 || configured.Contains(keyID) {
+			keyIDs = append(keyIDs, keyID)
+		}
+	}
+
+	if len(keyIDs) == 0 && !d.IsNewResource() {
+		log.Printf("[WARN] API Gateway Usage Plan Keys (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	d.Set("key_ids", keyIDs)
+	d.Set("usage_plan_id", d.Id())
+
+	return diags
+}
+
+func resourceUsagePlanKeysUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	if d.HasChange("key_ids") {
+		o, n := d.GetChange("key_ids")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+		add, del := flex.ExpandStringValueSet(ns.Difference(os)), flex.ExpandStringValueSet(os.Difference(ns))
+
+		if err := deleteUsagePlanKeys(ctx, conn, d.Id(), del); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating API Gateway Usage Plan Keys (%s): %s", d.Id(), err)
+		}
+
+		if err := createUsagePlanKeys(ctx, conn, d.Id(), add); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating API Gateway Usage Plan Keys (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceUsagePlanKeysRead(ctx, d, meta)...)
+}
+
+func resourceUsagePlanKeysDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	log.Printf("[DEBUG] Deleting API Gateway Usage Plan Keys: %s", d.Id())
+	keyIDs := flex.ExpandStringValueSet(d.Get("key_ids").(*schema.Set))
+
+	if err := deleteUsagePlanKeys(ctx, conn, d.Id(), keyIDs); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting API Gateway Usage Plan Keys (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func createUsagePlanKeys(ctx context.Context, conn *apigateway.Client, usagePlanID string, keyIDs []string) error {
+	for _, keyID := range keyIDs {
+		input := &apigateway.CreateUsagePlanKeyInput{
+			KeyId:       aws.String(keyID),
+			KeyType:     aws.String(usagePlanKeyTypeAPIKey),
+			UsagePlanId: aws.String(usagePlanID),
+		}
+
+		if _, err := conn.CreateUsagePlanKey(ctx, input); err != nil {
+			return fmt.Errorf("associating API Key (%s): %w", keyID, err)
+		}
+	}
+
+	return nil
+}
+
+func deleteUsagePlanKeys(ctx context.Context, conn *apigateway.Client, usagePlanID string, keyIDs []string) error {
+	for _, keyID := range keyIDs {
+		input := &apigateway.DeleteUsagePlanKeyInput{
+			KeyId:       aws.String(keyID),
+			UsagePlanId: aws.String(usagePlanID),
+		}
+
+		_, err := conn.DeleteUsagePlanKey(ctx, input)
+
+		if errs.IsA[*types.NotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("disassociating API Key (%s): %w", keyID, err)
+		}
+	}
+
+	return nil
+}
+
+func findUsagePlanKeysByUsagePlanID(ctx context.Context, conn *apigateway.Client, usagePlanID string) ([]types.UsagePlanKey, error) {
+	input := &apigateway.GetUsagePlanKeysInput{
+		UsagePlanId: aws.String(usagePlanID),
+	}
+
+	return findUsagePlanKeys(ctx, conn, input)
+}
+
+func findUsagePlanKeys(ctx context.Context, conn *apigateway.Client, input *apigateway.GetUsagePlanKeysInput) ([]types.UsagePlanKey, error) {
+	var output []types.UsagePlanKey
+
+	pages := apigateway.NewGetUsagePlanKeysPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfapigateway "github.com/hashicorp/terraform-provider-aws/internal/service/apigateway"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAPIGatewayUsagePlanKeys_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_usage_plan_keys.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsagePlanKeysDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsagePlanKeysConfig_basic(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsagePlanKeysExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_ids.#", acctest.Ct3),
+					resource.TestCheckResourceAttrPair(resourceName, "usage_plan_id", "aws_api_gateway_usage_plan.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayUsagePlanKeys_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_usage_plan_keys.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsagePlanKeysDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsagePlanKeysConfig_basic(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsagePlanKeysExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfapigateway.ResourceUsagePlanKeys(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayUsagePlanKeys_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_usage_plan_keys.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUsagePlanKeysDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUsagePlanKeysConfig_basic(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsagePlanKeysExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_ids.#", acctest.Ct3),
+				),
+			},
+			{
+				Config: testAccUsagePlanKeysConfig_basic(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsagePlanKeysExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_ids.#", "10"),
+				),
+			},
+			{
+				Config: testAccUsagePlanKeysConfig_basic(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUsagePlanKeysExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key_ids.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUsagePlanKeysExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayClient(ctx)
+
+		keys, err := tfapigateway.FindUsagePlanKeysByUsagePlanID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		associated := make(map[string]bool, len(keys))
+		for _, key := range keys {
+			associated[aws.ToString(key.Id)] = true
+		}
+
+		for _, keyID := range testAccUsagePlanKeysKeyIDs(rs) {
+			if !associated[keyID] {
+				return fmt.Errorf("API Gateway Usage Plan %s: API Key %s not associated", rs.Primary.ID, keyID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckUsagePlanKeysDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).APIGatewayClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_api_gateway_usage_plan_keys" {
+				continue
+			}
+
+			for _, keyID := range testAccUsagePlanKeysKeyIDs(rs) {
+				_, err := tfapigateway.FindUsagePlanKeyByTwoPartKey(ctx, conn, rs.Primary.ID, keyID)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("API Gateway Usage Plan Key %s/%s still exists", rs.Primary.ID, keyID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccUsagePlanKeysKeyIDs(rs *terraform.ResourceState) []string {
+	var keyIDs []string
+
+	for k, v := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, "key_ids.") && k != "key_ids.#" {
+			keyIDs = append(keyIDs, v)
+		}
+	}
+
+	return keyIDs
+}
+
+func testAccUsagePlanKeysConfig_basic(rName string, count int) string {
+	return acctest.ConfigCompose(
+		testAccUsagePlanKeyBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_api_gateway_api_key" "test" {
+  count = %[2]d
+
+  name = "%[1]s-${count.index}"
+}
+
+resource "aws_api_gateway_usage_plan" "test" {
+  name = %[1]q
+
+  api_stages {
+    api_id = aws_api_gateway_rest_api.test.id
+    stage  = aws_api_gateway_deployment.test.stage_name
+  }
+}
+
+resource "aws_api_gateway_usage_plan_keys" "test" {
+  usage_plan_id = aws_api_gateway_usage_plan.test.id
+  key_ids       = aws_api_gateway_api_key.test[*].id
+}
+`, rName, count))
+}
@@ -38,6 +38,7 @@ import (
 
 // @FrameworkResource("aws_appconfig_environment", name="Environment")
 // @Tags(identifierAttribute="arn")
+// @ARNIdentity
 func newResourceEnvironment(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceEnvironment{}
 
@@ -309,6 +310,16 @@ func (r *resourceEnvironment) ImportState(ctx context.Context, request resource.
 	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrApplicationID), parts[1])...)
 }
 
+func (r *resourceEnvironment) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "appconfig", "application/*/environment/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%s:%s", parts[1], parts[0]), nil
+}
+
 func (r *resourceEnvironment) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, request, response)
 }
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/appfabric"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/appfabric/types"
 	uuid "github.com/hashicorp/go-uuid"
@@ -40,6 +41,7 @@ import (
 
 // @FrameworkResource(name="App Authorization")
 // @Tags(identifierAttribute="arn")
+// @ARNIdentity
 func newAppAuthorizationResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &appAuthorizationResource{}
 
@@ -556,6 +558,19 @@ func expandAppAuthorizationOAuthCredential(ctx context.Context, oauth2Credential
 	}
 }
 
+func (r *appAuthorizationResource) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "appfabric", "appbundle/*/appauthorization/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	appBundleARN := arn
+	appBundleARN.Resource = "appbundle/" + parts[0]
+
+	return flex.FlattenResourceId([]string{arn.String(), appBundleARN.String()}, appAuthorizationResourceIDPartCount, false)
+}
+
 type appAuthorizationResourceModel struct {
 	App                 types.String                                     `tfsdk:"app"`
 	AppAuthorizationARN types.String                                     `tfsdk:"arn"`
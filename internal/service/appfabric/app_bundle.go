@@ -29,6 +29,7 @@ import (
 
 // @FrameworkResource(name="App Bundle")
 // @Tags(identifierAttribute="id")
+// @ARNIdentity
 func newAppBundleResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &appBundleResource{}
 
@@ -27,6 +27,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				ARNAttribute: names.AttrARN,
+			},
 		},
 		{
 			Factory: newAppBundleResource,
@@ -34,6 +37,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				ARNAttribute: names.AttrARN,
+			},
 		},
 	}
 }
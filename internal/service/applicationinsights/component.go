@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationinsights
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationinsights"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationinsights/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_applicationinsights_component", name="Component")
+func resourceComponent() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceComponentCreate,
+		ReadWithoutTimeout:   resourceComponentRead,
+		UpdateWithoutTimeout: resourceComponentUpdate,
+		DeleteWithoutTimeout: resourceComponentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"component_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"resource_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const (
+	componentResourceIDPartCount = 2
+)
+
+func resourceComponentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+	rgName, componentName := d.Get("resource_group_name").(string), d.Get("component_name").(string)
+	id, err := flex.FlattenResourceId([]string{rgName, componentName}, componentResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &applicationinsights.CreateComponentInput{
+		ComponentName:     aws.String(componentName),
+		ResourceGroupName: aws.String(rgName),
+		ResourceList:      flex.ExpandStringValueSet(d.Get("resource_arns").(*schema.Set)),
+	}
+
+	_, err = conn.CreateComponent(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating ApplicationInsights Component (%s): %s", id, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceComponentRead(ctx, d, meta)...)
+}
+
+func resourceComponentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), componentResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	rgName, componentName := parts[0], parts[1]
+	output, err := findComponentByTwoPartKey(ctx, conn, rgName, componentName)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ApplicationInsights Component (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ApplicationInsights Component (%s): %s", d.Id(), err)
+	}
+
+	d.Set("component_name", output.ApplicationComponent.ComponentName)
+	d.Set("resource_arns", output.ResourceList)
+	d.Set("resource_group_name", rgName)
+
+	return diags
+}
+
+func resourceComponentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), componentResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &applicationinsights.UpdateComponentInput{
+		ComponentName:     aws.String(parts[1]),
+		ResourceGroupName: aws.String(parts[0]),
+		ResourceList:      flex.ExpandStringValueSet(d.Get("resource_arns").(*schema.Set)),
+	}
+
+	_, err = conn.UpdateComponent(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating ApplicationInsights Component (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceComponentRead(ctx, d, meta)...)
+}
+
+func resourceComponentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), componentResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting ApplicationInsights Component: %s", d.Id())
+	_, err = conn.DeleteComponent(ctx, &applicationinsights.DeleteComponentInput{
+		ComponentName:     aws.String(parts[1]),
+		ResourceGroupName: aws.String(parts[0]),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting ApplicationInsights Component (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findComponentByTwoPartKey(ctx context.Context, conn *applicationinsights.Client, rgName, componentName string) (*applicationinsights.DescribeComponentOutput, error) {
+	input := &applicationinsights.DescribeComponentInput{
+		ComponentName:     aws.String(componentName),
+		ResourceGroupName: aws.String(rgName),
+	}
+
+	output, err := conn.DescribeComponent(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ApplicationComponent == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
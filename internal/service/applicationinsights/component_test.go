@@ -0,0 +1,179 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationinsights_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationinsights"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfapplicationinsights "github.com/hashicorp/terraform-provider-aws/internal/service/applicationinsights"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationInsightsComponent_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v applicationinsights.DescribeComponentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationinsights_component.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "component_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "resource_group_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComponentConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationInsightsComponent_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v applicationinsights.DescribeComponentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationinsights_component.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckComponentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComponentConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComponentExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfapplicationinsights.ResourceComponent(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckComponentDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_applicationinsights_component" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfapplicationinsights.FindComponentByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ApplicationInsights Component %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckComponentExists(ctx context.Context, n string, v *applicationinsights.DescribeComponentOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 2, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+		output, err := tfapplicationinsights.FindComponentByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccComponentConfig_base(rName string, instanceCount int) string {
+	return acctest.ConfigCompose(
+		testAccApplicationConfig_basic(rName),
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		acctest.AvailableEC2InstanceTypeForRegion("t3.micro", "t2.micro"),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  count = %[2]d
+
+  ami           = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+
+  tags = {
+    Name  = %[1]q
+    Stage = "Test"
+  }
+}
+`, rName, instanceCount))
+}
+
+func testAccComponentConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccComponentConfig_base(rName, 2), fmt.Sprintf(`
+resource "aws_applicationinsights_component" "test" {
+  resource_group_name = aws_applicationinsights_application.test.resource_group_name
+  component_name      = %[1]q
+  resource_arns       = [aws_instance.test[0].arn]
+}
+`, rName))
+}
+
+func testAccComponentConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccComponentConfig_base(rName, 2), fmt.Sprintf(`
+resource "aws_applicationinsights_component" "test" {
+  resource_group_name = aws_applicationinsights_application.test.resource_group_name
+  component_name      = %[1]q
+  resource_arns       = aws_instance.test[*].arn
+}
+`, rName))
+}
@@ -6,6 +6,12 @@ package applicationinsights
 // Exports for use in tests only.
 var (
 	ResourceApplication = resourceApplication
+	ResourceComponent   = resourceComponent
+	ResourceLogPattern  = resourceLogPattern
+	ResourceWorkload    = resourceWorkload
 
-	FindApplicationByName = findApplicationByName
+	FindApplicationByName        = findApplicationByName
+	FindComponentByTwoPartKey    = findComponentByTwoPartKey
+	FindLogPatternByThreePartKey = findLogPatternByThreePartKey
+	FindWorkloadByThreePartKey   = findWorkloadByThreePartKey
 )
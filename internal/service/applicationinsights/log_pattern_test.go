@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationinsights_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/applicationinsights/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfapplicationinsights "github.com/hashicorp/terraform-provider-aws/internal/service/applicationinsights"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationInsightsLogPattern_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LogPattern
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationinsights_log_pattern.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLogPatternDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLogPatternConfig_basic(rName, "ERROR", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogPatternExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "pattern", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "pattern_name", "test-pattern"),
+					resource.TestCheckResourceAttr(resourceName, "pattern_set_name", "test-set"),
+					resource.TestCheckResourceAttr(resourceName, "rank", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "resource_group_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLogPatternConfig_basic(rName, "FATAL", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogPatternExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "pattern", "FATAL"),
+					resource.TestCheckResourceAttr(resourceName, "rank", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccApplicationInsightsLogPattern_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.LogPattern
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationinsights_log_pattern.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLogPatternDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLogPatternConfig_basic(rName, "ERROR", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLogPatternExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfapplicationinsights.ResourceLogPattern(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLogPatternDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_applicationinsights_log_pattern" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfapplicationinsights.FindLogPatternByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ApplicationInsights Log Pattern %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckLogPatternExists(ctx context.Context, n string, v *awstypes.LogPattern) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+		output, err := tfapplicationinsights.FindLogPatternByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccLogPatternConfig_basic(rName, pattern string, rank int) string {
+	return acctest.ConfigCompose(testAccApplicationConfig_basic(rName), fmt.Sprintf(`
+resource "aws_applicationinsights_log_pattern" "test" {
+  resource_group_name = aws_applicationinsights_application.test.resource_group_name
+  pattern_set_name    = "test-set"
+  pattern_name        = "test-pattern"
+  pattern             = %[1]q
+  rank                = %[2]d
+}
+`, pattern, rank))
+}
@@ -37,6 +37,21 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  resourceComponent,
+			TypeName: "aws_applicationinsights_component",
+			Name:     "Component",
+		},
+		{
+			Factory:  resourceLogPattern,
+			TypeName: "aws_applicationinsights_log_pattern",
+			Name:     "Log Pattern",
+		},
+		{
+			Factory:  resourceWorkload,
+			TypeName: "aws_applicationinsights_workload",
+			Name:     "Workload",
+		},
 	}
 }
 
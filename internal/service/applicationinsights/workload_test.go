@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package applicationinsights_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationinsights"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfapplicationinsights "github.com/hashicorp/terraform-provider-aws/internal/service/applicationinsights"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccApplicationInsightsWorkload_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v applicationinsights.DescribeWorkloadOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationinsights_workload.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "component_name", "aws_applicationinsights_component.test", "component_name"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrConfiguration),
+					resource.TestCheckResourceAttr(resourceName, "resource_group_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "tier", "DEFAULT"),
+					resource.TestCheckResourceAttrSet(resourceName, "workload_id"),
+					resource.TestCheckResourceAttr(resourceName, "workload_name", rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccApplicationInsightsWorkload_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v applicationinsights.DescribeWorkloadOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_applicationinsights_workload.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ApplicationInsightsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkloadExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfapplicationinsights.ResourceWorkload(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckWorkloadDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_applicationinsights_workload" {
+				continue
+			}
+
+			parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+			if err != nil {
+				return err
+			}
+
+			_, err = tfapplicationinsights.FindWorkloadByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("ApplicationInsights Workload %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckWorkloadExists(ctx context.Context, n string, v *applicationinsights.DescribeWorkloadOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, 3, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ApplicationInsightsClient(ctx)
+
+		output, err := tfapplicationinsights.FindWorkloadByThreePartKey(ctx, conn, parts[0], parts[1], parts[2])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccWorkloadConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccComponentConfig_basic(rName), fmt.Sprintf(`
+resource "aws_applicationinsights_workload" "test" {
+  resource_group_name = aws_applicationinsights_component.test.resource_group_name
+  component_name      = aws_applicationinsights_component.test.component_name
+  workload_name       = %[1]q
+  tier                = "DEFAULT"
+}
+`, rName))
+}
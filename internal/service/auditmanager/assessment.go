@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -38,6 +39,7 @@ const iamPropagationTimeout = 2 * time.Minute
 
 // @FrameworkResource(name="Assessment")
 // @Tags(identifierAttribute="arn")
+// @ARNIdentity
 func newResourceAssessment(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceAssessment{}, nil
 }
@@ -369,6 +371,16 @@ func (r *resourceAssessment) ImportState(ctx context.Context, req resource.Impor
 	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
 }
 
+func (r *resourceAssessment) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "auditmanager", "assessment/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return parts[0], nil
+}
+
 func (r *resourceAssessment) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, req, resp)
 }
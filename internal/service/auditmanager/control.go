@@ -9,6 +9,7 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
@@ -33,6 +34,7 @@ import (
 
 // @FrameworkResource(name="Control")
 // @Tags(identifierAttribute="arn")
+// @ARNIdentity
 func newResourceControl(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceControl{}, nil
 }
@@ -313,6 +315,16 @@ func (r *resourceControl) ImportState(ctx context.Context, req resource.ImportSt
 	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
 }
 
+func (r *resourceControl) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "auditmanager", "control/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return parts[0], nil
+}
+
 func (r *resourceControl) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if !req.State.Raw.IsNull() && !req.Plan.Raw.IsNull() {
 		var plan resourceControlData
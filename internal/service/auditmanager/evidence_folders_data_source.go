@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package auditmanager
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/auditmanager"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/auditmanager/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Evidence Folders")
+func newDataSourceEvidenceFolders(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceEvidenceFolders{}, nil
+}
+
+const (
+	DSNameEvidenceFolders = "Evidence Folders Data Source"
+)
+
+type dataSourceEvidenceFolders struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceEvidenceFolders) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_auditmanager_evidence_folders"
+}
+
+func (d *dataSourceEvidenceFolders) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"assessment_id": schema.StringAttribute{
+				Required: true,
+			},
+			"control_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("control_set_id")),
+				},
+			},
+			"control_set_id": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("control_id")),
+				},
+			},
+			"evidence_folders": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[evidenceFolderModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[evidenceFolderModel](ctx),
+				Computed:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+		},
+	}
+}
+
+func (d *dataSourceEvidenceFolders) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().AuditManagerClient(ctx)
+
+	var data dataSourceEvidenceFoldersData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := []string{data.AssessmentID.ValueString()}
+	var evidenceFolders []awstypes.AssessmentEvidenceFolder
+	var err error
+	if data.ControlSetID.IsNull() {
+		evidenceFolders, err = findEvidenceFoldersByAssessment(ctx, conn, data.AssessmentID.ValueString())
+	} else {
+		idParts = append(idParts, data.ControlSetID.ValueString(), data.ControlID.ValueString())
+		evidenceFolders, err = findEvidenceFoldersByAssessmentControl(ctx, conn, data.AssessmentID.ValueString(), data.ControlSetID.ValueString(), data.ControlID.ValueString())
+	}
+	data.ID = types.StringValue(strings.Join(idParts, ","))
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.AuditManager, create.ErrActionReading, DSNameEvidenceFolders, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, evidenceFolders, &data.EvidenceFolders)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findEvidenceFoldersByAssessment(ctx context.Context, conn *auditmanager.Client, assessmentID string) ([]awstypes.AssessmentEvidenceFolder, error) {
+	in := &auditmanager.GetEvidenceFoldersByAssessmentInput{
+		AssessmentId: aws.String(assessmentID),
+	}
+	var out []awstypes.AssessmentEvidenceFolder
+
+	pages := auditmanager.NewGetEvidenceFoldersByAssessmentPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, page.EvidenceFolders...)
+	}
+
+	return out, nil
+}
+
+func findEvidenceFoldersByAssessmentControl(ctx context.Context, conn *auditmanager.Client, assessmentID, controlSetID, controlID string) ([]awstypes.AssessmentEvidenceFolder, error) {
+	in := &auditmanager.GetEvidenceFoldersByAssessmentControlInput{
+		AssessmentId: aws.String(assessmentID),
+		ControlId:    aws.String(controlID),
+		ControlSetId: aws.String(controlSetID),
+	}
+	var out []awstypes.AssessmentEvidenceFolder
+
+	pages := auditmanager.NewGetEvidenceFoldersByAssessmentControlPaginator(conn, in)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, page.EvidenceFolders...)
+	}
+
+	return out, nil
+}
+
+type dataSourceEvidenceFoldersData struct {
+	AssessmentID    types.String                                         `tfsdk:"assessment_id"`
+	ControlID       types.String                                         `tfsdk:"control_id"`
+	ControlSetID    types.String                                         `tfsdk:"control_set_id"`
+	EvidenceFolders fwtypes.ListNestedObjectValueOf[evidenceFolderModel] `tfsdk:"evidence_folders"`
+	ID              types.String                                         `tfsdk:"id"`
+}
+
+type evidenceFolderModel struct {
+	AssessmentReportSelectionCount types.Int64       `tfsdk:"assessment_report_selection_count"`
+	Author                         types.String      `tfsdk:"author"`
+	ControlID                      types.String      `tfsdk:"control_id"`
+	ControlName                    types.String      `tfsdk:"control_name"`
+	ControlSetID                   types.String      `tfsdk:"control_set_id"`
+	DataSource                     types.String      `tfsdk:"data_source"`
+	Date                           timetypes.RFC3339 `tfsdk:"date"`
+	EvidenceAWSServiceSourceCount  types.Int64       `tfsdk:"evidence_aws_service_source_count"`
+	EvidenceResourcesIncludedCount types.Int64       `tfsdk:"evidence_resources_included_count"`
+	ID                             types.String      `tfsdk:"id"`
+	Name                           types.String      `tfsdk:"name"`
+	TotalEvidence                  types.Int64       `tfsdk:"total_evidence"`
+}
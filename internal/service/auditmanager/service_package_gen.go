@@ -20,6 +20,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 		{
 			Factory: newDataSourceControl,
 		},
+		{
+			Factory: newDataSourceEvidenceFolders,
+			Name:    "Evidence Folders",
+		},
 		{
 			Factory: newDataSourceFramework,
 		},
@@ -37,6 +41,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				ARNAttribute: names.AttrARN,
+			},
 		},
 		{
 			Factory: newResourceAssessmentDelegation,
@@ -50,6 +57,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				ARNAttribute: names.AttrARN,
+			},
 		},
 		{
 			Factory: newResourceFramework,
@@ -57,6 +67,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				ARNAttribute: names.AttrARN,
+			},
 		},
 		{
 			Factory: newResourceFrameworkShare,
@@ -13,6 +13,7 @@ var (
 	ResourceNotification            = resourceNotification
 	ResourcePolicy                  = resourcePolicy
 	ResourceSchedule                = resourceSchedule
+	ResourceSchedules               = resourceSchedules
 	ResourceTrafficSourceAttachment = resourceTrafficSourceAttachment
 
 	FindAttachmentByLoadBalancerName          = findAttachmentByLoadBalancerName
@@ -24,6 +25,7 @@ var (
 	FindNotificationsByTwoPartKey             = findNotificationsByTwoPartKey
 	FindScalingPolicyByTwoPartKey             = findScalingPolicyByTwoPartKey
 	FindScheduleByTwoPartKey                  = findScheduleByTwoPartKey
+	FindSchedulesByGroupName                  = findSchedulesByGroupName
 	FindTag                                   = findTag
 	FindTrafficSourceAttachmentByThreePartKey = findTrafficSourceAttachmentByThreePartKey
 )
@@ -318,6 +318,42 @@ func resourceGroup() *schema.Resource {
 					},
 				},
 			},
+			"latest_instance_refresh": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"end_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instance_refresh_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"instances_to_update": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"percentage_complete": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						names.AttrStartTime: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatusReason: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"launch_configuration": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -1292,6 +1328,23 @@ func resourceGroupRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	if err := d.Set("instance_maintenance_policy", flattenInstanceMaintenancePolicy(g.InstanceMaintenancePolicy)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting instance_maintenance_policy: %s", err)
 	}
+	// Only track instance refreshes when they are managed by this resource.
+	if v, ok := d.GetOk("instance_refresh"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		instanceRefresh, err := findLatestInstanceRefreshByGroupName(ctx, conn, d.Id())
+
+		switch {
+		case tfresource.NotFound(err):
+			d.Set("latest_instance_refresh", nil)
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading Auto Scaling Group (%s) instance refreshes: %s", d.Id(), err)
+		default:
+			if err := d.Set("latest_instance_refresh", []interface{}{flattenInstanceRefresh(instanceRefresh)}); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting latest_instance_refresh: %s", err)
+			}
+		}
+	} else {
+		d.Set("latest_instance_refresh", nil)
+	}
 	d.Set("launch_configuration", g.LaunchConfigurationName)
 	if g.LaunchTemplate != nil {
 		if err := d.Set(names.AttrLaunchTemplate, []interface{}{flattenLaunchTemplateSpecification(g.LaunchTemplate)}); err != nil {
@@ -2119,6 +2172,34 @@ func findInstanceRefreshes(ctx context.Context, conn *autoscaling.Client, input
 	return output, nil
 }
 
+// findLatestInstanceRefreshByGroupName returns the most recent instance refresh.
+// Instance refreshes are returned in descending order of start time.
+func findLatestInstanceRefreshByGroupName(ctx context.Context, conn *autoscaling.Client, name string) (*awstypes.InstanceRefresh, error) {
+	input := &autoscaling.DescribeInstanceRefreshesInput{
+		AutoScalingGroupName: aws.String(name),
+		MaxRecords:           aws.Int32(1),
+	}
+
+	output, err := conn.DescribeInstanceRefreshes(ctx, input)
+
+	if tfawserr.ErrMessageContains(err, errCodeValidationError, "not found") {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.InstanceRefreshes) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return &output.InstanceRefreshes[0], nil
+}
+
 func findLoadBalancerStates(ctx context.Context, conn *autoscaling.Client, name string) ([]awstypes.LoadBalancerState, error) {
 	input := &autoscaling.DescribeLoadBalancersInput{
 		AutoScalingGroupName: aws.String(name),
@@ -3552,6 +3633,42 @@ func flattenInstanceMaintenancePolicy(instanceMaintenancePolicy *awstypes.Instan
 	return []interface{}{m}
 }
 
+func flattenInstanceRefresh(apiObject *awstypes.InstanceRefresh) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		names.AttrStatus: string(apiObject.Status),
+	}
+
+	if v := apiObject.EndTime; v != nil {
+		tfMap["end_time"] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.InstanceRefreshId; v != nil {
+		tfMap["instance_refresh_id"] = aws.ToString(v)
+	}
+
+	if v := apiObject.InstancesToUpdate; v != nil {
+		tfMap["instances_to_update"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.PercentageComplete; v != nil {
+		tfMap["percentage_complete"] = aws.ToInt32(v)
+	}
+
+	if v := apiObject.StartTime; v != nil {
+		tfMap[names.AttrStartTime] = aws.ToTime(v).Format(time.RFC3339)
+	}
+
+	if v := apiObject.StatusReason; v != nil {
+		tfMap[names.AttrStatusReason] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
 func flattenLaunchTemplate(apiObject *awstypes.LaunchTemplate) map[string]interface{} {
 	if apiObject == nil {
 		return nil
@@ -1548,6 +1548,7 @@ func TestAccAutoScalingGroup_InstanceRefresh_start(t *testing.T) {
 					testAccCheckGroupExists(ctx, resourceName, &group),
 					resource.TestCheckResourceAttrPair(resourceName, "launch_configuration", launchConfigurationResourceName, names.AttrName),
 					testAccCheckInstanceRefreshCount(ctx, &group, 0),
+					resource.TestCheckResourceAttr(resourceName, "latest_instance_refresh.#", acctest.Ct0),
 				),
 			},
 			{
@@ -1557,6 +1558,10 @@ func TestAccAutoScalingGroup_InstanceRefresh_start(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "launch_configuration", launchConfigurationResourceName, names.AttrName),
 					testAccCheckInstanceRefreshCount(ctx, &group, 1),
 					testAccCheckInstanceRefreshStatus(ctx, &group, 0, awstypes.InstanceRefreshStatusPending, awstypes.InstanceRefreshStatusInProgress),
+					resource.TestCheckResourceAttr(resourceName, "latest_instance_refresh.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "latest_instance_refresh.0.instance_refresh_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "latest_instance_refresh.0.start_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "latest_instance_refresh.0.status"),
 				),
 			},
 			{
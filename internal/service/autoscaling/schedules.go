@@ -0,0 +1,391 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/autoscaling"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/autoscaling/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// BatchPutScheduledUpdateGroupAction and BatchDeleteScheduledAction accept at most 50 actions per request.
+	scheduledActionsBatchSize = 50
+)
+
+// @SDKResource("aws_autoscaling_schedules", name="Scheduled Actions")
+func resourceSchedules() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSchedulesCreate,
+		ReadWithoutTimeout:   resourceSchedulesRead,
+		UpdateWithoutTimeout: resourceSchedulesUpdate,
+		DeleteWithoutTimeout: resourceSchedulesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSchedulesImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"autoscaling_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"scheduled_action": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"desired_capacity": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  -1,
+						},
+						"end_time": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validScheduleTimestamp,
+						},
+						"max_size": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  -1,
+						},
+						"min_size": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Default:  -1,
+						},
+						"recurrence": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"scheduled_action_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrStartTime: {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validScheduleTimestamp,
+						},
+						"time_zone": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceSchedulesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AutoScalingClient(ctx)
+
+	asgName := d.Get("autoscaling_group_name").(string)
+	actions := expandScheduledUpdateGroupActionRequests(d.Get("scheduled_action").(*schema.Set).List())
+
+	// The schedule is authoritative, so remove any existing actions that aren't configured.
+	existing, err := findSchedulesByGroupName(ctx, conn, asgName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Auto Scaling Scheduled Actions (%s): %s", asgName, err)
+	}
+
+	desired := make(map[string]struct{}, len(actions))
+	for _, v := range actions {
+		desired[aws.ToString(v.ScheduledActionName)] = struct{}{}
+	}
+	var del []string
+	for _, v := range existing {
+		if name := aws.ToString(v.ScheduledActionName); name != "" {
+			if _, ok := desired[name]; !ok {
+				del = append(del, name)
+			}
+		}
+	}
+
+	if err := deleteScheduledActions(ctx, conn, asgName, del); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Auto Scaling Scheduled Actions (%s): %s", asgName, err)
+	}
+
+	if err := putScheduledActions(ctx, conn, asgName, actions); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Auto Scaling Scheduled Actions (%s): %s", asgName, err)
+	}
+
+	d.SetId(asgName)
+
+	return append(diags, resourceSchedulesRead(ctx, d, meta)...)
+}
+
+func resourceSchedulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AutoScalingClient(ctx)
+
+	actions, err := findSchedulesByGroupName(ctx, conn, d.Id())
+
+	if err == nil && len(actions) == 0 {
+		err = tfresource.NewEmptyResultError(nil)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Auto Scaling Scheduled Actions %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Auto Scaling Scheduled Actions (%s): %s", d.Id(), err)
+	}
+
+	// Recurring actions report their next start time, so ignore it unless a start time is configured.
+	startTimes := make(map[string]string)
+	for _, tfMapRaw := range d.Get("scheduled_action").(*schema.Set).List() {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			startTimes[tfMap["scheduled_action_name"].(string)] = tfMap[names.AttrStartTime].(string)
+		}
+	}
+
+	d.Set("autoscaling_group_name", d.Id())
+	if err := d.Set("scheduled_action", flattenScheduledUpdateGroupActions(actions, startTimes)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting scheduled_action: %s", err)
+	}
+
+	return diags
+}
+
+func resourceSchedulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AutoScalingClient(ctx)
+
+	if d.HasChange("scheduled_action") {
+		o, n := d.GetChange("scheduled_action")
+		newActions := expandScheduledUpdateGroupActionRequests(n.(*schema.Set).List())
+
+		desired := make(map[string]struct{}, len(newActions))
+		for _, v := range newActions {
+			desired[aws.ToString(v.ScheduledActionName)] = struct{}{}
+		}
+		var del []string
+		for _, v := range expandScheduledUpdateGroupActionRequests(o.(*schema.Set).List()) {
+			if name := aws.ToString(v.ScheduledActionName); name != "" {
+				if _, ok := desired[name]; !ok {
+					del = append(del, name)
+				}
+			}
+		}
+
+		if err := deleteScheduledActions(ctx, conn, d.Id(), del); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Auto Scaling Scheduled Actions (%s): %s", d.Id(), err)
+		}
+
+		if err := putScheduledActions(ctx, conn, d.Id(), newActions); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Auto Scaling Scheduled Actions (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSchedulesRead(ctx, d, meta)...)
+}
+
+func resourceSchedulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AutoScalingClient(ctx)
+
+	actionNames := tfslices.ApplyToAll(expandScheduledUpdateGroupActionRequests(d.Get("scheduled_action").(*schema.Set).List()), func(v awstypes.ScheduledUpdateGroupActionRequest) string {
+		return aws.ToString(v.ScheduledActionName)
+	})
+
+	log.Printf("[INFO] Deleting Auto Scaling Scheduled Actions: %s", d.Id())
+	err := deleteScheduledActions(ctx, conn, d.Id(), actionNames)
+
+	if tfawserr.ErrMessageContains(err, errCodeValidationError, "not found") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Auto Scaling Scheduled Actions (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceSchedulesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	d.Set("autoscaling_group_name", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func findSchedulesByGroupName(ctx context.Context, conn *autoscaling.Client, asgName string) ([]awstypes.ScheduledUpdateGroupAction, error) {
+	input := &autoscaling.DescribeScheduledActionsInput{
+		AutoScalingGroupName: aws.String(asgName),
+	}
+
+	return findSchedules(ctx, conn, input)
+}
+
+func putScheduledActions(ctx context.Context, conn *autoscaling.Client, asgName string, actions []awstypes.ScheduledUpdateGroupActionRequest) error {
+	for _, chunk := range tfslices.Chunks(actions, scheduledActionsBatchSize) {
+		input := &autoscaling.BatchPutScheduledUpdateGroupActionInput{
+			AutoScalingGroupName:        aws.String(asgName),
+			ScheduledUpdateGroupActions: chunk,
+		}
+
+		output, err := conn.BatchPutScheduledUpdateGroupAction(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		if err := failedScheduledUpdateGroupActionsError(output.FailedScheduledUpdateGroupActions); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func deleteScheduledActions(ctx context.Context, conn *autoscaling.Client, asgName string, actionNames []string) error {
+	for _, chunk := range tfslices.Chunks(actionNames, scheduledActionsBatchSize) {
+		input := &autoscaling.BatchDeleteScheduledActionInput{
+			AutoScalingGroupName: aws.String(asgName),
+			ScheduledActionNames: chunk,
+		}
+
+		output, err := conn.BatchDeleteScheduledAction(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		if err := failedScheduledUpdateGroupActionsError(output.FailedScheduledActions); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func failedScheduledUpdateGroupActionsError(apiObjects []awstypes.FailedScheduledUpdateGroupActionRequest) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		errs = append(errs, fmt.Errorf("%s: %s: %s", aws.ToString(apiObject.ScheduledActionName), aws.ToString(apiObject.ErrorCode), aws.ToString(apiObject.ErrorMessage)))
+	}
+
+	return errors.Join(errs...)
+}
+
+func expandScheduledUpdateGroupActionRequests(tfList []interface{}) []awstypes.ScheduledUpdateGroupActionRequest {
+	var apiObjects []awstypes.ScheduledUpdateGroupActionRequest
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.ScheduledUpdateGroupActionRequest{
+			ScheduledActionName: aws.String(tfMap["scheduled_action_name"].(string)),
+		}
+
+		// As with aws_autoscaling_schedule, -1 means "don't include this parameter in the action".
+		if v, ok := tfMap["desired_capacity"].(int); ok && v != -1 {
+			apiObject.DesiredCapacity = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["end_time"].(string); ok && v != "" {
+			v, _ := time.Parse(ScheduleTimeLayout, v)
+			apiObject.EndTime = aws.Time(v)
+		}
+
+		if v, ok := tfMap["max_size"].(int); ok && v != -1 {
+			apiObject.MaxSize = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["min_size"].(int); ok && v != -1 {
+			apiObject.MinSize = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["recurrence"].(string); ok && v != "" {
+			apiObject.Recurrence = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrStartTime].(string); ok && v != "" {
+			v, _ := time.Parse(ScheduleTimeLayout, v)
+			apiObject.StartTime = aws.Time(v)
+		}
+
+		if v, ok := tfMap["time_zone"].(string); ok && v != "" {
+			apiObject.TimeZone = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenScheduledUpdateGroupActions(apiObjects []awstypes.ScheduledUpdateGroupAction, startTimes map[string]string) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		name := aws.ToString(apiObject.ScheduledActionName)
+		tfMap := map[string]interface{}{
+			names.AttrARN:           aws.ToString(apiObject.ScheduledActionARN),
+			"desired_capacity":      -1,
+			"max_size":              -1,
+			"min_size":              -1,
+			"recurrence":            aws.ToString(apiObject.Recurrence),
+			"scheduled_action_name": name,
+			"time_zone":             aws.ToString(apiObject.TimeZone),
+		}
+
+		if v := apiObject.DesiredCapacity; v != nil {
+			tfMap["desired_capacity"] = aws.ToInt32(v)
+		}
+
+		if v := apiObject.EndTime; v != nil {
+			tfMap["end_time"] = v.Format(ScheduleTimeLayout)
+		}
+
+		if v := apiObject.MaxSize; v != nil {
+			tfMap["max_size"] = aws.ToInt32(v)
+		}
+
+		if v := apiObject.MinSize; v != nil {
+			tfMap["min_size"] = aws.ToInt32(v)
+		}
+
+		if startTimes[name] == "" && apiObject.Recurrence != nil {
+			tfMap[names.AttrStartTime] = ""
+		} else if v := apiObject.StartTime; v != nil {
+			tfMap[names.AttrStartTime] = v.Format(ScheduleTimeLayout)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
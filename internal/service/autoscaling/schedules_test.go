@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package autoscaling_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfautoscaling "github.com/hashicorp/terraform-provider-aws/internal/service/autoscaling"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAutoScalingSchedules_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_autoscaling_schedules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchedulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulesExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "autoscaling_group_name", "aws_autoscaling_group.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_action.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scheduled_action.*", map[string]string{
+						"desired_capacity":      "-1",
+						"max_size":              acctest.Ct2,
+						"min_size":              acctest.Ct1,
+						"recurrence":            "0 8 * * MON-FRI",
+						"scheduled_action_name": rName + "-up",
+						"time_zone":             "Europe/London",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scheduled_action.*", map[string]string{
+						"desired_capacity":      "-1",
+						"max_size":              acctest.Ct1,
+						"min_size":              acctest.Ct0,
+						"recurrence":            "0 18 * * MON-FRI",
+						"scheduled_action_name": rName + "-down",
+						"time_zone":             "Europe/London",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAutoScalingSchedules_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_autoscaling_schedules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchedulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulesExists(ctx, resourceName, 2),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfautoscaling.ResourceSchedules(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccAutoScalingSchedules_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_autoscaling_schedules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AutoScalingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchedulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchedulesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulesExists(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_action.#", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccSchedulesConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchedulesExists(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "scheduled_action.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "scheduled_action.*", map[string]string{
+						"desired_capacity":      acctest.Ct1,
+						"max_size":              acctest.Ct3,
+						"min_size":              acctest.Ct1,
+						"recurrence":            "0 6 * * SAT",
+						"scheduled_action_name": rName + "-weekend",
+						"time_zone":             "Europe/London",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSchedulesExists(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingClient(ctx)
+
+		output, err := tfautoscaling.FindSchedulesByGroupName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("Auto Scaling Scheduled Actions (%s) count = %d, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSchedulesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).AutoScalingClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_autoscaling_schedules" {
+				continue
+			}
+
+			output, err := tfautoscaling.FindSchedulesByGroupName(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if len(output) == 0 {
+				continue
+			}
+
+			return fmt.Errorf("Auto Scaling Scheduled Actions %s still exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSchedulesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccScheduleConfig_base(rName), fmt.Sprintf(`
+resource "aws_autoscaling_schedules" "test" {
+  autoscaling_group_name = aws_autoscaling_group.test.name
+
+  scheduled_action {
+    scheduled_action_name = "%[1]s-up"
+    min_size              = 1
+    max_size              = 2
+    recurrence            = "0 8 * * MON-FRI"
+    time_zone             = "Europe/London"
+  }
+
+  scheduled_action {
+    scheduled_action_name = "%[1]s-down"
+    min_size              = 0
+    max_size              = 1
+    recurrence            = "0 18 * * MON-FRI"
+    time_zone             = "Europe/London"
+  }
+}
+`, rName))
+}
+
+func testAccSchedulesConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccScheduleConfig_base(rName), fmt.Sprintf(`
+resource "aws_autoscaling_schedules" "test" {
+  autoscaling_group_name = aws_autoscaling_group.test.name
+
+  scheduled_action {
+    scheduled_action_name = "%[1]s-weekend"
+    min_size              = 1
+    max_size              = 3
+    desired_capacity      = 1
+    recurrence            = "0 6 * * SAT"
+    time_zone             = "Europe/London"
+  }
+}
+`, rName))
+}
@@ -80,6 +80,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_autoscaling_schedule",
 			Name:     "Scheduled Action",
 		},
+		{
+			Factory:  resourceSchedules,
+			TypeName: "aws_autoscaling_schedules",
+			Name:     "Scheduled Actions",
+		},
 		{
 			Factory:  resourceTrafficSourceAttachment,
 			TypeName: "aws_autoscaling_traffic_source_attachment",
@@ -98,7 +98,6 @@ func ResourceComputeEnvironment() *schema.Resource {
 							Type:     schema.TypeList,
 							Optional: true,
 							Computed: true,
-							ForceNew: true,
 							MaxItems: 2,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -137,7 +136,6 @@ func ResourceComputeEnvironment() *schema.Resource {
 						names.AttrLaunchTemplate: {
 							Type:     schema.TypeList,
 							Optional: true,
-							ForceNew: true,
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
@@ -170,7 +168,6 @@ func ResourceComputeEnvironment() *schema.Resource {
 						"placement_group": {
 							Type:     schema.TypeString,
 							Optional: true,
-							ForceNew: true,
 						},
 						names.AttrSecurityGroupIDs: {
 							Type:     schema.TypeSet,
@@ -197,6 +194,10 @@ func ResourceComputeEnvironment() *schema.Resource {
 							},
 							ValidateFunc: validation.StringInSlice(batch.CRType_Values(), true),
 						},
+						"update_to_latest_image_version": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -360,7 +361,10 @@ func resourceComputeEnvironmentRead(ctx context.Context, d *schema.ResourceData,
 	d.Set("compute_environment_name", computeEnvironment.ComputeEnvironmentName)
 	d.Set("compute_environment_name_prefix", create.NamePrefixFromName(aws.StringValue(computeEnvironment.ComputeEnvironmentName)))
 	if computeEnvironment.ComputeResources != nil {
-		if err := d.Set("compute_resources", []interface{}{flattenComputeResource(ctx, computeEnvironment.ComputeResources)}); err != nil {
+		tfMap := flattenComputeResource(ctx, computeEnvironment.ComputeResources)
+		// update_to_latest_image_version is only specified on update and is not returned by the API.
+		tfMap["update_to_latest_image_version"] = d.Get("compute_resources.0.update_to_latest_image_version").(bool)
+		if err := d.Set("compute_resources", []interface{}{tfMap}); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting compute_resources: %s", err)
 		}
 	} else {
@@ -505,6 +509,14 @@ func resourceComputeEnvironmentUpdate(ctx context.Context, d *schema.ResourceDat
 					computeResourceUpdate.LaunchTemplate = expandLaunchTemplateSpecificationUpdate(launchTemplate)
 				}
 
+				if d.HasChange("compute_resources.0.placement_group") {
+					if placementGroup, ok := d.GetOk("compute_resources.0.placement_group"); ok {
+						computeResourceUpdate.PlacementGroup = aws.String(placementGroup.(string))
+					} else {
+						computeResourceUpdate.PlacementGroup = aws.String("")
+					}
+				}
+
 				if d.HasChange("compute_resources.0.tags") {
 					if tags, ok := d.GetOk("compute_resources.0.tags"); ok {
 						computeResourceUpdate.Tags = Tags(tftags.New(ctx, tags.(map[string]interface{})).IgnoreAWS())
@@ -512,6 +524,13 @@ func resourceComputeEnvironmentUpdate(ctx context.Context, d *schema.ResourceDat
 						computeResourceUpdate.Tags = aws.StringMap(map[string]string{})
 					}
 				}
+
+				// Only request an image update when the setting or the configured image changes.
+				if d.HasChanges("compute_resources.0.update_to_latest_image_version", "compute_resources.0.ec2_configuration", "compute_resources.0.image_id", "compute_resources.0.launch_template") {
+					if v, ok := d.GetOk("compute_resources.0.update_to_latest_image_version"); ok {
+						computeResourceUpdate.UpdateToLatestImageVersion = aws.Bool(v.(bool))
+					}
+				}
 			}
 
 			input.ComputeResources = computeResourceUpdate
@@ -612,15 +631,14 @@ func resourceComputeEnvironmentCustomizeDiff(_ context.Context, diff *schema.Res
 				}
 			}
 
-			if diff.HasChange("compute_resources.0.ec2_configuration.0.image_id_override") {
-				if err := diff.ForceNew("compute_resources.0.ec2_configuration.0.image_id_override"); err != nil {
-					return err
-				}
-			}
-
-			if diff.HasChange("compute_resources.0.ec2_configuration.0.image_type") {
-				if err := diff.ForceNew("compute_resources.0.ec2_configuration.0.image_type"); err != nil {
-					return err
+			o, n := diff.GetChange("compute_resources.0.ec2_configuration.#")
+			for i := 0; i < max(o.(int), n.(int)); i++ {
+				for _, k := range []string{"image_id_override", "image_type"} {
+					if k := fmt.Sprintf("compute_resources.0.ec2_configuration.%d.%s", i, k); diff.HasChange(k) {
+						if err := diff.ForceNew(k); err != nil {
+							return err
+						}
+					}
 				}
 			}
 
@@ -672,6 +690,12 @@ func resourceComputeEnvironmentCustomizeDiff(_ context.Context, diff *schema.Res
 				}
 			}
 
+			if diff.HasChange("compute_resources.0.placement_group") {
+				if err := diff.ForceNew("compute_resources.0.placement_group"); err != nil {
+					return err
+				}
+			}
+
 			if diff.HasChange("compute_resources.0.tags") {
 				if err := diff.ForceNew("compute_resources.0.tags"); err != nil {
 					return err
@@ -14,6 +14,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -1712,6 +1713,11 @@ func TestAccBatchComputeEnvironment_updateEC2(t *testing.T) {
 			},
 			{
 				Config: testAccComputeenvironmentConfig_ec2Update(rName, publicKey),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckComputeEnvironmentExists(ctx, resourceName, &ce),
 					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "batch", fmt.Sprintf("compute-environment/%s", rName)),
@@ -1742,6 +1748,7 @@ func TestAccBatchComputeEnvironment_updateEC2(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.tags.%", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.tags.updated", "yes"),
 					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.type", "SPOT"),
+					resource.TestCheckResourceAttr(resourceName, "compute_resources.0.update_to_latest_image_version", acctest.CtTrue),
 					resource.TestCheckResourceAttrSet(resourceName, "ecs_cluster_arn"),
 					resource.TestCheckResourceAttr(resourceName, names.AttrState, "ENABLED"),
 					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
@@ -3123,6 +3130,7 @@ resource "aws_batch_compute_environment" "test" {
     tags = {
       updated = "yes"
     }
+    update_to_latest_image_version = true
   }
 
   type = "MANAGED"
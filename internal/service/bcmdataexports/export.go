@@ -71,7 +71,6 @@ func (r *resourceExport) Schema(ctx context.Context, req resource.SchemaRequest,
 					Optional:   true,
 					PlanModifiers: []planmodifier.Map{
 						mapplanmodifier.UseStateForUnknown(),
-						mapplanmodifier.RequiresReplace(),
 					},
 				},
 			},
@@ -85,23 +84,14 @@ func (r *resourceExport) Schema(ctx context.Context, req resource.SchemaRequest,
 				"compression": schema.StringAttribute{
 					Required:   true,
 					CustomType: fwtypes.StringEnumType[awstypes.CompressionOption](),
-					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.RequiresReplace(),
-					},
 				},
 				names.AttrFormat: schema.StringAttribute{
 					Required:   true,
 					CustomType: fwtypes.StringEnumType[awstypes.FormatOption](),
-					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.RequiresReplace(),
-					},
 				},
 				"output_type": schema.StringAttribute{
 					Required:   true,
 					CustomType: fwtypes.StringEnumType[awstypes.S3OutputType](),
-					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.RequiresReplace(),
-					},
 				},
 				"overwrite": schema.StringAttribute{
 					Required:   true,
@@ -117,21 +107,12 @@ func (r *resourceExport) Schema(ctx context.Context, req resource.SchemaRequest,
 			Attributes: map[string]schema.Attribute{
 				names.AttrS3Bucket: schema.StringAttribute{
 					Required: true,
-					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.RequiresReplace(),
-					},
 				},
 				"s3_prefix": schema.StringAttribute{
 					Required: true,
-					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.RequiresReplace(),
-					},
 				},
 				"s3_region": schema.StringAttribute{
 					Required: true,
-					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.RequiresReplace(),
-					},
 				},
 			},
 			Blocks: map[string]schema.Block{
@@ -156,9 +137,6 @@ func (r *resourceExport) Schema(ctx context.Context, req resource.SchemaRequest,
 				"frequency": schema.StringAttribute{
 					Required:   true,
 					CustomType: fwtypes.StringEnumType[awstypes.FrequencyOption](),
-					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.RequiresReplace(),
-					},
 				},
 			},
 		},
@@ -186,9 +164,6 @@ func (r *resourceExport) Schema(ctx context.Context, req resource.SchemaRequest,
 						},
 						names.AttrDescription: schema.StringAttribute{
 							Optional: true,
-							PlanModifiers: []planmodifier.String{
-								stringplanmodifier.RequiresReplace(),
-							},
 						},
 						"export_arn": schema.StringAttribute{
 							Computed: true,
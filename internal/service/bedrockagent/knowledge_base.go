@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -37,6 +38,7 @@ import (
 
 // @FrameworkResource(name="Knowledge Base")
 // @Tags(identifierAttribute="arn")
+// @ARNIdentity
 func newKnowledgeBaseResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &knowledgeBaseResource{}
 
@@ -585,6 +587,16 @@ func findKnowledgeBaseByID(ctx context.Context, conn *bedrockagent.Client, id st
 	return output.KnowledgeBase, nil
 }
 
+func (r *knowledgeBaseResource) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "bedrock", "knowledge-base/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return parts[0], nil
+}
+
 type knowledgeBaseResourceModel struct {
 	CreatedAt                  timetypes.RFC3339                                                `tfsdk:"created_at"`
 	Description                types.String                                                     `tfsdk:"description"`
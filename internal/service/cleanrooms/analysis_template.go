@@ -0,0 +1,442 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Analysis Template")
+// @Tags(identifierAttribute="arn")
+// @ARNIdentity
+func newAnalysisTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &analysisTemplateResource{}, nil
+}
+
+type analysisTemplateResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*analysisTemplateResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cleanrooms_analysis_template"
+}
+
+func (r *analysisTemplateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"analysis_template_id": framework.IDAttribute(),
+			names.AttrARN:          framework.ARNAttributeComputedOnly(),
+			"collaboration_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collaboration_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrCreateTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			names.AttrFormat: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AnalysisFormat](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"membership_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"membership_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"update_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"analysis_parameters": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[analysisParameterModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrDefaultValue: schema.StringAttribute{
+							Optional: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+						names.AttrType: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ParameterType](),
+							Required:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			names.AttrSource: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[analysisSourceModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"text": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 90000),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *analysisTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data analysisTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &cleanrooms.CreateAnalysisTemplateInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.MembershipIdentifier = fwflex.StringFromFramework(ctx, data.MembershipID)
+	input.Source = data.expandSource(ctx, &response.Diagnostics)
+	input.Tags = getTagsIn(ctx)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateAnalysisTemplate(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Clean Rooms Analysis Template (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output.AnalysisTemplate)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *analysisTemplateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data analysisTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	output, err := findAnalysisTemplateByTwoPartKey(ctx, conn, data.MembershipID.ValueString(), data.AnalysisTemplateID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Clean Rooms Analysis Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *analysisTemplateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new analysisTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	if !new.Description.Equal(old.Description) {
+		input := &cleanrooms.UpdateAnalysisTemplateInput{
+			AnalysisTemplateIdentifier: fwflex.StringFromFramework(ctx, new.AnalysisTemplateID),
+			Description:                aws.String(new.Description.ValueString()),
+			MembershipIdentifier:       fwflex.StringFromFramework(ctx, new.MembershipID),
+		}
+
+		output, err := conn.UpdateAnalysisTemplate(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Clean Rooms Analysis Template (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.UpdateTime = fwflex.TimeToFramework(ctx, output.AnalysisTemplate.UpdateTime)
+	} else {
+		new.UpdateTime = old.UpdateTime
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *analysisTemplateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data analysisTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	_, err := conn.DeleteAnalysisTemplate(ctx, &cleanrooms.DeleteAnalysisTemplateInput{
+		AnalysisTemplateIdentifier: aws.String(data.AnalysisTemplateID.ValueString()),
+		MembershipIdentifier:       aws.String(data.MembershipID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Clean Rooms Analysis Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *analysisTemplateResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findAnalysisTemplateByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, analysisTemplateID string) (*awstypes.AnalysisTemplate, error) {
+	input := &cleanrooms.GetAnalysisTemplateInput{
+		AnalysisTemplateIdentifier: aws.String(analysisTemplateID),
+		MembershipIdentifier:       aws.String(membershipID),
+	}
+
+	output, err := conn.GetAnalysisTemplate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AnalysisTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AnalysisTemplate, nil
+}
+
+func (r *analysisTemplateResource) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "cleanrooms", "membership/*/analysistemplate/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return flex.FlattenResourceId(parts, analysisTemplateResourceIDPartCount, false)
+}
+
+type analysisTemplateResourceModel struct {
+	AnalysisParameters fwtypes.ListNestedObjectValueOf[analysisParameterModel] `tfsdk:"analysis_parameters"`
+	AnalysisTemplateID types.String                                            `tfsdk:"analysis_template_id"`
+	ARN                types.String                                            `tfsdk:"arn"`
+	CollaborationARN   types.String                                            `tfsdk:"collaboration_arn"`
+	CollaborationID    types.String                                            `tfsdk:"collaboration_id"`
+	CreateTime         timetypes.RFC3339                                       `tfsdk:"create_time"`
+	Description        types.String                                            `tfsdk:"description"`
+	Format             fwtypes.StringEnum[awstypes.AnalysisFormat]             `tfsdk:"format"`
+	ID                 types.String                                            `tfsdk:"id"`
+	MembershipARN      types.String                                            `tfsdk:"membership_arn"`
+	MembershipID       types.String                                            `tfsdk:"membership_id"`
+	Name               types.String                                            `tfsdk:"name"`
+	Source             fwtypes.ListNestedObjectValueOf[analysisSourceModel]    `tfsdk:"source"`
+	Tags               types.Map                                               `tfsdk:"tags"`
+	TagsAll            types.Map                                               `tfsdk:"tags_all"`
+	UpdateTime         timetypes.RFC3339                                       `tfsdk:"update_time"`
+}
+
+const (
+	analysisTemplateResourceIDPartCount = 2
+)
+
+func (data *analysisTemplateResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), analysisTemplateResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.MembershipID = types.StringValue(parts[0])
+	data.AnalysisTemplateID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *analysisTemplateResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.MembershipID.ValueString(), data.AnalysisTemplateID.ValueString()}, analysisTemplateResourceIDPartCount, false)))
+}
+
+func (data *analysisTemplateResourceModel) expandSource(ctx context.Context, diags *diag.Diagnostics) awstypes.AnalysisSource {
+	sourceData, d := data.Source.ToPtr(ctx)
+	diags.Append(d...)
+	if sourceData == nil {
+		return nil
+	}
+
+	return &awstypes.AnalysisSourceMemberText{
+		Value: sourceData.Text.ValueString(),
+	}
+}
+
+func (data *analysisTemplateResourceModel) flatten(ctx context.Context, apiObject *awstypes.AnalysisTemplate) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The API's Id field is the analysis template ID, not the resource ID.
+	data.AnalysisTemplateID = fwflex.StringToFramework(ctx, apiObject.Id)
+	data.setID()
+
+	if v, ok := apiObject.Source.(*awstypes.AnalysisSourceMemberText); ok {
+		data.Source = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &analysisSourceModel{
+			Text: types.StringValue(v.Value),
+		})
+	} else {
+		data.Source = fwtypes.NewListNestedObjectValueOfNull[analysisSourceModel](ctx)
+	}
+
+	return diags
+}
+
+type analysisParameterModel struct {
+	DefaultValue types.String                               `tfsdk:"default_value"`
+	Name         types.String                               `tfsdk:"name"`
+	Type         fwtypes.StringEnum[awstypes.ParameterType] `tfsdk:"type"`
+}
+
+type analysisSourceModel struct {
+	Text types.String `tfsdk:"text"`
+}
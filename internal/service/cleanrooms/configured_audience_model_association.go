@@ -0,0 +1,354 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Configured Audience Model Association")
+// @Tags(identifierAttribute="arn")
+// @ARNIdentity
+func newConfiguredAudienceModelAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &configuredAudienceModelAssociationResource{}, nil
+}
+
+type configuredAudienceModelAssociationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*configuredAudienceModelAssociationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cleanrooms_configured_audience_model_association"
+}
+
+func (r *configuredAudienceModelAssociationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"collaboration_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collaboration_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"configured_audience_model_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"configured_audience_model_association_id": framework.IDAttribute(),
+			names.AttrCreateTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"manage_resource_policies": schema.BoolAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"membership_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"membership_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"update_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (r *configuredAudienceModelAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data configuredAudienceModelAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &cleanrooms.CreateConfiguredAudienceModelAssociationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ConfiguredAudienceModelAssociationName = aws.String(name)
+	input.MembershipIdentifier = fwflex.StringFromFramework(ctx, data.MembershipID)
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateConfiguredAudienceModelAssociation(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Clean Rooms Configured Audience Model Association (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output.ConfiguredAudienceModelAssociation)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *configuredAudienceModelAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data configuredAudienceModelAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	output, err := findConfiguredAudienceModelAssociationByTwoPartKey(ctx, conn, data.MembershipID.ValueString(), data.ConfiguredAudienceModelAssociationID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Clean Rooms Configured Audience Model Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *configuredAudienceModelAssociationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new configuredAudienceModelAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	if !new.Description.Equal(old.Description) ||
+		!new.Name.Equal(old.Name) {
+		input := &cleanrooms.UpdateConfiguredAudienceModelAssociationInput{
+			ConfiguredAudienceModelAssociationIdentifier: fwflex.StringFromFramework(ctx, new.ConfiguredAudienceModelAssociationID),
+			Description:          aws.String(new.Description.ValueString()),
+			MembershipIdentifier: fwflex.StringFromFramework(ctx, new.MembershipID),
+			Name:                 fwflex.StringFromFramework(ctx, new.Name),
+		}
+
+		output, err := conn.UpdateConfiguredAudienceModelAssociation(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Clean Rooms Configured Audience Model Association (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.UpdateTime = fwflex.TimeToFramework(ctx, output.ConfiguredAudienceModelAssociation.UpdateTime)
+	} else {
+		new.UpdateTime = old.UpdateTime
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *configuredAudienceModelAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data configuredAudienceModelAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	_, err := conn.DeleteConfiguredAudienceModelAssociation(ctx, &cleanrooms.DeleteConfiguredAudienceModelAssociationInput{
+		ConfiguredAudienceModelAssociationIdentifier: aws.String(data.ConfiguredAudienceModelAssociationID.ValueString()),
+		MembershipIdentifier:                         aws.String(data.MembershipID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Clean Rooms Configured Audience Model Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *configuredAudienceModelAssociationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findConfiguredAudienceModelAssociationByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, configuredAudienceModelAssociationID string) (*awstypes.ConfiguredAudienceModelAssociation, error) {
+	input := &cleanrooms.GetConfiguredAudienceModelAssociationInput{
+		ConfiguredAudienceModelAssociationIdentifier: aws.String(configuredAudienceModelAssociationID),
+		MembershipIdentifier:                         aws.String(membershipID),
+	}
+
+	output, err := conn.GetConfiguredAudienceModelAssociation(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConfiguredAudienceModelAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ConfiguredAudienceModelAssociation, nil
+}
+
+func (r *configuredAudienceModelAssociationResource) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "cleanrooms", "membership/*/configuredaudiencemodelassociation/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return flex.FlattenResourceId(parts, configuredAudienceModelAssociationResourceIDPartCount, false)
+}
+
+type configuredAudienceModelAssociationResourceModel struct {
+	ARN                                  types.String      `tfsdk:"arn"`
+	CollaborationARN                     types.String      `tfsdk:"collaboration_arn"`
+	CollaborationID                      types.String      `tfsdk:"collaboration_id"`
+	ConfiguredAudienceModelARN           fwtypes.ARN       `tfsdk:"configured_audience_model_arn"`
+	ConfiguredAudienceModelAssociationID types.String      `tfsdk:"configured_audience_model_association_id"`
+	CreateTime                           timetypes.RFC3339 `tfsdk:"create_time"`
+	Description                          types.String      `tfsdk:"description"`
+	ID                                   types.String      `tfsdk:"id"`
+	ManageResourcePolicies               types.Bool        `tfsdk:"manage_resource_policies"`
+	MembershipARN                        types.String      `tfsdk:"membership_arn"`
+	MembershipID                         types.String      `tfsdk:"membership_id"`
+	Name                                 types.String      `tfsdk:"name"`
+	Tags                                 types.Map         `tfsdk:"tags"`
+	TagsAll                              types.Map         `tfsdk:"tags_all"`
+	UpdateTime                           timetypes.RFC3339 `tfsdk:"update_time"`
+}
+
+const (
+	configuredAudienceModelAssociationResourceIDPartCount = 2
+)
+
+func (data *configuredAudienceModelAssociationResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), configuredAudienceModelAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.MembershipID = types.StringValue(parts[0])
+	data.ConfiguredAudienceModelAssociationID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *configuredAudienceModelAssociationResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.MembershipID.ValueString(), data.ConfiguredAudienceModelAssociationID.ValueString()}, configuredAudienceModelAssociationResourceIDPartCount, false)))
+}
+
+func (data *configuredAudienceModelAssociationResourceModel) flatten(ctx context.Context, apiObject *awstypes.ConfiguredAudienceModelAssociation) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The API's Id field is the association ID, not the resource ID.
+	data.ConfiguredAudienceModelAssociationID = fwflex.StringToFramework(ctx, apiObject.Id)
+	data.setID()
+
+	return diags
+}
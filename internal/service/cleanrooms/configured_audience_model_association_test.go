@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Configured audience models are managed by Clean Rooms ML, which this provider does not support,
+// so the tests require an existing model ARN.
+const envVarConfiguredAudienceModelARN = "CLEANROOMS_CONFIGURED_AUDIENCE_MODEL_ARN"
+
+func TestAccCleanRoomsConfiguredAudienceModelAssociation_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	modelARN := acctest.SkipIfEnvVarNotSet(t, envVarConfiguredAudienceModelARN)
+	var association awstypes.ConfiguredAudienceModelAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_audience_model_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredAudienceModelAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredAudienceModelAssociationConfig_basic(rName, rName, modelARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredAudienceModelAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "configured_audience_model_arn", modelARN),
+					resource.TestCheckResourceAttrSet(resourceName, "configured_audience_model_association_id"),
+					resource.TestCheckResourceAttr(resourceName, "manage_resource_policies", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_id", "aws_cleanrooms_membership.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredAudienceModelAssociationConfig_basic(rName, rNameUpdated, modelARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredAudienceModelAssociationExists(ctx, resourceName, &association),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredAudienceModelAssociation_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	modelARN := acctest.SkipIfEnvVarNotSet(t, envVarConfiguredAudienceModelARN)
+	var association awstypes.ConfiguredAudienceModelAssociation
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_audience_model_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredAudienceModelAssociationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredAudienceModelAssociationConfig_basic(rName, rName, modelARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredAudienceModelAssociationExists(ctx, resourceName, &association),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceConfiguredAudienceModelAssociation, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredAudienceModelAssociationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_configured_audience_model_association" {
+				continue
+			}
+
+			_, err := tfcleanrooms.FindConfiguredAudienceModelAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["configured_audience_model_association_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Clean Rooms Configured Audience Model Association %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfiguredAudienceModelAssociationExists(ctx context.Context, n string, v *awstypes.ConfiguredAudienceModelAssociation) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		output, err := tfcleanrooms.FindConfiguredAudienceModelAssociationByTwoPartKey(ctx, conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["configured_audience_model_association_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccConfiguredAudienceModelAssociationConfig_basic(rName, associationName, modelARN string) string {
+	return acctest.ConfigCompose(testAccMembershipConfig_basic(rName, "DISABLED"), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_audience_model_association" "test" {
+  membership_id                 = aws_cleanrooms_membership.test.id
+  configured_audience_model_arn = %[2]q
+  name                          = %[1]q
+  manage_resource_policies      = true
+}
+`, associationName, modelARN))
+}
@@ -0,0 +1,580 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Configured Table Analysis Rule")
+func newConfiguredTableAnalysisRuleResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &configuredTableAnalysisRuleResource{}, nil
+}
+
+type configuredTableAnalysisRuleResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*configuredTableAnalysisRuleResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cleanrooms_configured_table_analysis_rule"
+}
+
+func (r *configuredTableAnalysisRuleResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	joinOperatorsAttribute := schema.SetAttribute{
+		CustomType:  fwtypes.SetOfStringType,
+		ElementType: types.StringType,
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.Set{
+			setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.JoinOperator]()),
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"analysis_rule_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ConfiguredTableAnalysisRuleType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"configured_table_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"configured_table_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrCreateTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"update_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"analysis_rule_policy": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[analysisRulePolicyModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"aggregation": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[analysisRuleAggregationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("aggregation"),
+									path.MatchRelative().AtParent().AtName("custom"),
+									path.MatchRelative().AtParent().AtName("list"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_join_operators": joinOperatorsAttribute,
+									"dimension_columns": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+									"join_columns": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+									"join_required": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.JoinRequiredOption](),
+										Optional:   true,
+									},
+									"scalar_functions": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+										Validators: []validator.Set{
+											setvalidator.ValueStringsAre(enum.FrameworkValidate[awstypes.ScalarFunctions]()),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"aggregate_columns": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[aggregateColumnModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"column_names": schema.SetAttribute{
+													CustomType:  fwtypes.SetOfStringType,
+													ElementType: types.StringType,
+													Required:    true,
+												},
+												"function": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.AggregateFunctionName](),
+													Required:   true,
+												},
+											},
+										},
+									},
+									"output_constraints": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[aggregationConstraintModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"column_name": schema.StringAttribute{
+													Required: true,
+												},
+												"minimum": schema.Int64Attribute{
+													Required: true,
+												},
+												names.AttrType: schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.AggregationType](),
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"custom": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[analysisRuleCustomModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_analyses": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+									"allowed_analysis_providers": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"differential_privacy": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[differentialPrivacyConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"columns": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[differentialPrivacyColumnModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtLeast(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															names.AttrName: schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"list": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[analysisRuleListModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"allowed_join_operators": joinOperatorsAttribute,
+									"join_columns": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+									"list_columns": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *configuredTableAnalysisRuleResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data configuredTableAnalysisRuleResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	policy, diags := data.expandPolicy(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &cleanrooms.CreateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy:        policy,
+		AnalysisRuleType:          data.AnalysisRuleType.ValueEnum(),
+		ConfiguredTableIdentifier: fwflex.StringFromFramework(ctx, data.ConfiguredTableID),
+	}
+
+	output, err := conn.CreateConfiguredTableAnalysisRule(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Clean Rooms Configured Table (%s) Analysis Rule (%s)", data.ConfiguredTableID.ValueString(), data.AnalysisRuleType.ValueString()), err.Error())
+
+		return
+	}
+
+	data.setID()
+
+	response.Diagnostics.Append(data.flatten(ctx, output.AnalysisRule)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *configuredTableAnalysisRuleResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data configuredTableAnalysisRuleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	output, err := findConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, data.ConfiguredTableID.ValueString(), data.AnalysisRuleType.ValueEnum())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Clean Rooms Configured Table Analysis Rule (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *configuredTableAnalysisRuleResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new configuredTableAnalysisRuleResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	policy, diags := new.expandPolicy(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &cleanrooms.UpdateConfiguredTableAnalysisRuleInput{
+		AnalysisRulePolicy:        policy,
+		AnalysisRuleType:          new.AnalysisRuleType.ValueEnum(),
+		ConfiguredTableIdentifier: fwflex.StringFromFramework(ctx, new.ConfiguredTableID),
+	}
+
+	output, err := conn.UpdateConfiguredTableAnalysisRule(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Clean Rooms Configured Table Analysis Rule (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(new.flatten(ctx, output.AnalysisRule)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *configuredTableAnalysisRuleResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data configuredTableAnalysisRuleResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	_, err := conn.DeleteConfiguredTableAnalysisRule(ctx, &cleanrooms.DeleteConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          data.AnalysisRuleType.ValueEnum(),
+		ConfiguredTableIdentifier: aws.String(data.ConfiguredTableID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Clean Rooms Configured Table Analysis Rule (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findConfiguredTableAnalysisRuleByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, configuredTableID string, analysisRuleType awstypes.ConfiguredTableAnalysisRuleType) (*awstypes.ConfiguredTableAnalysisRule, error) {
+	input := &cleanrooms.GetConfiguredTableAnalysisRuleInput{
+		AnalysisRuleType:          analysisRuleType,
+		ConfiguredTableIdentifier: aws.String(configuredTableID),
+	}
+
+	output, err := conn.GetConfiguredTableAnalysisRule(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.AnalysisRule == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.AnalysisRule, nil
+}
+
+type configuredTableAnalysisRuleResourceModel struct {
+	AnalysisRulePolicy fwtypes.ListNestedObjectValueOf[analysisRulePolicyModel]     `tfsdk:"analysis_rule_policy"`
+	AnalysisRuleType   fwtypes.StringEnum[awstypes.ConfiguredTableAnalysisRuleType] `tfsdk:"analysis_rule_type"`
+	ConfiguredTableARN types.String                                                 `tfsdk:"configured_table_arn"`
+	ConfiguredTableID  types.String                                                 `tfsdk:"configured_table_id"`
+	CreateTime         timetypes.RFC3339                                            `tfsdk:"create_time"`
+	ID                 types.String                                                 `tfsdk:"id"`
+	UpdateTime         timetypes.RFC3339                                            `tfsdk:"update_time"`
+}
+
+const (
+	configuredTableAnalysisRuleResourceIDPartCount = 2
+)
+
+func (data *configuredTableAnalysisRuleResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), configuredTableAnalysisRuleResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ConfiguredTableID = types.StringValue(parts[0])
+	data.AnalysisRuleType = fwtypes.StringEnumValue(awstypes.ConfiguredTableAnalysisRuleType(parts[1]))
+
+	return nil
+}
+
+func (data *configuredTableAnalysisRuleResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.ConfiguredTableID.ValueString(), data.AnalysisRuleType.ValueString()}, configuredTableAnalysisRuleResourceIDPartCount, false)))
+}
+
+// expandPolicy builds the V1 policy union from whichever rule block is configured.
+func (data *configuredTableAnalysisRuleResourceModel) expandPolicy(ctx context.Context) (awstypes.ConfiguredTableAnalysisRulePolicy, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	policyData, d := data.AnalysisRulePolicy.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || policyData == nil {
+		return nil, diags
+	}
+
+	if v, d := policyData.Aggregation.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+		var apiObject awstypes.AnalysisRuleAggregation
+		diags.Append(fwflex.Expand(ctx, v, &apiObject)...)
+
+		return &awstypes.ConfiguredTableAnalysisRulePolicyMemberV1{
+			Value: &awstypes.ConfiguredTableAnalysisRulePolicyV1MemberAggregation{Value: apiObject},
+		}, diags
+	}
+
+	if v, d := policyData.Custom.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+		var apiObject awstypes.AnalysisRuleCustom
+		diags.Append(fwflex.Expand(ctx, v, &apiObject)...)
+
+		return &awstypes.ConfiguredTableAnalysisRulePolicyMemberV1{
+			Value: &awstypes.ConfiguredTableAnalysisRulePolicyV1MemberCustom{Value: apiObject},
+		}, diags
+	}
+
+	if v, d := policyData.List.ToPtr(ctx); v != nil {
+		diags.Append(d...)
+		var apiObject awstypes.AnalysisRuleList
+		diags.Append(fwflex.Expand(ctx, v, &apiObject)...)
+
+		return &awstypes.ConfiguredTableAnalysisRulePolicyMemberV1{
+			Value: &awstypes.ConfiguredTableAnalysisRulePolicyV1MemberList{Value: apiObject},
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func (data *configuredTableAnalysisRuleResourceModel) flatten(ctx context.Context, apiObject *awstypes.ConfiguredTableAnalysisRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.AnalysisRuleType = fwtypes.StringEnumValue(apiObject.Type)
+	data.ConfiguredTableARN = fwflex.StringToFramework(ctx, apiObject.ConfiguredTableArn)
+	data.ConfiguredTableID = fwflex.StringToFramework(ctx, apiObject.ConfiguredTableId)
+	data.CreateTime = fwflex.TimeToFramework(ctx, apiObject.CreateTime)
+	data.UpdateTime = fwflex.TimeToFramework(ctx, apiObject.UpdateTime)
+
+	policyData := &analysisRulePolicyModel{
+		Aggregation: fwtypes.NewListNestedObjectValueOfNull[analysisRuleAggregationModel](ctx),
+		Custom:      fwtypes.NewListNestedObjectValueOfNull[analysisRuleCustomModel](ctx),
+		List:        fwtypes.NewListNestedObjectValueOfNull[analysisRuleListModel](ctx),
+	}
+
+	if v, ok := apiObject.Policy.(*awstypes.ConfiguredTableAnalysisRulePolicyMemberV1); ok {
+		switch v := v.Value.(type) {
+		case *awstypes.ConfiguredTableAnalysisRulePolicyV1MemberAggregation:
+			var tfObject analysisRuleAggregationModel
+			diags.Append(fwflex.Flatten(ctx, v.Value, &tfObject)...)
+			policyData.Aggregation = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfObject)
+		case *awstypes.ConfiguredTableAnalysisRulePolicyV1MemberCustom:
+			var tfObject analysisRuleCustomModel
+			diags.Append(fwflex.Flatten(ctx, v.Value, &tfObject)...)
+			policyData.Custom = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfObject)
+		case *awstypes.ConfiguredTableAnalysisRulePolicyV1MemberList:
+			var tfObject analysisRuleListModel
+			diags.Append(fwflex.Flatten(ctx, v.Value, &tfObject)...)
+			policyData.List = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfObject)
+		}
+	}
+
+	data.AnalysisRulePolicy = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, policyData)
+
+	return diags
+}
+
+type analysisRulePolicyModel struct {
+	Aggregation fwtypes.ListNestedObjectValueOf[analysisRuleAggregationModel] `tfsdk:"aggregation"`
+	Custom      fwtypes.ListNestedObjectValueOf[analysisRuleCustomModel]      `tfsdk:"custom"`
+	List        fwtypes.ListNestedObjectValueOf[analysisRuleListModel]        `tfsdk:"list"`
+}
+
+type analysisRuleAggregationModel struct {
+	AggregateColumns     fwtypes.ListNestedObjectValueOf[aggregateColumnModel]       `tfsdk:"aggregate_columns"`
+	AllowedJoinOperators fwtypes.SetValueOf[types.String]                            `tfsdk:"allowed_join_operators"`
+	DimensionColumns     fwtypes.SetValueOf[types.String]                            `tfsdk:"dimension_columns"`
+	JoinColumns          fwtypes.SetValueOf[types.String]                            `tfsdk:"join_columns"`
+	JoinRequired         fwtypes.StringEnum[awstypes.JoinRequiredOption]             `tfsdk:"join_required"`
+	OutputConstraints    fwtypes.ListNestedObjectValueOf[aggregationConstraintModel] `tfsdk:"output_constraints"`
+	ScalarFunctions      fwtypes.SetValueOf[types.String]                            `tfsdk:"scalar_functions"`
+}
+
+type aggregateColumnModel struct {
+	ColumnNames fwtypes.SetValueOf[types.String]                   `tfsdk:"column_names"`
+	Function    fwtypes.StringEnum[awstypes.AggregateFunctionName] `tfsdk:"function"`
+}
+
+type aggregationConstraintModel struct {
+	ColumnName types.String                                 `tfsdk:"column_name"`
+	Minimum    types.Int64                                  `tfsdk:"minimum"`
+	Type       fwtypes.StringEnum[awstypes.AggregationType] `tfsdk:"type"`
+}
+
+type analysisRuleCustomModel struct {
+	AllowedAnalyses          fwtypes.SetValueOf[types.String]                                       `tfsdk:"allowed_analyses"`
+	AllowedAnalysisProviders fwtypes.SetValueOf[types.String]                                       `tfsdk:"allowed_analysis_providers"`
+	DifferentialPrivacy      fwtypes.ListNestedObjectValueOf[differentialPrivacyConfigurationModel] `tfsdk:"differential_privacy"`
+}
+
+type differentialPrivacyConfigurationModel struct {
+	Columns fwtypes.ListNestedObjectValueOf[differentialPrivacyColumnModel] `tfsdk:"columns"`
+}
+
+type differentialPrivacyColumnModel struct {
+	Name types.String `tfsdk:"name"`
+}
+
+type analysisRuleListModel struct {
+	AllowedJoinOperators fwtypes.SetValueOf[types.String] `tfsdk:"allowed_join_operators"`
+	JoinColumns          fwtypes.SetValueOf[types.String] `tfsdk:"join_columns"`
+	ListColumns          fwtypes.SetValueOf[types.String] `tfsdk:"list_columns"`
+}
@@ -0,0 +1,204 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule awstypes.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName, "my_column_2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.list.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.list.0.list_columns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "analysis_rule_policy.0.list.0.list_columns.*", "my_column_2"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "LIST"),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_arn", "aws_cleanrooms_configured_table.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "configured_table_id", "aws_cleanrooms_configured_table.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName, "my_column_1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckTypeSetElemAttr(resourceName, "analysis_rule_policy.0.list.0.list_columns.*", "my_column_1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule awstypes.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_list(rName, "my_column_2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &rule),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourceConfiguredTableAnalysisRule, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsConfiguredTableAnalysisRule_custom(t *testing.T) {
+	ctx := acctest.Context(t)
+	var rule awstypes.ConfiguredTableAnalysisRule
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_configured_table_analysis_rule.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckConfiguredTableAnalysisRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfiguredTableAnalysisRuleConfig_custom(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckConfiguredTableAnalysisRuleExists(ctx, resourceName, &rule),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.custom.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.custom.0.differential_privacy.0.columns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_policy.0.custom.0.differential_privacy.0.columns.0.name", "my_column_1"),
+					resource.TestCheckResourceAttr(resourceName, "analysis_rule_type", "CUSTOM"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckConfiguredTableAnalysisRuleDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_configured_table_analysis_rule" {
+				continue
+			}
+
+			_, err := tfcleanrooms.FindConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes["configured_table_id"], awstypes.ConfiguredTableAnalysisRuleType(rs.Primary.Attributes["analysis_rule_type"]))
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Clean Rooms Configured Table Analysis Rule %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckConfiguredTableAnalysisRuleExists(ctx context.Context, n string, v *awstypes.ConfiguredTableAnalysisRule) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		output, err := tfcleanrooms.FindConfiguredTableAnalysisRuleByTwoPartKey(ctx, conn, rs.Primary.Attributes["configured_table_id"], awstypes.ConfiguredTableAnalysisRuleType(rs.Primary.Attributes["analysis_rule_type"]))
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_list(rName, listColumn string) string {
+	return acctest.ConfigCompose(
+		testAccConfiguredTableConfig(rName, rName, rName, TEST_TAG, TEST_ALLOWED_COLUMNS, TEST_ANALYSIS_METHOD, rName, rName),
+		fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "LIST"
+
+  analysis_rule_policy {
+    list {
+      join_columns = ["my_column_1"]
+      list_columns = [%[1]q]
+    }
+  }
+}
+`, listColumn))
+}
+
+func testAccConfiguredTableAnalysisRuleConfig_custom(rName string) string {
+	return acctest.ConfigCompose(
+		testAccConfiguredTableConfig(rName, rName, rName, TEST_TAG, TEST_ALLOWED_COLUMNS, TEST_ANALYSIS_METHOD, rName, rName),
+		`
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "CUSTOM"
+
+  analysis_rule_policy {
+    custom {
+      allowed_analyses = ["ANY_QUERY"]
+
+      differential_privacy {
+        columns {
+          name = "my_column_1"
+        }
+      }
+    }
+  }
+}
+`)
+}
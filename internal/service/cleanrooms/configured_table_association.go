@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Configured Table Association")
+// @Tags(identifierAttribute="arn")
+// @ARNIdentity
+func newConfiguredTableAssociationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &configuredTableAssociationResource{}, nil
+}
+
+type configuredTableAssociationResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*configuredTableAssociationResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cleanrooms_configured_table_association"
+}
+
+func (r *configuredTableAssociationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:                     framework.ARNAttributeComputedOnly(),
+			"configured_table_association_id": framework.IDAttribute(),
+			"configured_table_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrCreateTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(255),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"membership_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 128),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"update_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (r *configuredTableAssociationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data configuredTableAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &cleanrooms.CreateConfiguredTableAssociationInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ConfiguredTableIdentifier = fwflex.StringFromFramework(ctx, data.ConfiguredTableID)
+	input.MembershipIdentifier = fwflex.StringFromFramework(ctx, data.MembershipID)
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateConfiguredTableAssociation(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Clean Rooms Configured Table Association (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.ConfiguredTableAssociation, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Set values for unknowns.
+	data.ConfiguredTableAssociationID = fwflex.StringToFramework(ctx, output.ConfiguredTableAssociation.Id)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *configuredTableAssociationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data configuredTableAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	output, err := findConfiguredTableAssociationByTwoPartKey(ctx, conn, data.MembershipID.ValueString(), data.ConfiguredTableAssociationID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Clean Rooms Configured Table Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ConfiguredTableAssociationID = fwflex.StringToFramework(ctx, output.Id)
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *configuredTableAssociationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new configuredTableAssociationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	if !new.Description.Equal(old.Description) ||
+		!new.RoleARN.Equal(old.RoleARN) {
+		input := &cleanrooms.UpdateConfiguredTableAssociationInput{
+			ConfiguredTableAssociationIdentifier: fwflex.StringFromFramework(ctx, new.ConfiguredTableAssociationID),
+			Description:                          aws.String(new.Description.ValueString()),
+			MembershipIdentifier:                 fwflex.StringFromFramework(ctx, new.MembershipID),
+			RoleArn:                              fwflex.StringFromFramework(ctx, new.RoleARN),
+		}
+
+		output, err := conn.UpdateConfiguredTableAssociation(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Clean Rooms Configured Table Association (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.UpdateTime = fwflex.TimeToFramework(ctx, output.ConfiguredTableAssociation.UpdateTime)
+	} else {
+		new.UpdateTime = old.UpdateTime
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *configuredTableAssociationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data configuredTableAssociationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	_, err := conn.DeleteConfiguredTableAssociation(ctx, &cleanrooms.DeleteConfiguredTableAssociationInput{
+		ConfiguredTableAssociationIdentifier: aws.String(data.ConfiguredTableAssociationID.ValueString()),
+		MembershipIdentifier:                 aws.String(data.MembershipID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Clean Rooms Configured Table Association (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *configuredTableAssociationResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findConfiguredTableAssociationByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, configuredTableAssociationID string) (*awstypes.ConfiguredTableAssociation, error) {
+	input := &cleanrooms.GetConfiguredTableAssociationInput{
+		ConfiguredTableAssociationIdentifier: aws.String(configuredTableAssociationID),
+		MembershipIdentifier:                 aws.String(membershipID),
+	}
+
+	output, err := conn.GetConfiguredTableAssociation(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ConfiguredTableAssociation == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ConfiguredTableAssociation, nil
+}
+
+func (r *configuredTableAssociationResource) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "cleanrooms", "membership/*/configuredtableassociation/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return flex.FlattenResourceId(parts, configuredTableAssociationResourceIDPartCount, false)
+}
+
+type configuredTableAssociationResourceModel struct {
+	ARN                          types.String      `tfsdk:"arn"`
+	ConfiguredTableAssociationID types.String      `tfsdk:"configured_table_association_id"`
+	ConfiguredTableID            types.String      `tfsdk:"configured_table_id"`
+	CreateTime                   timetypes.RFC3339 `tfsdk:"create_time"`
+	Description                  types.String      `tfsdk:"description"`
+	ID                           types.String      `tfsdk:"id"`
+	MembershipID                 types.String      `tfsdk:"membership_id"`
+	Name                         types.String      `tfsdk:"name"`
+	RoleARN                      fwtypes.ARN       `tfsdk:"role_arn"`
+	Tags                         types.Map         `tfsdk:"tags"`
+	TagsAll                      types.Map         `tfsdk:"tags_all"`
+	UpdateTime                   timetypes.RFC3339 `tfsdk:"update_time"`
+}
+
+const (
+	configuredTableAssociationResourceIDPartCount = 2
+)
+
+func (data *configuredTableAssociationResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), configuredTableAssociationResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.MembershipID = types.StringValue(parts[0])
+	data.ConfiguredTableAssociationID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *configuredTableAssociationResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.MembershipID.ValueString(), data.ConfiguredTableAssociationID.ValueString()}, configuredTableAssociationResourceIDPartCount, false)))
+}
@@ -0,0 +1,21 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+// Exports for use in tests only.
+var (
+	ResourceAnalysisTemplate                   = newAnalysisTemplateResource
+	ResourceConfiguredAudienceModelAssociation = newConfiguredAudienceModelAssociationResource
+	ResourceConfiguredTableAnalysisRule        = newConfiguredTableAnalysisRuleResource
+	ResourceConfiguredTableAssociation         = newConfiguredTableAssociationResource
+	ResourceMembership                         = newMembershipResource
+	ResourcePrivacyBudgetTemplate              = newPrivacyBudgetTemplateResource
+
+	FindAnalysisTemplateByTwoPartKey                   = findAnalysisTemplateByTwoPartKey
+	FindConfiguredAudienceModelAssociationByTwoPartKey = findConfiguredAudienceModelAssociationByTwoPartKey
+	FindConfiguredTableAnalysisRuleByTwoPartKey        = findConfiguredTableAnalysisRuleByTwoPartKey
+	FindConfiguredTableAssociationByTwoPartKey         = findConfiguredTableAssociationByTwoPartKey
+	FindMembershipByID                                 = findMembershipByID
+	FindPrivacyBudgetTemplateByTwoPartKey              = findPrivacyBudgetTemplateByTwoPartKey
+)
@@ -0,0 +1,515 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Membership")
+// @Tags(identifierAttribute="arn")
+// @ARNIdentity
+func newMembershipResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &membershipResource{}, nil
+}
+
+type membershipResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*membershipResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cleanrooms_membership"
+}
+
+func (r *membershipResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:       framework.ARNAttributeComputedOnly(),
+			"collaboration_arn": framework.ARNAttributeComputedOnly(),
+			"collaboration_creator_account_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collaboration_creator_display_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collaboration_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"collaboration_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrCreateTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"member_abilities": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"query_log_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.MembershipQueryLogStatus](),
+				Required:   true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.MembershipStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"update_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_result_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[membershipDefaultResultConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrRoleARN: schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Optional:   true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"output_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[membershipOutputConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"s3": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[protectedQueryS3OutputConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrBucket: schema.StringAttribute{
+													Required: true,
+												},
+												"key_prefix": schema.StringAttribute{
+													Optional: true,
+												},
+												"result_format": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ResultFormat](),
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"payment_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[membershipPaymentConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"query_compute": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[membershipQueryComputePaymentConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"is_responsible": schema.BoolAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *membershipResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data membershipResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	input := &cleanrooms.CreateMembershipInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.CollaborationIdentifier = fwflex.StringFromFramework(ctx, data.CollaborationID)
+	defaultResultConfiguration, diags := data.expandDefaultResultConfiguration(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	input.DefaultResultConfiguration = defaultResultConfiguration
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateMembership(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Clean Rooms Membership (%s)", data.CollaborationID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output.Membership)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *membershipResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data membershipResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	output, err := findMembershipByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Clean Rooms Membership (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *membershipResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new membershipResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	if !new.DefaultResultConfiguration.Equal(old.DefaultResultConfiguration) ||
+		!new.QueryLogStatus.Equal(old.QueryLogStatus) {
+		input := &cleanrooms.UpdateMembershipInput{
+			MembershipIdentifier: fwflex.StringFromFramework(ctx, new.ID),
+			QueryLogStatus:       new.QueryLogStatus.ValueEnum(),
+		}
+
+		defaultResultConfiguration, diags := new.expandDefaultResultConfiguration(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		input.DefaultResultConfiguration = defaultResultConfiguration
+
+		output, err := conn.UpdateMembership(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Clean Rooms Membership (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(new.flatten(ctx, output.Membership)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.UpdateTime = old.UpdateTime
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *membershipResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data membershipResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	_, err := conn.DeleteMembership(ctx, &cleanrooms.DeleteMembershipInput{
+		MembershipIdentifier: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Clean Rooms Membership (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *membershipResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findMembershipByID(ctx context.Context, conn *cleanrooms.Client, id string) (*awstypes.Membership, error) {
+	input := &cleanrooms.GetMembershipInput{
+		MembershipIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetMembership(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Membership == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Deleted memberships remain visible with a terminal status.
+	if status := output.Membership.Status; status == awstypes.MembershipStatusRemoved || status == awstypes.MembershipStatusCollaborationDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	return output.Membership, nil
+}
+
+func (r *membershipResource) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "cleanrooms", "membership/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return parts[0], nil
+}
+
+type membershipResourceModel struct {
+	ARN                             types.String                                                               `tfsdk:"arn"`
+	CollaborationARN                types.String                                                               `tfsdk:"collaboration_arn"`
+	CollaborationCreatorAccountID   types.String                                                               `tfsdk:"collaboration_creator_account_id"`
+	CollaborationCreatorDisplayName types.String                                                               `tfsdk:"collaboration_creator_display_name"`
+	CollaborationID                 types.String                                                               `tfsdk:"collaboration_id"`
+	CollaborationName               types.String                                                               `tfsdk:"collaboration_name"`
+	CreateTime                      timetypes.RFC3339                                                          `tfsdk:"create_time"`
+	DefaultResultConfiguration      fwtypes.ListNestedObjectValueOf[membershipDefaultResultConfigurationModel] `tfsdk:"default_result_configuration"`
+	ID                              types.String                                                               `tfsdk:"id"`
+	MemberAbilities                 fwtypes.ListValueOf[types.String]                                          `tfsdk:"member_abilities"`
+	PaymentConfiguration            fwtypes.ListNestedObjectValueOf[membershipPaymentConfigurationModel]       `tfsdk:"payment_configuration"`
+	QueryLogStatus                  fwtypes.StringEnum[awstypes.MembershipQueryLogStatus]                      `tfsdk:"query_log_status"`
+	Status                          fwtypes.StringEnum[awstypes.MembershipStatus]                              `tfsdk:"status"`
+	Tags                            types.Map                                                                  `tfsdk:"tags"`
+	TagsAll                         types.Map                                                                  `tfsdk:"tags_all"`
+	UpdateTime                      timetypes.RFC3339                                                          `tfsdk:"update_time"`
+}
+
+func (data *membershipResourceModel) flatten(ctx context.Context, apiObject *awstypes.Membership) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The service reports a payment configuration even when none was requested.
+	paymentConfiguration := data.PaymentConfiguration
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if paymentConfiguration.IsNull() {
+		data.PaymentConfiguration = paymentConfiguration
+	}
+	data.DefaultResultConfiguration = flattenMembershipDefaultResultConfiguration(ctx, apiObject.DefaultResultConfiguration)
+
+	return diags
+}
+
+// The output configuration is a union type, so the default result configuration is expanded and flattened by hand.
+func (data *membershipResourceModel) expandDefaultResultConfiguration(ctx context.Context) (*awstypes.MembershipProtectedQueryResultConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	defaultResultConfigurationData, d := data.DefaultResultConfiguration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || defaultResultConfigurationData == nil {
+		return nil, diags
+	}
+
+	apiObject := &awstypes.MembershipProtectedQueryResultConfiguration{
+		RoleArn: fwflex.StringFromFramework(ctx, defaultResultConfigurationData.RoleARN),
+	}
+
+	outputConfigurationData, d := defaultResultConfigurationData.OutputConfiguration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	if outputConfigurationData != nil {
+		s3Data, d := outputConfigurationData.S3.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		if s3Data != nil {
+			outputConfiguration := &awstypes.MembershipProtectedQueryOutputConfigurationMemberS3{}
+			diags.Append(fwflex.Expand(ctx, s3Data, &outputConfiguration.Value)...)
+			if diags.HasError() {
+				return nil, diags
+			}
+
+			apiObject.OutputConfiguration = outputConfiguration
+		}
+	}
+
+	return apiObject, diags
+}
+
+func flattenMembershipDefaultResultConfiguration(ctx context.Context, apiObject *awstypes.MembershipProtectedQueryResultConfiguration) fwtypes.ListNestedObjectValueOf[membershipDefaultResultConfigurationModel] {
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[membershipDefaultResultConfigurationModel](ctx)
+	}
+
+	outputConfigurationData := &membershipOutputConfigurationModel{
+		S3: fwtypes.NewListNestedObjectValueOfNull[protectedQueryS3OutputConfigurationModel](ctx),
+	}
+
+	if v, ok := apiObject.OutputConfiguration.(*awstypes.MembershipProtectedQueryOutputConfigurationMemberS3); ok {
+		outputConfigurationData.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &protectedQueryS3OutputConfigurationModel{
+			Bucket:       fwflex.StringToFramework(ctx, v.Value.Bucket),
+			KeyPrefix:    fwflex.StringToFramework(ctx, v.Value.KeyPrefix),
+			ResultFormat: fwtypes.StringEnumValue(v.Value.ResultFormat),
+		})
+	}
+
+	roleARN := fwtypes.ARNNull()
+	if v := apiObject.RoleArn; v != nil {
+		roleARN = fwtypes.ARNValue(aws.ToString(v))
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &membershipDefaultResultConfigurationModel{
+		OutputConfiguration: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, outputConfigurationData),
+		RoleARN:             roleARN,
+	})
+}
+
+type membershipDefaultResultConfigurationModel struct {
+	OutputConfiguration fwtypes.ListNestedObjectValueOf[membershipOutputConfigurationModel] `tfsdk:"output_configuration"`
+	RoleARN             fwtypes.ARN                                                         `tfsdk:"role_arn"`
+}
+
+type membershipOutputConfigurationModel struct {
+	S3 fwtypes.ListNestedObjectValueOf[protectedQueryS3OutputConfigurationModel] `tfsdk:"s3"`
+}
+
+type protectedQueryS3OutputConfigurationModel struct {
+	Bucket       types.String                              `tfsdk:"bucket"`
+	KeyPrefix    types.String                              `tfsdk:"key_prefix"`
+	ResultFormat fwtypes.StringEnum[awstypes.ResultFormat] `tfsdk:"result_format"`
+}
+
+type membershipPaymentConfigurationModel struct {
+	QueryCompute fwtypes.ListNestedObjectValueOf[membershipQueryComputePaymentConfigModel] `tfsdk:"query_compute"`
+}
+
+type membershipQueryComputePaymentConfigModel struct {
+	IsResponsible types.Bool `tfsdk:"is_responsible"`
+}
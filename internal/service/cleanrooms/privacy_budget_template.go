@@ -0,0 +1,403 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cleanrooms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Privacy Budget Template")
+// @Tags(identifierAttribute="arn")
+// @ARNIdentity
+func newPrivacyBudgetTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &privacyBudgetTemplateResource{}, nil
+}
+
+type privacyBudgetTemplateResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*privacyBudgetTemplateResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_cleanrooms_privacy_budget_template"
+}
+
+func (r *privacyBudgetTemplateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"auto_refresh": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PrivacyBudgetTemplateAutoRefresh](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"collaboration_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"collaboration_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrCreateTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"membership_arn": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"membership_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"privacy_budget_template_id": framework.IDAttribute(),
+			"privacy_budget_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PrivacyBudgetType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"update_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrParameters: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[differentialPrivacyTemplateParametersModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"epsilon": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 20),
+							},
+						},
+						"users_noise_per_query": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(10, 100),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *privacyBudgetTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data privacyBudgetTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	parametersData, diags := data.Parameters.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var parameters awstypes.DifferentialPrivacyTemplateParametersInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, parametersData, &parameters)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &cleanrooms.CreatePrivacyBudgetTemplateInput{
+		AutoRefresh:          data.AutoRefresh.ValueEnum(),
+		MembershipIdentifier: fwflex.StringFromFramework(ctx, data.MembershipID),
+		Parameters: &awstypes.PrivacyBudgetTemplateParametersInputMemberDifferentialPrivacy{
+			Value: parameters,
+		},
+		PrivacyBudgetType: data.PrivacyBudgetType.ValueEnum(),
+		Tags:              getTagsIn(ctx),
+	}
+
+	output, err := conn.CreatePrivacyBudgetTemplate(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Clean Rooms Privacy Budget Template (%s)", data.MembershipID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output.PrivacyBudgetTemplate)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *privacyBudgetTemplateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data privacyBudgetTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	output, err := findPrivacyBudgetTemplateByTwoPartKey(ctx, conn, data.MembershipID.ValueString(), data.PrivacyBudgetTemplateID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Clean Rooms Privacy Budget Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *privacyBudgetTemplateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new privacyBudgetTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	if !new.Parameters.Equal(old.Parameters) {
+		parametersData, diags := new.Parameters.ToPtr(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		var parameters awstypes.DifferentialPrivacyTemplateUpdateParameters
+		response.Diagnostics.Append(fwflex.Expand(ctx, parametersData, &parameters)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &cleanrooms.UpdatePrivacyBudgetTemplateInput{
+			MembershipIdentifier: fwflex.StringFromFramework(ctx, new.MembershipID),
+			Parameters: &awstypes.PrivacyBudgetTemplateUpdateParametersMemberDifferentialPrivacy{
+				Value: parameters,
+			},
+			PrivacyBudgetTemplateIdentifier: fwflex.StringFromFramework(ctx, new.PrivacyBudgetTemplateID),
+			PrivacyBudgetType:               new.PrivacyBudgetType.ValueEnum(),
+		}
+
+		output, err := conn.UpdatePrivacyBudgetTemplate(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Clean Rooms Privacy Budget Template (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.UpdateTime = fwflex.TimeToFramework(ctx, output.PrivacyBudgetTemplate.UpdateTime)
+	} else {
+		new.UpdateTime = old.UpdateTime
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *privacyBudgetTemplateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data privacyBudgetTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().CleanRoomsClient(ctx)
+
+	_, err := conn.DeletePrivacyBudgetTemplate(ctx, &cleanrooms.DeletePrivacyBudgetTemplateInput{
+		MembershipIdentifier:            aws.String(data.MembershipID.ValueString()),
+		PrivacyBudgetTemplateIdentifier: aws.String(data.PrivacyBudgetTemplateID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Clean Rooms Privacy Budget Template (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *privacyBudgetTemplateResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findPrivacyBudgetTemplateByTwoPartKey(ctx context.Context, conn *cleanrooms.Client, membershipID, privacyBudgetTemplateID string) (*awstypes.PrivacyBudgetTemplate, error) {
+	input := &cleanrooms.GetPrivacyBudgetTemplateInput{
+		MembershipIdentifier:            aws.String(membershipID),
+		PrivacyBudgetTemplateIdentifier: aws.String(privacyBudgetTemplateID),
+	}
+
+	output, err := conn.GetPrivacyBudgetTemplate(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PrivacyBudgetTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PrivacyBudgetTemplate, nil
+}
+
+func (r *privacyBudgetTemplateResource) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "cleanrooms", "membership/*/privacybudgettemplate/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return flex.FlattenResourceId(parts, privacyBudgetTemplateResourceIDPartCount, false)
+}
+
+type privacyBudgetTemplateResourceModel struct {
+	ARN                     types.String                                                                `tfsdk:"arn"`
+	AutoRefresh             fwtypes.StringEnum[awstypes.PrivacyBudgetTemplateAutoRefresh]               `tfsdk:"auto_refresh"`
+	CollaborationARN        types.String                                                                `tfsdk:"collaboration_arn"`
+	CollaborationID         types.String                                                                `tfsdk:"collaboration_id"`
+	CreateTime              timetypes.RFC3339                                                           `tfsdk:"create_time"`
+	ID                      types.String                                                                `tfsdk:"id"`
+	MembershipARN           types.String                                                                `tfsdk:"membership_arn"`
+	MembershipID            types.String                                                                `tfsdk:"membership_id"`
+	Parameters              fwtypes.ListNestedObjectValueOf[differentialPrivacyTemplateParametersModel] `tfsdk:"parameters"`
+	PrivacyBudgetTemplateID types.String                                                                `tfsdk:"privacy_budget_template_id"`
+	PrivacyBudgetType       fwtypes.StringEnum[awstypes.PrivacyBudgetType]                              `tfsdk:"privacy_budget_type"`
+	Tags                    types.Map                                                                   `tfsdk:"tags"`
+	TagsAll                 types.Map                                                                   `tfsdk:"tags_all"`
+	UpdateTime              timetypes.RFC3339                                                           `tfsdk:"update_time"`
+}
+
+const (
+	privacyBudgetTemplateResourceIDPartCount = 2
+)
+
+func (data *privacyBudgetTemplateResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), privacyBudgetTemplateResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.MembershipID = types.StringValue(parts[0])
+	data.PrivacyBudgetTemplateID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *privacyBudgetTemplateResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.MembershipID.ValueString(), data.PrivacyBudgetTemplateID.ValueString()}, privacyBudgetTemplateResourceIDPartCount, false)))
+}
+
+func (data *privacyBudgetTemplateResourceModel) flatten(ctx context.Context, apiObject *awstypes.PrivacyBudgetTemplate) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The API's Id field is the privacy budget template ID, not the resource ID.
+	data.PrivacyBudgetTemplateID = fwflex.StringToFramework(ctx, apiObject.Id)
+	data.setID()
+
+	if v, ok := apiObject.Parameters.(*awstypes.PrivacyBudgetTemplateParametersOutputMemberDifferentialPrivacy); ok {
+		var tfObject differentialPrivacyTemplateParametersModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &tfObject)...)
+		data.Parameters = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfObject)
+	} else {
+		data.Parameters = fwtypes.NewListNestedObjectValueOfNull[differentialPrivacyTemplateParametersModel](ctx)
+	}
+
+	return diags
+}
+
+type differentialPrivacyTemplateParametersModel struct {
+	Epsilon            types.Int64 `tfsdk:"epsilon"`
+	UsersNoisePerQuery types.Int64 `tfsdk:"users_noise_per_query"`
+}
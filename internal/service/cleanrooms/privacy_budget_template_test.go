@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cleanrooms_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cleanrooms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcleanrooms "github.com/hashicorp/terraform-provider-aws/internal/service/cleanrooms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCleanRoomsPrivacyBudgetTemplate_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template awstypes.PrivacyBudgetTemplate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_privacy_budget_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPrivacyBudgetTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivacyBudgetTemplateConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPrivacyBudgetTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "auto_refresh", "CALENDAR_MONTH"),
+					resource.TestCheckResourceAttrPair(resourceName, "membership_id", "aws_cleanrooms_membership.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.epsilon", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.users_noise_per_query", "10"),
+					resource.TestCheckResourceAttrSet(resourceName, "privacy_budget_template_id"),
+					resource.TestCheckResourceAttr(resourceName, "privacy_budget_type", "DIFFERENTIAL_PRIVACY"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPrivacyBudgetTemplateConfig_basic(rName, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPrivacyBudgetTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "parameters.0.epsilon", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCleanRoomsPrivacyBudgetTemplate_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template awstypes.PrivacyBudgetTemplate
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cleanrooms_privacy_budget_template.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CleanRoomsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPrivacyBudgetTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPrivacyBudgetTemplateConfig_basic(rName, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckPrivacyBudgetTemplateExists(ctx, resourceName, &template),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfcleanrooms.ResourcePrivacyBudgetTemplate, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckPrivacyBudgetTemplateDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_cleanrooms_privacy_budget_template" {
+				continue
+			}
+
+			_, err := tfcleanrooms.FindPrivacyBudgetTemplateByTwoPartKey(ctx, conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["privacy_budget_template_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Clean Rooms Privacy Budget Template %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPrivacyBudgetTemplateExists(ctx context.Context, n string, v *awstypes.PrivacyBudgetTemplate) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CleanRoomsClient(ctx)
+
+		output, err := tfcleanrooms.FindPrivacyBudgetTemplateByTwoPartKey(ctx, conn, rs.Primary.Attributes["membership_id"], rs.Primary.Attributes["privacy_budget_template_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPrivacyBudgetTemplateConfig_basic(rName string, epsilon int) string {
+	return acctest.ConfigCompose(testAccConfiguredTableAssociationConfig_basic(rName, rName), fmt.Sprintf(`
+resource "aws_cleanrooms_configured_table_analysis_rule" "test" {
+  configured_table_id = aws_cleanrooms_configured_table.test.id
+  analysis_rule_type  = "CUSTOM"
+
+  analysis_rule_policy {
+    custom {
+      allowed_analyses = ["ANY_QUERY"]
+
+      differential_privacy {
+        columns {
+          name = "my_column_1"
+        }
+      }
+    }
+  }
+}
+
+resource "aws_cleanrooms_privacy_budget_template" "test" {
+  membership_id       = aws_cleanrooms_membership.test.id
+  auto_refresh        = "CALENDAR_MONTH"
+  privacy_budget_type = "DIFFERENTIAL_PRIVACY"
+
+  parameters {
+    epsilon               = %[1]d
+    users_noise_per_query = 10
+  }
+
+  depends_on = [aws_cleanrooms_configured_table_analysis_rule.test]
+}
+`, epsilon))
+}
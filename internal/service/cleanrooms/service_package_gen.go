@@ -20,7 +20,62 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newAnalysisTemplateResource,
+			Name:    "Analysis Template",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+			Identity: &types.ServicePackageResourceIdentity{
+				ARNAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newConfiguredAudienceModelAssociationResource,
+			Name:    "Configured Audience Model Association",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+			Identity: &types.ServicePackageResourceIdentity{
+				ARNAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newConfiguredTableAnalysisRuleResource,
+			Name:    "Configured Table Analysis Rule",
+		},
+		{
+			Factory: newConfiguredTableAssociationResource,
+			Name:    "Configured Table Association",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+			Identity: &types.ServicePackageResourceIdentity{
+				ARNAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newMembershipResource,
+			Name:    "Membership",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+			Identity: &types.ServicePackageResourceIdentity{
+				ARNAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newPrivacyBudgetTemplateResource,
+			Name:    "Privacy Budget Template",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+			Identity: &types.ServicePackageResourceIdentity{
+				ARNAttribute: names.AttrARN,
+			},
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
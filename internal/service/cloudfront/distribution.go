@@ -5,14 +5,20 @@ package cloudfront
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
+	"slices"
 	"time"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -676,6 +682,36 @@ func resourceDistribution() *schema.Resource {
 					},
 				},
 			},
+			"origin_access_control_bucket_policy": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrBucket: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrPolicy: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"origin_access_identity_migration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"origin_access_control_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+					},
+				},
+			},
 			"price_class": {
 				Type:             schema.TypeString,
 				Optional:         true,
@@ -836,10 +872,45 @@ func resourceDistribution() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceDistributionCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
+func resourceDistributionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	const (
+		key = "origin_access_control_bucket_policy"
+	)
+
+	// Compute the bucket policies from the planned origins so that they are known before the origins are switched.
+	v := diff.Get("origin_access_identity_migration").([]interface{})
+	if len(v) == 0 {
+		if len(diff.Get(key).([]interface{})) > 0 {
+			return diff.SetNew(key, []interface{}{})
+		}
+
+		return nil
+	}
+
+	distributionARN := diff.Get(names.AttrARN).(string)
+	if diff.Id() == "" || distributionARN == "" || !diff.GetRawPlan().GetAttr("origin").IsWhollyKnown() || !diff.GetRawPlan().GetAttr("origin_access_identity_migration").IsWhollyKnown() {
+		return diff.SetNewComputed(key)
+	}
+
+	oacID := originAccessIdentityMigrationControlID(v)
+	apiObject := expandOrigins(diff.Get("origin").(*schema.Set).List())
+	migrateOriginAccessIdentities(apiObject, oacID)
+	bucketPolicies := originAccessControlBucketPolicies(apiObject, oacID, distributionARN)
+
+	if old := diff.Get(key).([]interface{}); (len(old) == 0 && len(bucketPolicies) == 0) || reflect.DeepEqual(old, bucketPolicies) {
+		return nil
+	}
+
+	return diff.SetNew(key, bucketPolicies)
+}
+
 func resourceDistributionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).CloudFrontClient(ctx)
@@ -940,10 +1011,21 @@ func resourceDistributionRead(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 	if aws.ToInt32(distributionConfig.Origins.Quantity) > 0 {
-		if err := d.Set("origin", flattenOrigins(distributionConfig.Origins)); err != nil {
+		tfList := flattenOrigins(distributionConfig.Origins)
+		if oacID := originAccessIdentityMigrationControlID(d.Get("origin_access_identity_migration").([]interface{})); oacID != "" {
+			tfList = unmigrateOriginAccessIdentities(tfList, d.Get("origin").(*schema.Set).List(), oacID)
+		}
+		if err := d.Set("origin", tfList); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting origin: %s", err)
 		}
 	}
+	var bucketPolicies []interface{}
+	if oacID := originAccessIdentityMigrationControlID(d.Get("origin_access_identity_migration").([]interface{})); oacID != "" {
+		bucketPolicies = originAccessControlBucketPolicies(distributionConfig.Origins, oacID, aws.ToString(output.Distribution.ARN))
+	}
+	if err := d.Set("origin_access_control_bucket_policy", bucketPolicies); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting origin_access_control_bucket_policy: %s", err)
+	}
 	if aws.ToInt32(distributionConfig.OriginGroups.Quantity) > 0 {
 		if err := d.Set("origin_group", flattenOriginGroups(distributionConfig.OriginGroups)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "setting origin_group: %s", err)
@@ -1307,6 +1389,10 @@ func expandDistributionConfig(d *schema.ResourceData) *awstypes.DistributionConf
 		apiObject.OriginGroups = expandOriginGroups(v.(*schema.Set).List())
 	}
 
+	if oacID := originAccessIdentityMigrationControlID(d.Get("origin_access_identity_migration").([]interface{})); oacID != "" {
+		migrateOriginAccessIdentities(apiObject.Origins, oacID)
+	}
+
 	if v, ok := d.GetOk("restrictions"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		apiObject.Restrictions = expandRestrictions(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -2097,6 +2183,128 @@ func flattenOrigin(apiObject *awstypes.Origin) map[string]interface{} {
 	return tfMap
 }
 
+// migrateOriginAccessIdentities replaces the origin access identity of each S3 origin
+// that doesn't already use an origin access control with the specified origin access control.
+func migrateOriginAccessIdentities(apiObject *awstypes.Origins, oacID string) {
+	for i, v := range apiObject.Items {
+		if v.S3OriginConfig == nil || aws.ToString(v.S3OriginConfig.OriginAccessIdentity) == "" || aws.ToString(v.OriginAccessControlId) != "" {
+			continue
+		}
+
+		apiObject.Items[i].OriginAccessControlId = aws.String(oacID)
+		apiObject.Items[i].S3OriginConfig.OriginAccessIdentity = aws.String("")
+	}
+}
+
+// originAccessIdentityMigrationControlID returns the origin access control ID of the
+// origin_access_identity_migration configuration block, if any.
+func originAccessIdentityMigrationControlID(tfList []interface{}) string {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return ""
+	}
+
+	return tfList[0].(map[string]interface{})["origin_access_control_id"].(string)
+}
+
+// unmigrateOriginAccessIdentities restores the configured origin access identity of each
+// migrated origin so that origins still configured with an origin access identity show no diff.
+// Only origins that are mid-migration are restored: the origin is configured with an origin access identity
+// and no origin access control, and uses the specified origin access control and no origin access identity.
+// Any other difference between the configured and actual origins is reported as drift.
+func unmigrateOriginAccessIdentities(tfList, configured []interface{}, oacID string) []interface{} {
+	identities := make(map[string]interface{})
+	for _, tfMapRaw := range configured {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok || tfMap["origin_access_control_id"].(string) != "" {
+			continue
+		}
+
+		if v, ok := tfMap["s3_origin_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil && v[0].(map[string]interface{})["origin_access_identity"].(string) != "" {
+			identities[tfMap["origin_id"].(string)] = v
+		}
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap := tfMapRaw.(map[string]interface{})
+
+		if _, ok := tfMap["s3_origin_config"]; ok {
+			continue
+		}
+
+		if v, ok := identities[tfMap["origin_id"].(string)]; ok && tfMap["origin_access_control_id"] == oacID {
+			delete(tfMap, "origin_access_control_id")
+			tfMap["s3_origin_config"] = v
+		}
+	}
+
+	return tfList
+}
+
+var s3OriginDomainNameRegexp = regexache.MustCompile(`^(.+)\.s3[.-]`)
+
+// originAccessControlBucketPolicies returns, for each S3 bucket origin that uses the specified
+// origin access control, a bucket policy that allows the distribution to read the bucket's objects.
+func originAccessControlBucketPolicies(apiObject *awstypes.Origins, oacID, distributionARN string) []interface{} {
+	partition := names.StandardPartitionID
+	if v, err := arn.Parse(distributionARN); err == nil {
+		partition = v.Partition
+	}
+
+	var buckets []string
+
+	for _, v := range apiObject.Items {
+		if aws.ToString(v.OriginAccessControlId) != oacID {
+			continue
+		}
+
+		match := s3OriginDomainNameRegexp.FindStringSubmatch(aws.ToString(v.DomainName))
+		if match == nil {
+			continue
+		}
+
+		buckets = append(buckets, match[1])
+	}
+
+	// Origins are unordered, and several origins may use the same bucket.
+	slices.Sort(buckets)
+	buckets = slices.Compact(buckets)
+
+	var tfList []interface{}
+
+	for _, bucket := range buckets {
+		policy, err := json.Marshal(map[string]interface{}{
+			"Version": "2012-10-17",
+			"Statement": []interface{}{
+				map[string]interface{}{
+					"Sid":    "AllowCloudFrontServicePrincipalReadOnly",
+					"Effect": "Allow",
+					"Principal": map[string]interface{}{
+						"Service": "cloudfront.amazonaws.com",
+					},
+					"Action":   "s3:GetObject",
+					"Resource": fmt.Sprintf("arn:%s:s3:::%s/*", partition, bucket),
+					"Condition": map[string]interface{}{
+						"StringEquals": map[string]interface{}{
+							"AWS:SourceArn": distributionARN,
+						},
+					},
+				},
+			},
+		})
+
+		if err != nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrBucket: bucket,
+			names.AttrPolicy: string(policy),
+		})
+	}
+
+	return tfList
+}
+
 func expandOriginGroups(tfList []interface{}) *awstypes.OriginGroups {
 	var items []awstypes.OriginGroup
 
@@ -14,7 +14,10 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfcloudfront "github.com/hashicorp/terraform-provider-aws/internal/service/cloudfront"
@@ -650,6 +653,78 @@ func TestAccCloudFrontDistribution_Origin_originAccessControl(t *testing.T) {
 	})
 }
 
+func TestAccCloudFrontDistribution_Origin_originAccessIdentityMigration(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var distribution awstypes.Distribution
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cloudfront_distribution.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDistributionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDistributionConfig_originAccessIdentityMigration(rName, `
+    s3_origin_config {
+      origin_access_identity = aws_cloudfront_origin_access_identity.test.cloudfront_access_identity_path
+    }
+`, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					resource.TestCheckResourceAttr(resourceName, "origin_access_identity_migration.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccDistributionConfig_originAccessIdentityMigration(rName, `
+    s3_origin_config {
+      origin_access_identity = aws_cloudfront_origin_access_identity.test.cloudfront_access_identity_path
+    }
+`, `
+  origin_access_identity_migration {
+    origin_access_control_id = aws_cloudfront_origin_access_control.test.id
+  }
+`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("origin_access_control_bucket_policy").AtSliceIndex(0).AtMapKey(names.AttrBucket), knownvalue.StringExact(rName+".origin-bucket")),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					testAccCheckDistributionOriginAccessControlID(&distribution, "myS3Origin", "aws_cloudfront_origin_access_control.test"),
+					resource.TestCheckResourceAttr(resourceName, "origin_access_identity_migration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "origin_access_control_bucket_policy.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "origin_access_control_bucket_policy.0.bucket", "aws_s3_bucket.s3_bucket_origin", names.AttrBucket),
+					resource.TestCheckResourceAttrSet(resourceName, "origin_access_control_bucket_policy.0.policy"),
+				),
+			},
+			{
+				Config: testAccDistributionConfig_originAccessIdentityMigration(rName, `
+    origin_access_control_id = aws_cloudfront_origin_access_control.test.id
+`, ""),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDistributionExists(ctx, resourceName, &distribution),
+					resource.TestCheckResourceAttrPair(resourceName, "origin.0.origin_access_control_id", "aws_cloudfront_origin_access_control.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "origin_access_identity_migration.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "origin_access_control_bucket_policy.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
 // TestAccCloudFrontDistribution_noOptionalItems runs an
 // aws_cloudfront_distribution acceptance test with no optional items set.
 //
@@ -4394,6 +4469,63 @@ resource "aws_cloudfront_distribution" "main" {
 `, rName, testAccDistributionRetainConfig()))
 }
 
+func testAccDistributionConfig_originAccessIdentityMigration(rName, originAccess, migration string) string {
+	return acctest.ConfigCompose(
+		originBucket(rName),
+		fmt.Sprintf(`
+resource "aws_cloudfront_origin_access_identity" "test" {
+  comment = %[1]q
+}
+
+resource "aws_cloudfront_origin_access_control" "test" {
+  name                              = %[1]q
+  origin_access_control_origin_type = "s3"
+  signing_behavior                  = "always"
+  signing_protocol                  = "sigv4"
+}
+
+resource "aws_cloudfront_distribution" "test" {
+  depends_on = [aws_s3_bucket_acl.s3_bucket_origin_acl]
+
+  origin {
+    domain_name = aws_s3_bucket.s3_bucket_origin.bucket_regional_domain_name
+    origin_id   = "myS3Origin"
+%[3]s
+  }
+
+  enabled = true
+
+  default_cache_behavior {
+    allowed_methods  = ["GET", "HEAD"]
+    cached_methods   = ["GET", "HEAD"]
+    target_origin_id = "myS3Origin"
+
+    forwarded_values {
+      query_string = false
+
+      cookies {
+        forward = "none"
+      }
+    }
+
+    viewer_protocol_policy = "allow-all"
+  }
+
+  restrictions {
+    geo_restriction {
+      restriction_type = "none"
+    }
+  }
+
+  viewer_certificate {
+    cloudfront_default_certificate = true
+  }
+%[4]s
+  %[2]s
+}
+`, rName, testAccDistributionRetainConfig(), originAccess, migration))
+}
+
 func testAccDistributionConfig_originAccessControl(rName string, which int) string {
 	return acctest.ConfigCompose(
 		originBucket(rName),
@@ -4470,3 +4602,30 @@ resource "aws_cloudfront_distribution" "test" {
 }
 `, rName, testAccDistributionRetainConfig(), which))
 }
+
+func testAccCheckDistributionOriginAccessControlID(v *awstypes.Distribution, originID, oacResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[oacResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", oacResourceName)
+		}
+
+		for _, origin := range v.DistributionConfig.Origins.Items {
+			if aws.ToString(origin.Id) != originID {
+				continue
+			}
+
+			if got, want := aws.ToString(origin.OriginAccessControlId), rs.Primary.ID; got != want {
+				return fmt.Errorf("CloudFront Distribution origin (%s) origin access control ID: got %s, want %s", originID, got, want)
+			}
+
+			if got := aws.ToString(origin.S3OriginConfig.OriginAccessIdentity); got != "" {
+				return fmt.Errorf("CloudFront Distribution origin (%s) origin access identity: got %s, want empty", originID, got)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("CloudFront Distribution origin (%s) not found", originID)
+	}
+}
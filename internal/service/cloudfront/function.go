@@ -5,14 +5,17 @@ package cloudfront
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -83,7 +86,27 @@ func resourceFunction() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"test_event": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"event_object": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.All(validation.StringLenBetween(1, 40960), validation.StringIsJSON),
+						},
+					},
+				},
+			},
 		},
+
+		CustomizeDiff: customdiff.Sequence(
+			// A previous publish may have been skipped, e.g. because a test event failed.
+			customdiff.ComputedIf("live_stage_etag", func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
+				return d.Id() != "" && d.Get("publish").(bool) && d.Get("live_stage_etag").(string) != d.Get("etag").(string)
+			}),
+		),
 	}
 }
 
@@ -113,6 +136,12 @@ func resourceFunctionCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(aws.ToString(output.FunctionSummary.Name))
 
+	if v, ok := d.GetOk("test_event"); ok {
+		if err := testFunction(ctx, conn, d.Id(), aws.ToString(output.ETag), v.([]interface{})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "testing CloudFront Function (%s): %s", d.Id(), err)
+		}
+	}
+
 	if d.Get("publish").(bool) {
 		input := &cloudfront.PublishFunctionInput{
 			Name:    aws.String(d.Id()),
@@ -197,6 +226,11 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 
 		if v, ok := d.GetOk("key_value_store_associations"); ok {
 			input.FunctionConfig.KeyValueStoreAssociations = expandKeyValueStoreAssociations(v.(*schema.Set).List())
+		} else {
+			// Explicitly remove any existing associations.
+			input.FunctionConfig.KeyValueStoreAssociations = &awstypes.KeyValueStoreAssociations{
+				Quantity: aws.Int32(0),
+			}
 		}
 
 		output, err := conn.UpdateFunction(ctx, input)
@@ -208,6 +242,12 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		etag = aws.ToString(output.ETag)
 	}
 
+	if v, ok := d.GetOk("test_event"); ok {
+		if err := testFunction(ctx, conn, d.Id(), etag, v.([]interface{})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "testing CloudFront Function (%s): %s", d.Id(), err)
+		}
+	}
+
 	if d.Get("publish").(bool) {
 		input := &cloudfront.PublishFunctionInput{
 			IfMatch: aws.String(etag),
@@ -271,6 +311,38 @@ func findFunctionByTwoPartKey(ctx context.Context, conn *cloudfront.Client, name
 	return output, nil
 }
 
+// testFunction runs each of the sample events against the function's DEVELOPMENT stage
+// and returns an error if any invocation fails.
+func testFunction(ctx context.Context, conn *cloudfront.Client, name, etag string, tfList []interface{}) error {
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		input := &cloudfront.TestFunctionInput{
+			EventObject: []byte(tfMap["event_object"].(string)),
+			IfMatch:     aws.String(etag),
+			Name:        aws.String(name),
+			Stage:       awstypes.FunctionStageDevelopment,
+		}
+
+		output, err := conn.TestFunction(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("test_event.%d: %w", i, err)
+		}
+
+		if output.TestResult != nil {
+			if v := aws.ToString(output.TestResult.FunctionErrorMessage); v != "" {
+				return fmt.Errorf("test_event.%d: %s", i, v)
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandKeyValueStoreAssociations(tfList []interface{}) *awstypes.KeyValueStoreAssociations {
 	if len(tfList) == 0 {
 		return nil
@@ -8,10 +8,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -330,6 +332,46 @@ func TestAccCloudFrontFunction_KeyValueStoreAssociations(t *testing.T) {
 					resource.TestCheckResourceAttrPair(resourceName, "key_value_store_associations.0", "aws_cloudfront_key_value_store.test2", names.AttrARN),
 				),
 			},
+			{
+				Config: testAccFunctionConfig_KeyValueStoreAssociationRemove(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "key_value_store_associations.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudFrontFunction_testEvent(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf cloudfront.DescribeFunctionOutput
+	resourceName := "aws_cloudfront_function.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CloudFrontEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudFrontServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_testEvent(rName, "return event.request;"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "test_event.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "live_stage_etag", resourceName, "etag"),
+				),
+			},
+			{
+				Config:      testAccFunctionConfig_testEvent(rName, "throw new Error('broken');"),
+				ExpectError: regexache.MustCompile(`testing CloudFront Function.*broken`),
+			},
 		},
 	})
 }
@@ -697,3 +739,64 @@ EOT
 }
 `, rName))
 }
+
+func testAccFunctionConfig_KeyValueStoreAssociationRemove(rName string) string {
+	return acctest.ConfigCompose(
+		testAccKeyValueStoreConfig_basic(rName),
+		fmt.Sprintf(`
+resource "aws_cloudfront_key_value_store" "test2" {
+  name = "%[1]s2"
+}
+resource "aws_cloudfront_function" "test" {
+  name    = %[1]q
+  runtime = "cloudfront-js-2.0"
+  code    = <<-EOT
+// updated code
+function handler(event) {
+	var response = {
+		statusCode: 302,
+		statusDescription: 'Found',
+		headers: {
+			'cloudfront-functions': { value: 'generated-by-CloudFront-Functions' },
+			'location': { value: 'https://aws.amazon.com/cloudfront/' }
+		}
+	};
+	return response;
+}
+EOT
+}
+`, rName))
+}
+
+func testAccFunctionConfig_testEvent(rName, body string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudfront_function" "test" {
+  name    = %[1]q
+  runtime = "cloudfront-js-2.0"
+  code    = <<-EOT
+function handler(event) {
+	%[2]s
+}
+EOT
+
+  test_event {
+    event_object = jsonencode({
+      version = "1.0"
+      context = {
+        eventType = "viewer-request"
+      }
+      viewer = {
+        ip = "198.51.100.11"
+      }
+      request = {
+        method      = "GET"
+        uri         = "/index.html"
+        headers     = {}
+        cookies     = {}
+        querystring = {}
+      }
+    })
+  }
+}
+`, rName, body)
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudfront/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -32,6 +33,7 @@ import (
 )
 
 // @FrameworkResource(name="Key Value Store")
+// @ARNIdentity
 func newKeyValueStoreResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &keyValueStoreResource{}
 
@@ -257,6 +259,23 @@ func (r *keyValueStoreResource) Delete(ctx context.Context, request resource.Del
 	}
 }
 
+// ImportIDFromARN returns the key value store's name, as its ARN contains its ID.
+func (r *keyValueStoreResource) ImportIDFromARN(ctx context.Context, arn arn.ARN) (string, error) {
+	if _, err := framework.ARNResourceParts(arn, "cloudfront", "key-value-store/*"); err != nil {
+		return "", err
+	}
+
+	conn := r.Meta().CloudFrontClient(ctx)
+
+	output, err := findKeyValueStoreByARN(ctx, conn, arn.String())
+
+	if err != nil {
+		return "", fmt.Errorf("reading CloudFront Key Value Store (%s): %w", arn, err)
+	}
+
+	return aws.ToString(output.Name), nil
+}
+
 func findKeyValueStoreByName(ctx context.Context, conn *cloudfront.Client, name string) (*cloudfront.DescribeKeyValueStoreOutput, error) {
 	input := &cloudfront.DescribeKeyValueStoreInput{
 		Name: aws.String(name),
@@ -282,6 +301,33 @@ func findKeyValueStoreByName(ctx context.Context, conn *cloudfront.Client, name
 	return output, nil
 }
 
+func findKeyValueStoreByARN(ctx context.Context, conn *cloudfront.Client, arn string) (*awstypes.KeyValueStore, error) {
+	input := &cloudfront.ListKeyValueStoresInput{}
+
+	pages := cloudfront.NewListKeyValueStoresPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page.KeyValueStoreList == nil {
+			continue
+		}
+
+		for _, v := range page.KeyValueStoreList.Items {
+			if aws.ToString(v.ARN) == arn {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
 func statusKeyValueStore(ctx context.Context, conn *cloudfront.Client, name string) retry.StateRefreshFunc {
 	return func() (interface{}, string, error) {
 		output, err := findKeyValueStoreByName(ctx, conn, name)
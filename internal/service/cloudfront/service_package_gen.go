@@ -28,6 +28,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 		{
 			Factory: newKeyValueStoreResource,
 			Name:    "Key Value Store",
+			Identity: &types.ServicePackageResourceIdentity{
+				ARNAttribute: names.AttrARN,
+			},
 		},
 	}
 }
@@ -0,0 +1,448 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact"
+	"github.com/aws/aws-sdk-go-v2/service/codeartifact/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_codeartifact_package_group", name="Package Group")
+// @Tags(identifierAttribute="arn")
+func resourcePackageGroup() *schema.Resource {
+	originRestrictionSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Computed: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"repositories": {
+						Type:     schema.TypeSet,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+					"restriction_mode": {
+						Type:             schema.TypeString,
+						Required:         true,
+						ValidateDiagFunc: enum.Validate[types.PackageGroupOriginRestrictionMode](),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourcePackageGroupCreate,
+		ReadWithoutTimeout:   resourcePackageGroupRead,
+		UpdateWithoutTimeout: resourcePackageGroupUpdate,
+		DeleteWithoutTimeout: resourcePackageGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"contact_info": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrDomain: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"domain_owner": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			"origin_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"restrictions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"external_upstream": originRestrictionSchema(),
+									"internal_upstream": originRestrictionSchema(),
+									"publish":           originRestrictionSchema(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"pattern": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+const (
+	packageGroupResourceIDPartCount = 3
+)
+
+func resourcePackageGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	input := &codeartifact.CreatePackageGroupInput{
+		Domain:       aws.String(d.Get(names.AttrDomain).(string)),
+		PackageGroup: aws.String(d.Get("pattern").(string)),
+		Tags:         getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("contact_info"); ok {
+		input.ContactInfo = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("domain_owner"); ok {
+		input.DomainOwner = aws.String(v.(string))
+	}
+
+	output, err := conn.CreatePackageGroup(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating CodeArtifact Package Group (%s): %s", d.Get("pattern").(string), err)
+	}
+
+	packageGroup := output.PackageGroup
+	id, err := flex.FlattenResourceId([]string{aws.ToString(packageGroup.DomainOwner), aws.ToString(packageGroup.DomainName), aws.ToString(packageGroup.Pattern)}, packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if v, ok := d.GetOk("origin_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input := expandUpdatePackageGroupOriginConfigurationInput(nil, v.([]interface{}))
+		input.Domain = packageGroup.DomainName
+		input.DomainOwner = packageGroup.DomainOwner
+		input.PackageGroup = packageGroup.Pattern
+
+		_, err := conn.UpdatePackageGroupOriginConfiguration(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeArtifact Package Group (%s) origin configuration: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePackageGroupRead(ctx, d, meta)...)
+}
+
+func resourcePackageGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	owner, domainName, pattern := parts[0], parts[1], parts[2]
+	packageGroup, err := findPackageGroupByThreePartKey(ctx, conn, owner, domainName, pattern)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CodeArtifact Package Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading CodeArtifact Package Group (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, packageGroup.Arn)
+	d.Set("contact_info", packageGroup.ContactInfo)
+	d.Set(names.AttrDescription, packageGroup.Description)
+	d.Set(names.AttrDomain, packageGroup.DomainName)
+	d.Set("domain_owner", packageGroup.DomainOwner)
+	d.Set("pattern", packageGroup.Pattern)
+
+	allowedRepositories := make(map[types.PackageGroupOriginRestrictionType][]string)
+	if v := packageGroup.OriginConfiguration; v != nil {
+		for restrictionType, restriction := range v.Restrictions {
+			if restriction.Mode != types.PackageGroupOriginRestrictionModeAllowSpecificRepositories {
+				continue
+			}
+
+			repositories, err := findAllowedRepositoriesForPackageGroup(ctx, conn, owner, domainName, pattern, types.PackageGroupOriginRestrictionType(restrictionType))
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading CodeArtifact Package Group (%s) %s allowed repositories: %s", d.Id(), restrictionType, err)
+			}
+
+			allowedRepositories[types.PackageGroupOriginRestrictionType(restrictionType)] = repositories
+		}
+	}
+
+	if err := d.Set("origin_configuration", flattenPackageGroupOriginConfiguration(packageGroup.OriginConfiguration, allowedRepositories)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting origin_configuration: %s", err)
+	}
+
+	return diags
+}
+
+func resourcePackageGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	owner, domainName, pattern := parts[0], parts[1], parts[2]
+
+	if d.HasChanges("contact_info", names.AttrDescription) {
+		input := &codeartifact.UpdatePackageGroupInput{
+			ContactInfo:  aws.String(d.Get("contact_info").(string)),
+			Description:  aws.String(d.Get(names.AttrDescription).(string)),
+			Domain:       aws.String(domainName),
+			DomainOwner:  aws.String(owner),
+			PackageGroup: aws.String(pattern),
+		}
+
+		_, err := conn.UpdatePackageGroup(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeArtifact Package Group (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("origin_configuration") {
+		o, n := d.GetChange("origin_configuration")
+		input := expandUpdatePackageGroupOriginConfigurationInput(o.([]interface{}), n.([]interface{}))
+		input.Domain = aws.String(domainName)
+		input.DomainOwner = aws.String(owner)
+		input.PackageGroup = aws.String(pattern)
+
+		_, err := conn.UpdatePackageGroupOriginConfiguration(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating CodeArtifact Package Group (%s) origin configuration: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourcePackageGroupRead(ctx, d, meta)...)
+}
+
+func resourcePackageGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).CodeArtifactClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), packageGroupResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting CodeArtifact Package Group: %s", d.Id())
+	_, err = conn.DeletePackageGroup(ctx, &codeartifact.DeletePackageGroupInput{
+		Domain:       aws.String(parts[1]),
+		DomainOwner:  aws.String(parts[0]),
+		PackageGroup: aws.String(parts[2]),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting CodeArtifact Package Group (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findPackageGroupByThreePartKey(ctx context.Context, conn *codeartifact.Client, owner, domainName, pattern string) (*types.PackageGroupDescription, error) {
+	input := &codeartifact.DescribePackageGroupInput{
+		Domain:       aws.String(domainName),
+		DomainOwner:  aws.String(owner),
+		PackageGroup: aws.String(pattern),
+	}
+
+	output, err := conn.DescribePackageGroup(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.PackageGroup == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.PackageGroup, nil
+}
+
+func findAllowedRepositoriesForPackageGroup(ctx context.Context, conn *codeartifact.Client, owner, domainName, pattern string, restrictionType types.PackageGroupOriginRestrictionType) ([]string, error) {
+	input := &codeartifact.ListAllowedRepositoriesForGroupInput{
+		Domain:                aws.String(domainName),
+		DomainOwner:           aws.String(owner),
+		OriginRestrictionType: restrictionType,
+		PackageGroup:          aws.String(pattern),
+	}
+	var output []string
+
+	pages := codeartifact.NewListAllowedRepositoriesForGroupPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AllowedRepositories...)
+	}
+
+	return output, nil
+}
+
+var packageGroupOriginRestrictionTypes = map[string]types.PackageGroupOriginRestrictionType{
+	"external_upstream": types.PackageGroupOriginRestrictionTypeExternalUpstream,
+	"internal_upstream": types.PackageGroupOriginRestrictionTypeInternalUpstream,
+	"publish":           types.PackageGroupOriginRestrictionTypePublish,
+}
+
+func expandPackageGroupOriginRestrictions(tfList []interface{}) map[string]interface{} {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	if v, ok := tfMap["restrictions"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		return v[0].(map[string]interface{})
+	}
+
+	return nil
+}
+
+// expandUpdatePackageGroupOriginConfigurationInput returns the restriction modes to set
+// and the allowed repositories to add and remove to move from the old to the new configuration.
+func expandUpdatePackageGroupOriginConfigurationInput(o, n []interface{}) *codeartifact.UpdatePackageGroupOriginConfigurationInput {
+	apiObject := &codeartifact.UpdatePackageGroupOriginConfigurationInput{
+		Restrictions: make(map[string]types.PackageGroupOriginRestrictionMode),
+	}
+	oldRestrictions, newRestrictions := expandPackageGroupOriginRestrictions(o), expandPackageGroupOriginRestrictions(n)
+
+	for key, restrictionType := range packageGroupOriginRestrictionTypes {
+		var oldMode, newMode string
+		oldRepositories, newRepositories := schema.NewSet(schema.HashString, nil), schema.NewSet(schema.HashString, nil)
+
+		if v, ok := oldRestrictions[key].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			oldMode = tfMap["restriction_mode"].(string)
+			oldRepositories = tfMap["repositories"].(*schema.Set)
+		}
+
+		if v, ok := newRestrictions[key].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]interface{})
+			newMode = tfMap["restriction_mode"].(string)
+			newRepositories = tfMap["repositories"].(*schema.Set)
+		}
+
+		if newMode != "" && newMode != oldMode {
+			apiObject.Restrictions[string(restrictionType)] = types.PackageGroupOriginRestrictionMode(newMode)
+		}
+
+		for _, v := range flex.ExpandStringValueSet(newRepositories.Difference(oldRepositories)) {
+			apiObject.AddAllowedRepositories = append(apiObject.AddAllowedRepositories, types.PackageGroupAllowedRepository{
+				OriginRestrictionType: restrictionType,
+				RepositoryName:        aws.String(v),
+			})
+		}
+
+		for _, v := range flex.ExpandStringValueSet(oldRepositories.Difference(newRepositories)) {
+			apiObject.RemoveAllowedRepositories = append(apiObject.RemoveAllowedRepositories, types.PackageGroupAllowedRepository{
+				OriginRestrictionType: restrictionType,
+				RepositoryName:        aws.String(v),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func flattenPackageGroupOriginConfiguration(apiObject *types.PackageGroupOriginConfiguration, allowedRepositories map[types.PackageGroupOriginRestrictionType][]string) []interface{} {
+	if apiObject == nil || len(apiObject.Restrictions) == 0 {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	for key, restrictionType := range packageGroupOriginRestrictionTypes {
+		restriction, ok := apiObject.Restrictions[string(restrictionType)]
+		if !ok {
+			continue
+		}
+
+		tfMap[key] = []interface{}{map[string]interface{}{
+			"repositories":     allowedRepositories[restrictionType],
+			"restriction_mode": restriction.Mode,
+		}}
+	}
+
+	return []interface{}{map[string]interface{}{
+		"restrictions": []interface{}{tfMap},
+	}}
+}
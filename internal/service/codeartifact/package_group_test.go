@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codeartifact_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfcodeartifact "github.com/hashicorp/terraform-provider-aws/internal/service/codeartifact"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccPackageGroup_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "contact_info", ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDomain, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "domain_owner", "aws_codeartifact_domain.test", names.AttrOwner),
+					resource.TestCheckResourceAttr(resourceName, "pattern", "/npm/example/*"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccPackageGroup_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageGroupConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfcodeartifact.ResourcePackageGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccPackageGroup_description(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageGroupConfig_description(rName, "desc1", "team1@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "contact_info", "team1@example.com"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "desc1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackageGroupConfig_description(rName, "desc2", "team2@example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "contact_info", "team2@example.com"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "desc2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccPackageGroup_originConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codeartifact_package_group.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.CodeArtifactEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeArtifactServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPackageGroupDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPackageGroupConfig_originConfiguration(rName, "BLOCK"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.0.restrictions.0.external_upstream.0.restriction_mode", "BLOCK"),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.0.restrictions.0.internal_upstream.0.restriction_mode", "ALLOW_SPECIFIC_REPOSITORIES"),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.0.restrictions.0.internal_upstream.0.repositories.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "origin_configuration.0.restrictions.0.internal_upstream.0.repositories.*", "aws_codeartifact_repository.test", "repository"),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.0.restrictions.0.publish.0.restriction_mode", "BLOCK"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPackageGroupConfig_originConfiguration(rName, "ALLOW"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPackageGroupExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.0.restrictions.0.external_upstream.0.restriction_mode", "ALLOW"),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.0.restrictions.0.internal_upstream.0.restriction_mode", "ALLOW_SPECIFIC_REPOSITORIES"),
+					resource.TestCheckResourceAttr(resourceName, "origin_configuration.0.restrictions.0.publish.0.restriction_mode", "ALLOW"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPackageGroupExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CodeArtifactClient(ctx)
+
+		_, err := tfcodeartifact.FindPackageGroupByThreePartKey(ctx, conn, rs.Primary.Attributes["domain_owner"], rs.Primary.Attributes[names.AttrDomain], rs.Primary.Attributes["pattern"])
+
+		return err
+	}
+}
+
+func testAccCheckPackageGroupDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_codeartifact_package_group" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).CodeArtifactClient(ctx)
+
+			_, err := tfcodeartifact.FindPackageGroupByThreePartKey(ctx, conn, rs.Primary.Attributes["domain_owner"], rs.Primary.Attributes[names.AttrDomain], rs.Primary.Attributes["pattern"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("CodeArtifact Package Group %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccPackageGroupConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccRepositoryConfig_base(rName), `
+resource "aws_codeartifact_package_group" "test" {
+  domain  = aws_codeartifact_domain.test.domain
+  pattern = "/npm/example/*"
+}
+`)
+}
+
+func testAccPackageGroupConfig_description(rName, description, contactInfo string) string {
+	return acctest.ConfigCompose(testAccRepositoryConfig_base(rName), fmt.Sprintf(`
+resource "aws_codeartifact_package_group" "test" {
+  domain       = aws_codeartifact_domain.test.domain
+  pattern      = "/npm/example/*"
+  description  = %[1]q
+  contact_info = %[2]q
+}
+`, description, contactInfo))
+}
+
+func testAccPackageGroupConfig_originConfiguration(rName, restrictionMode string) string {
+	return acctest.ConfigCompose(testAccRepositoryConfig_base(rName), fmt.Sprintf(`
+resource "aws_codeartifact_repository" "test" {
+  repository = %[1]q
+  domain     = aws_codeartifact_domain.test.domain
+}
+
+resource "aws_codeartifact_package_group" "test" {
+  domain  = aws_codeartifact_domain.test.domain
+  pattern = "/npm/example/*"
+
+  origin_configuration {
+    restrictions {
+      external_upstream {
+        restriction_mode = %[2]q
+      }
+
+      internal_upstream {
+        restriction_mode = "ALLOW_SPECIFIC_REPOSITORIES"
+        repositories     = [aws_codeartifact_repository.test.repository]
+      }
+
+      publish {
+        restriction_mode = %[2]q
+      }
+    }
+  }
+}
+`, rName, restrictionMode))
+}
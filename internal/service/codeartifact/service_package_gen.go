@@ -53,6 +53,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_codeartifact_domain_permissions_policy",
 			Name:     "Domain Permissions Policy",
 		},
+		{
+			Factory:  resourcePackageGroup,
+			TypeName: "aws_codeartifact_package_group",
+			Name:     "Package Group",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  resourceRepository,
 			TypeName: "aws_codeartifact_repository",
@@ -77,16 +77,19 @@ func ResourceDevEnvironment() *schema.Resource {
 			"project_name": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 			"persistent_storage": {
 				Type:     schema.TypeList,
 				Required: true,
+				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						names.AttrSize: {
 							Type:     schema.TypeInt,
 							Required: true,
+							ForceNew: true,
 						},
 					},
 				},
@@ -94,16 +97,19 @@ func ResourceDevEnvironment() *schema.Resource {
 			"repositories": {
 				Type:     schema.TypeList,
 				Optional: true,
+				ForceNew: true,
 				MaxItems: 100,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"branch_name": {
 							Type:     schema.TypeString,
 							Optional: true,
+							ForceNew: true,
 						},
 						names.AttrRepositoryName: {
 							Type:     schema.TypeString,
 							Required: true,
+							ForceNew: true,
 						},
 					},
 				},
@@ -111,6 +117,7 @@ func ResourceDevEnvironment() *schema.Resource {
 			"space_name": {
 				Type:     schema.TypeString,
 				Required: true,
+				ForceNew: true,
 			},
 		},
 	}
@@ -214,7 +221,9 @@ func resourceDevEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, m
 	update := false
 
 	in := &codecatalyst.UpdateDevEnvironmentInput{
-		Id: aws.String(d.Id()),
+		Id:          aws.String(d.Id()),
+		ProjectName: aws.String(d.Get("project_name").(string)),
+		SpaceName:   aws.String(d.Get("space_name").(string)),
 	}
 
 	if d.HasChanges(names.AttrAlias) {
@@ -222,6 +231,16 @@ func resourceDevEnvironmentUpdate(ctx context.Context, d *schema.ResourceData, m
 		update = true
 	}
 
+	if d.HasChanges("ides") {
+		in.Ides = expandIdesConfiguration(d.Get("ides").([]interface{}))
+		update = true
+	}
+
+	if d.HasChanges("inactivity_timeout_minutes") {
+		in.InactivityTimeoutMinutes = int32(d.Get("inactivity_timeout_minutes").(int))
+		update = true
+	}
+
 	if d.HasChanges(names.AttrInstanceType) {
 		in.InstanceType = types.InstanceType(d.Get(names.AttrInstanceType).(string))
 		update = true
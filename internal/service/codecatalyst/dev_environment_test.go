@@ -90,6 +90,47 @@ func TestAccCodeCatalystDevEnvironment_withRepositories(t *testing.T) {
 		},
 	})
 }
+func TestAccCodeCatalystDevEnvironment_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var DevEnvironment codecatalyst.GetDevEnvironmentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_codecatalyst_dev_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.CodeCatalyst)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.CodeCatalyst),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDevEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDevEnvironmentConfig_update(rName, "dev.standard1.small", "VSCode", 15),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDevEnvironmentExists(ctx, resourceName, &DevEnvironment),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAlias, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "dev.standard1.small"),
+					resource.TestCheckResourceAttr(resourceName, "ides.0.name", "VSCode"),
+					resource.TestCheckResourceAttr(resourceName, "inactivity_timeout_minutes", "15"),
+				),
+			},
+			{
+				Config: testAccDevEnvironmentConfig_update(rNameUpdated, "dev.standard1.medium", "Cloud9", 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDevEnvironmentExists(ctx, resourceName, &DevEnvironment),
+					resource.TestCheckResourceAttr(resourceName, names.AttrAlias, rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "dev.standard1.medium"),
+					resource.TestCheckResourceAttr(resourceName, "ides.0.name", "Cloud9"),
+					resource.TestCheckResourceAttr(resourceName, "inactivity_timeout_minutes", "30"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccCodeCatalystDevEnvironment_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -261,3 +302,24 @@ resource "aws_codecatalyst_dev_environment" "test" {
 }
 `, rName)
 }
+
+func testAccDevEnvironmentConfig_update(rName, instanceType, ide string, inactivityTimeout int) string {
+	return fmt.Sprintf(`
+resource "aws_codecatalyst_dev_environment" "test" {
+  alias         = %[1]q
+  space_name    = "terraform"
+  project_name  = "terraform"
+  instance_type = %[2]q
+
+  persistent_storage {
+    size = 16
+  }
+
+  ides {
+    name = %[3]q
+  }
+
+  inactivity_timeout_minutes = %[4]d
+}
+`, rName, instanceType, ide, inactivityTimeout)
+}
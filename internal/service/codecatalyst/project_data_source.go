@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codecatalyst
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Function annotations are used for datasource registration to the Provider. DO NOT EDIT.
+// @SDKDataSource("aws_codecatalyst_project", name="Project")
+func DataSourceProject() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceProjectRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDisplayName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"space_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+const (
+	DSNameProject = "Project Data Source"
+)
+
+func dataSourceProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CodeCatalystClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	spaceName := aws.String(d.Get("space_name").(string))
+
+	out, err := findProjectByName(ctx, conn, name, spaceName)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CodeCatalyst, create.ErrActionReading, DSNameProject, name, err)
+	}
+
+	d.SetId(aws.ToString(out.Name))
+
+	d.Set(names.AttrDescription, out.Description)
+	d.Set(names.AttrDisplayName, out.DisplayName)
+	d.Set(names.AttrName, out.Name)
+	d.Set("space_name", out.SpaceName)
+
+	return diags
+}
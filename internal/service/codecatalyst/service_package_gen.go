@@ -30,6 +30,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_codecatalyst_dev_environment",
 			Name:     "Dev Environment",
 		},
+		{
+			Factory:  DataSourceProject,
+			TypeName: "aws_codecatalyst_project",
+			Name:     "Project",
+		},
+		{
+			Factory:  DataSourceSpace,
+			TypeName: "aws_codecatalyst_space",
+			Name:     "Space",
+		},
 	}
 }
 
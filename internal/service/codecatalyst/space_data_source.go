@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package codecatalyst
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/codecatalyst"
+	"github.com/aws/aws-sdk-go-v2/service/codecatalyst/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Function annotations are used for datasource registration to the Provider. DO NOT EDIT.
+// @SDKDataSource("aws_codecatalyst_space", name="Space")
+func DataSourceSpace() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSpaceRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDisplayName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"region_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	DSNameSpace = "Space Data Source"
+)
+
+func dataSourceSpaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).CodeCatalystClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+
+	out, err := findSpaceByName(ctx, conn, name)
+	if err != nil {
+		return create.AppendDiagError(diags, names.CodeCatalyst, create.ErrActionReading, DSNameSpace, name, err)
+	}
+
+	d.SetId(aws.ToString(out.Name))
+
+	d.Set(names.AttrDescription, out.Description)
+	d.Set(names.AttrDisplayName, out.DisplayName)
+	d.Set(names.AttrName, out.Name)
+	d.Set("region_name", out.RegionName)
+
+	return diags
+}
+
+func findSpaceByName(ctx context.Context, conn *codecatalyst.Client, name string) (*codecatalyst.GetSpaceOutput, error) {
+	in := &codecatalyst.GetSpaceInput{
+		Name: aws.String(name),
+	}
+
+	out, err := conn.GetSpace(ctx, in)
+	if errs.IsA[*types.AccessDeniedException](err) || errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Name == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Environment Action")
+func newResourceEnvironmentAction(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceEnvironmentAction{}
+	return r, nil
+}
+
+const (
+	ResNameEnvironmentAction = "Environment Action"
+)
+
+type resourceEnvironmentAction struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceEnvironmentAction) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_datazone_environment_action"
+}
+
+func (r *resourceEnvironmentAction) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"aws_console_link": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[awsConsoleLinkParametersModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrURI: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceEnvironmentAction) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan environmentActionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.CreateEnvironmentActionInput{
+		Description:           flex.StringFromFramework(ctx, plan.Description),
+		DomainIdentifier:      aws.String(plan.DomainIdentifier.ValueString()),
+		EnvironmentIdentifier: aws.String(plan.EnvironmentIdentifier.ValueString()),
+		Name:                  aws.String(plan.Name.ValueString()),
+	}
+
+	parameters, d := expandActionParameters(ctx, plan.AwsConsoleLink)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	in.Parameters = parameters
+
+	out, err := conn.CreateEnvironmentAction(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameEnvironmentAction, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameEnvironmentAction, plan.Name.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	plan.ID = flex.StringToFramework(ctx, out.Id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceEnvironmentAction) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state environmentActionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findEnvironmentActionByThreePartKey(ctx, conn, state.DomainIdentifier.ValueString(), state.EnvironmentIdentifier.ValueString(), state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameEnvironmentAction, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.Description = flex.StringToFramework(ctx, out.Description)
+	state.Name = flex.StringToFramework(ctx, out.Name)
+
+	awsConsoleLink, d := flattenActionParameters(ctx, out.Parameters)
+	resp.Diagnostics.Append(d...)
+	state.AwsConsoleLink = awsConsoleLink
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceEnvironmentAction) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan, state environmentActionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.AwsConsoleLink.Equal(state.AwsConsoleLink) ||
+		!plan.Description.Equal(state.Description) ||
+		!plan.Name.Equal(state.Name) {
+		in := &datazone.UpdateEnvironmentActionInput{
+			Description:           flex.StringFromFramework(ctx, plan.Description),
+			DomainIdentifier:      aws.String(plan.DomainIdentifier.ValueString()),
+			EnvironmentIdentifier: aws.String(plan.EnvironmentIdentifier.ValueString()),
+			Identifier:            aws.String(plan.ID.ValueString()),
+			Name:                  aws.String(plan.Name.ValueString()),
+		}
+
+		parameters, d := expandActionParameters(ctx, plan.AwsConsoleLink)
+		resp.Diagnostics.Append(d...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		in.Parameters = parameters
+
+		out, err := conn.UpdateEnvironmentAction(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameEnvironmentAction, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+		if out == nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameEnvironmentAction, plan.ID.String(), nil),
+				errors.New("empty output").Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceEnvironmentAction) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state environmentActionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.DeleteEnvironmentActionInput{
+		DomainIdentifier:      aws.String(state.DomainIdentifier.ValueString()),
+		EnvironmentIdentifier: aws.String(state.EnvironmentIdentifier.ValueString()),
+		Identifier:            aws.String(state.ID.ValueString()),
+	}
+
+	_, err := conn.DeleteEnvironmentAction(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameEnvironmentAction, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceEnvironmentAction) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Resource Import Invalid ID", fmt.Sprintf("Wrong format for import ID (%s), use: 'domain-id/environment-id/environment-action-id'", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_identifier"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), parts[2])...)
+}
+
+func findEnvironmentActionByThreePartKey(ctx context.Context, conn *datazone.Client, domainID, environmentID, id string) (*datazone.GetEnvironmentActionOutput, error) {
+	in := &datazone.GetEnvironmentActionInput{
+		DomainIdentifier:      aws.String(domainID),
+		EnvironmentIdentifier: aws.String(environmentID),
+		Identifier:            aws.String(id),
+	}
+
+	out, err := conn.GetEnvironmentAction(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+func expandActionParameters(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[awsConsoleLinkParametersModel]) (awstypes.ActionParameters, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfObj, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || tfObj == nil {
+		return nil, diags
+	}
+
+	return &awstypes.ActionParametersMemberAwsConsoleLink{
+		Value: awstypes.AwsConsoleLinkParameters{
+			Uri: flex.StringFromFramework(ctx, tfObj.URI),
+		},
+	}, diags
+}
+
+func flattenActionParameters(ctx context.Context, apiObject awstypes.ActionParameters) (fwtypes.ListNestedObjectValueOf[awsConsoleLinkParametersModel], diag.Diagnostics) {
+	switch v := apiObject.(type) {
+	case *awstypes.ActionParametersMemberAwsConsoleLink:
+		return fwtypes.NewListNestedObjectValueOfPtr(ctx, &awsConsoleLinkParametersModel{
+			URI: flex.StringToFramework(ctx, v.Value.Uri),
+		})
+	}
+
+	return fwtypes.NewListNestedObjectValueOfNull[awsConsoleLinkParametersModel](ctx), nil
+}
+
+type environmentActionResourceModel struct {
+	AwsConsoleLink        fwtypes.ListNestedObjectValueOf[awsConsoleLinkParametersModel] `tfsdk:"aws_console_link"`
+	Description           types.String                                                   `tfsdk:"description"`
+	DomainIdentifier      types.String                                                   `tfsdk:"domain_identifier"`
+	EnvironmentIdentifier types.String                                                   `tfsdk:"environment_identifier"`
+	ID                    types.String                                                   `tfsdk:"id"`
+	Name                  types.String                                                   `tfsdk:"name"`
+}
+
+type awsConsoleLinkParametersModel struct {
+	URI types.String `tfsdk:"uri"`
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Environment actions and subscription targets belong to an existing DataZone environment,
+// which this provider cannot yet create, so the tests require one to be supplied.
+const (
+	envVarDataZoneDomainID      = "DATAZONE_DOMAIN_ID"
+	envVarDataZoneEnvironmentID = "DATAZONE_ENVIRONMENT_ID"
+)
+
+func TestAccDataZoneEnvironmentAction_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainID := acctest.SkipIfEnvVarNotSet(t, envVarDataZoneDomainID)
+	environmentID := acctest.SkipIfEnvVarNotSet(t, envVarDataZoneEnvironmentID)
+
+	var environmentaction datazone.GetEnvironmentActionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_environment_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentActionConfig_basic(rName, domainID, environmentID, "https://console.aws.amazon.com/s3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentActionExists(ctx, resourceName, &environmentaction),
+					resource.TestCheckResourceAttr(resourceName, "aws_console_link.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "aws_console_link.0.uri", "https://console.aws.amazon.com/s3"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, rName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccEnvironmentActionImportStateIdFunc(resourceName),
+			},
+			{
+				Config: testAccEnvironmentActionConfig_basic(rName, domainID, environmentID, "https://console.aws.amazon.com/athena"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentActionExists(ctx, resourceName, &environmentaction),
+					resource.TestCheckResourceAttr(resourceName, "aws_console_link.0.uri", "https://console.aws.amazon.com/athena"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataZoneEnvironmentAction_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainID := acctest.SkipIfEnvVarNotSet(t, envVarDataZoneDomainID)
+	environmentID := acctest.SkipIfEnvVarNotSet(t, envVarDataZoneEnvironmentID)
+
+	var environmentaction datazone.GetEnvironmentActionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_environment_action.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentActionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentActionConfig_basic(rName, domainID, environmentID, "https://console.aws.amazon.com/s3"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentActionExists(ctx, resourceName, &environmentaction),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatazone.ResourceEnvironmentAction, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentActionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_environment_action" {
+				continue
+			}
+
+			_, err := tfdatazone.FindEnvironmentActionByThreePartKey(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["environment_identifier"], rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataZone Environment Action %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnvironmentActionExists(ctx context.Context, n string, v *datazone.GetEnvironmentActionOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		output, err := tfdatazone.FindEnvironmentActionByThreePartKey(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["environment_identifier"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEnvironmentActionImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["environment_identifier"], rs.Primary.ID), nil
+	}
+}
+
+func testAccEnvironmentActionConfig_basic(rName, domainID, environmentID, uri string) string {
+	return fmt.Sprintf(`
+resource "aws_datazone_environment_action" "test" {
+  domain_identifier      = %[2]q
+  environment_identifier = %[3]q
+  name                   = %[1]q
+  description            = %[1]q
+
+  aws_console_link {
+    uri = %[4]q
+  }
+}
+`, rName, domainID, environmentID, uri)
+}
@@ -6,6 +6,11 @@ package datazone
 // Exports for use in tests only.
 var (
 	ResourceDomain                            = newResourceDomain
+	ResourceEnvironmentAction                 = newResourceEnvironmentAction
 	ResourceEnvironmentBlueprintConfiguration = newResourceEnvironmentBlueprintConfiguration
+	ResourceSubscriptionTarget                = newResourceSubscriptionTarget
 	IsResourceMissing                         = isResourceMissing
+
+	FindEnvironmentActionByThreePartKey  = findEnvironmentActionByThreePartKey
+	FindSubscriptionTargetByThreePartKey = findSubscriptionTargetByThreePartKey
 )
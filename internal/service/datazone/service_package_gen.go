@@ -32,11 +32,22 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				ARNAttribute: names.AttrARN,
+			},
+		},
+		{
+			Factory: newResourceEnvironmentAction,
+			Name:    "Environment Action",
 		},
 		{
 			Factory: newResourceEnvironmentBlueprintConfiguration,
 			Name:    "Environment Blueprint Configuration",
 		},
+		{
+			Factory: newResourceSubscriptionTarget,
+			Name:    "Subscription Target",
+		},
 	}
 }
 
@@ -0,0 +1,345 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Subscription Target")
+func newResourceSubscriptionTarget(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceSubscriptionTarget{}
+	return r, nil
+}
+
+const (
+	ResNameSubscriptionTarget = "Subscription Target"
+)
+
+type resourceSubscriptionTarget struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceSubscriptionTarget) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_datazone_subscription_target"
+}
+
+func (r *resourceSubscriptionTarget) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"applicable_asset_types": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"authorized_principals": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"manage_access_role": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"project_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			// "provider" is a reserved attribute name.
+			"provider_name": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrType: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"subscription_target_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[subscriptionTargetFormModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrContent: schema.StringAttribute{
+							Required: true,
+						},
+						"form_name": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceSubscriptionTarget) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan subscriptionTargetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.CreateSubscriptionTargetInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in.ClientToken = aws.String(sdkid.UniqueId())
+	in.Provider = flex.StringFromFramework(ctx, plan.ProviderName)
+
+	out, err := conn.CreateSubscriptionTarget(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameSubscriptionTarget, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	if out == nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameSubscriptionTarget, plan.Name.String(), nil),
+			errors.New("empty output").Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ProviderName = flex.StringToFramework(ctx, out.Provider)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceSubscriptionTarget) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state subscriptionTargetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findSubscriptionTargetByThreePartKey(ctx, conn, state.DomainIdentifier.ValueString(), state.EnvironmentIdentifier.ValueString(), state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameSubscriptionTarget, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ProviderName = flex.StringToFramework(ctx, out.Provider)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceSubscriptionTarget) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan, state subscriptionTargetResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ApplicableAssetTypes.Equal(state.ApplicableAssetTypes) ||
+		!plan.AuthorizedPrincipals.Equal(state.AuthorizedPrincipals) ||
+		!plan.ManageAccessRole.Equal(state.ManageAccessRole) ||
+		!plan.Name.Equal(state.Name) ||
+		!plan.ProviderName.Equal(state.ProviderName) ||
+		!plan.SubscriptionTargetConfig.Equal(state.SubscriptionTargetConfig) {
+		in := &datazone.UpdateSubscriptionTargetInput{}
+		resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		in.Identifier = aws.String(plan.ID.ValueString())
+		in.Provider = flex.StringFromFramework(ctx, plan.ProviderName)
+
+		out, err := conn.UpdateSubscriptionTarget(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameSubscriptionTarget, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+		if out == nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameSubscriptionTarget, plan.ID.String(), nil),
+				errors.New("empty output").Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceSubscriptionTarget) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state subscriptionTargetResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.DeleteSubscriptionTargetInput{
+		DomainIdentifier:      aws.String(state.DomainIdentifier.ValueString()),
+		EnvironmentIdentifier: aws.String(state.EnvironmentIdentifier.ValueString()),
+		Identifier:            aws.String(state.ID.ValueString()),
+	}
+
+	_, err := conn.DeleteSubscriptionTarget(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameSubscriptionTarget, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceSubscriptionTarget) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 {
+		resp.Diagnostics.AddError("Resource Import Invalid ID", fmt.Sprintf("Wrong format for import ID (%s), use: 'domain-id/environment-id/subscription-target-id'", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_identifier"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), parts[2])...)
+}
+
+func findSubscriptionTargetByThreePartKey(ctx context.Context, conn *datazone.Client, domainID, environmentID, id string) (*datazone.GetSubscriptionTargetOutput, error) {
+	in := &datazone.GetSubscriptionTargetInput{
+		DomainIdentifier:      aws.String(domainID),
+		EnvironmentIdentifier: aws.String(environmentID),
+		Identifier:            aws.String(id),
+	}
+
+	out, err := conn.GetSubscriptionTarget(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type subscriptionTargetResourceModel struct {
+	ApplicableAssetTypes     fwtypes.ListValueOf[types.String]                            `tfsdk:"applicable_asset_types"`
+	AuthorizedPrincipals     fwtypes.ListValueOf[types.String]                            `tfsdk:"authorized_principals"`
+	CreatedAt                timetypes.RFC3339                                            `tfsdk:"created_at"`
+	CreatedBy                types.String                                                 `tfsdk:"created_by"`
+	DomainIdentifier         types.String                                                 `tfsdk:"domain_identifier"`
+	EnvironmentIdentifier    types.String                                                 `tfsdk:"environment_identifier"`
+	ID                       types.String                                                 `tfsdk:"id"`
+	ManageAccessRole         fwtypes.ARN                                                  `tfsdk:"manage_access_role"`
+	Name                     types.String                                                 `tfsdk:"name"`
+	ProjectID                types.String                                                 `tfsdk:"project_id"`
+	ProviderName             types.String                                                 `tfsdk:"provider_name"`
+	SubscriptionTargetConfig fwtypes.ListNestedObjectValueOf[subscriptionTargetFormModel] `tfsdk:"subscription_target_config"`
+	Type                     types.String                                                 `tfsdk:"type"`
+}
+
+type subscriptionTargetFormModel struct {
+	Content  types.String `tfsdk:"content"`
+	FormName types.String `tfsdk:"form_name"`
+}
@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneSubscriptionTarget_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainID := acctest.SkipIfEnvVarNotSet(t, envVarDataZoneDomainID)
+	environmentID := acctest.SkipIfEnvVarNotSet(t, envVarDataZoneEnvironmentID)
+
+	var subscriptiontarget datazone.GetSubscriptionTargetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_subscription_target.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriptionTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriptionTargetConfig_basic(rName, domainID, environmentID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionTargetExists(ctx, resourceName, &subscriptiontarget),
+					resource.TestCheckResourceAttr(resourceName, "applicable_asset_types.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "applicable_asset_types.0", "GlueTableAssetType"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttrSet(resourceName, "created_by"),
+					resource.TestCheckResourceAttrPair(resourceName, "manage_access_role", "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrSet(resourceName, "project_id"),
+					resource.TestCheckResourceAttr(resourceName, "subscription_target_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "GlueSubscriptionTargetType"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccSubscriptionTargetImportStateIdFunc(resourceName),
+			},
+			{
+				Config: testAccSubscriptionTargetConfig_basic(rNameUpdated, domainID, environmentID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionTargetExists(ctx, resourceName, &subscriptiontarget),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rNameUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataZoneSubscriptionTarget_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	domainID := acctest.SkipIfEnvVarNotSet(t, envVarDataZoneDomainID)
+	environmentID := acctest.SkipIfEnvVarNotSet(t, envVarDataZoneEnvironmentID)
+
+	var subscriptiontarget datazone.GetSubscriptionTargetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_subscription_target.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriptionTargetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriptionTargetConfig_basic(rName, domainID, environmentID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSubscriptionTargetExists(ctx, resourceName, &subscriptiontarget),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatazone.ResourceSubscriptionTarget, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSubscriptionTargetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_subscription_target" {
+				continue
+			}
+
+			_, err := tfdatazone.FindSubscriptionTargetByThreePartKey(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["environment_identifier"], rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataZone Subscription Target %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSubscriptionTargetExists(ctx context.Context, n string, v *datazone.GetSubscriptionTargetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		output, err := tfdatazone.FindSubscriptionTargetByThreePartKey(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["environment_identifier"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccSubscriptionTargetImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["environment_identifier"], rs.Primary.ID), nil
+	}
+}
+
+func testAccSubscriptionTargetConfig_basic(rName, domainID, environmentID string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = ["sts:AssumeRole", "sts:TagSession"]
+      Effect = "Allow"
+      Principal = {
+        Service = "datazone.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_datazone_subscription_target" "test" {
+  domain_identifier      = %[2]q
+  environment_identifier = %[3]q
+  name                   = %[1]q
+  type                   = "GlueSubscriptionTargetType"
+  manage_access_role     = aws_iam_role.test.arn
+  applicable_asset_types = ["GlueTableAssetType"]
+  authorized_principals  = [data.aws_caller_identity.current.arn]
+
+  subscription_target_config {
+    form_name = "GlueSubscriptionTargetConfigForm"
+    content   = jsonencode({ databaseName = %[1]q })
+  }
+}
+`, rName, domainID, environmentID)
+}
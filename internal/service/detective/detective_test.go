@@ -18,6 +18,7 @@ func TestAccDetective_serial(t *testing.T) {
 			acctest.CtBasic:      testAccGraph_basic,
 			acctest.CtDisappears: testAccGraph_disappears,
 			"tags":               testAccGraph_tags,
+			"datasourcePackages": testAccGraph_datasourcePackages,
 		},
 		"InvitationAccepter": {
 			acctest.CtBasic: testAccInvitationAccepter_basic,
@@ -27,6 +28,10 @@ func TestAccDetective_serial(t *testing.T) {
 			"disappear":     testAccMember_disappears,
 			"message":       testAccMember_message,
 		},
+		"Members": {
+			acctest.CtBasic:      testAccMembers_basic,
+			acctest.CtDisappears: testAccMembers_disappears,
+		},
 		"OrganizationAdminAccount": {
 			acctest.CtBasic:      testAccOrganizationAdminAccount_basic,
 			acctest.CtDisappears: testAccOrganizationAdminAccount_disappears,
@@ -5,6 +5,7 @@ package detective
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -12,9 +13,13 @@ import (
 	"github.com/aws/aws-sdk-go/service/detective"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -40,6 +45,15 @@ func ResourceGraph() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"datasource_packages": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(optionalDatasourcePackages(), false),
+				},
+			},
 			"graph_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -48,7 +62,13 @@ func ResourceGraph() *schema.Resource {
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			// Optional datasource packages can be started but not stopped.
+			customdiff.ForceNewIfChange("datasource_packages", func(_ context.Context, old, new, meta interface{}) bool {
+				return old.(*schema.Set).Difference(new.(*schema.Set)).Len() > 0
+			}),
+		),
 	}
 }
 
@@ -74,6 +94,12 @@ func resourceGraphCreate(ctx context.Context, d *schema.ResourceData, meta inter
 
 	d.SetId(aws.StringValue(outputRaw.(*detective.CreateGraphOutput).GraphArn))
 
+	if v, ok := d.GetOk("datasource_packages"); ok && v.(*schema.Set).Len() > 0 {
+		if err := updateDatasourcePackages(ctx, conn, d.Id(), flex.ExpandStringSet(v.(*schema.Set))); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceGraphRead(ctx, d, meta)...)
 }
 
@@ -97,12 +123,44 @@ func resourceGraphRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set(names.AttrCreatedTime, aws.TimeValue(graph.CreatedTime).Format(time.RFC3339))
 	d.Set("graph_arn", graph.Arn)
 
+	packages, err := findDatasourcePackagesByGraphARN(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Detective Graph (%s) datasource packages: %s", d.Id(), err)
+	}
+
+	var datasourcePackages []string
+	for k, v := range packages {
+		// DETECTIVE_CORE is always enabled and cannot be managed.
+		if k == detective.DatasourcePackageDetectiveCore {
+			continue
+		}
+
+		if v != nil && aws.StringValue(v.DatasourcePackageIngestState) != detective.DatasourcePackageIngestStateDisabled {
+			datasourcePackages = append(datasourcePackages, k)
+		}
+	}
+	d.Set("datasource_packages", datasourcePackages)
+
 	return diags
 }
 
 func resourceGraphUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	// Tags only.
-	return resourceGraphRead(ctx, d, meta)
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DetectiveConn(ctx)
+
+	if d.HasChange("datasource_packages") {
+		o, n := d.GetChange("datasource_packages")
+		// Removals force a new resource, so only additions are sent.
+		if add := n.(*schema.Set).Difference(o.(*schema.Set)); add.Len() > 0 {
+			if err := updateDatasourcePackages(ctx, conn, d.Id(), flex.ExpandStringSet(add)); err != nil {
+				return sdkdiag.AppendFromErr(diags, err)
+			}
+		}
+	}
+
+	return append(diags, resourceGraphRead(ctx, d, meta)...)
 }
 
 func resourceGraphDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -126,6 +184,21 @@ func resourceGraphDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	return diags
 }
 
+func updateDatasourcePackages(ctx context.Context, conn *detective.Detective, graphARN string, packages []*string) error {
+	input := &detective.UpdateDatasourcePackagesInput{
+		DatasourcePackages: packages,
+		GraphArn:           aws.String(graphARN),
+	}
+
+	_, err := conn.UpdateDatasourcePackagesWithContext(ctx, input)
+
+	if err != nil {
+		return fmt.Errorf("updating Detective Graph (%s) datasource packages: %w", graphARN, err)
+	}
+
+	return nil
+}
+
 func FindGraphByARN(ctx context.Context, conn *detective.Detective, arn string) (*detective.Graph, error) {
 	input := &detective.ListGraphsInput{}
 
@@ -167,3 +240,42 @@ func findGraphs(ctx context.Context, conn *detective.Detective, input *detective
 
 	return output, nil
 }
+
+func findDatasourcePackagesByGraphARN(ctx context.Context, conn *detective.Detective, graphARN string) (map[string]*detective.DatasourcePackageIngestDetail, error) {
+	input := &detective.ListDatasourcePackagesInput{
+		GraphArn: aws.String(graphARN),
+	}
+	output := make(map[string]*detective.DatasourcePackageIngestDetail)
+
+	err := conn.ListDatasourcePackagesPagesWithContext(ctx, input, func(page *detective.ListDatasourcePackagesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for k, v := range page.DatasourcePackages {
+			output[k] = v
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// optionalDatasourcePackages returns the datasource packages that can be started on a behavior graph.
+func optionalDatasourcePackages() []string {
+	return tfslices.Filter(detective.DatasourcePackage_Values(), func(v string) bool {
+		return v != detective.DatasourcePackageDetectiveCore
+	})
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/service/detective"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -114,6 +115,48 @@ func testAccGraph_tags(t *testing.T) {
 	})
 }
 
+func testAccGraph_datasourcePackages(t *testing.T) {
+	ctx := acctest.Context(t)
+	var graph detective.Graph
+	resourceName := "aws_detective_graph.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGraphDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.DetectiveServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGraphConfig_datasourcePackages(`"EKS_AUDIT"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphExists(ctx, resourceName, &graph),
+					resource.TestCheckResourceAttr(resourceName, "datasource_packages.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "datasource_packages.*", detective.DatasourcePackageEksAudit),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGraphConfig_datasourcePackages(`"EKS_AUDIT", "ASFF_SECURITYHUB_FINDING"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGraphExists(ctx, resourceName, &graph),
+					resource.TestCheckResourceAttr(resourceName, "datasource_packages.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "datasource_packages.*", detective.DatasourcePackageEksAudit),
+					resource.TestCheckTypeSetElemAttr(resourceName, "datasource_packages.*", detective.DatasourcePackageAsffSecurityhubFinding),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGraphDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn(ctx)
@@ -187,3 +230,11 @@ resource "aws_detective_graph" "test" {
 }
 `, tagKey1, tagValue1, tagKey2, tagValue2)
 }
+
+func testAccGraphConfig_datasourcePackages(packages string) string {
+	return fmt.Sprintf(`
+resource "aws_detective_graph" "test" {
+  datasource_packages = [%[1]s]
+}
+`, packages)
+}
@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package detective
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/detective"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// CreateMembers and DeleteMembers accept at most 50 accounts per request.
+	membersBatchSize = 50
+)
+
+// @SDKResource("aws_detective_members", name="Members")
+func ResourceMembers() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMembersCreate,
+		ReadWithoutTimeout:   resourceMembersRead,
+		UpdateWithoutTimeout: resourceMembersUpdate,
+		DeleteWithoutTimeout: resourceMembersDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"disable_email_notification": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"graph_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"member": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAccountID: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidAccountID,
+						},
+						"email_address": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrMessage: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func resourceMembersCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DetectiveConn(ctx)
+
+	graphARN := d.Get("graph_arn").(string)
+	accounts := expandAccounts(d.Get("member").(*schema.Set).List())
+
+	if err := createMembers(ctx, conn, d, graphARN, accounts, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Detective Members (%s): %s", graphARN, err)
+	}
+
+	d.SetId(graphARN)
+
+	return append(diags, resourceMembersRead(ctx, d, meta)...)
+}
+
+func resourceMembersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DetectiveConn(ctx)
+
+	input := &detective.ListMembersInput{
+		GraphArn: aws.String(d.Id()),
+	}
+	// Only the members managed by this resource are tracked, unless importing.
+	managed := make(map[string]struct{})
+	for _, v := range d.Get("member").(*schema.Set).List() {
+		managed[v.(map[string]interface{})[names.AttrAccountID].(string)] = struct{}{}
+	}
+	members, err := findMembers(ctx, conn, input, func(v *detective.MemberDetail) bool {
+		if len(managed) == 0 {
+			return true
+		}
+		_, ok := managed[aws.StringValue(v.AccountId)]
+		return ok
+	})
+
+	if err == nil && len(members) == 0 {
+		err = tfresource.NewEmptyResultError(input)
+	}
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Detective Members (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Detective Members (%s): %s", d.Id(), err)
+	}
+
+	d.Set("graph_arn", d.Id())
+	if err := d.Set("member", flattenMemberDetails(members)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting member: %s", err)
+	}
+
+	return diags
+}
+
+func resourceMembersUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DetectiveConn(ctx)
+
+	if d.HasChange("member") {
+		o, n := d.GetChange("member")
+		oldAccounts, newAccounts := expandAccounts(o.(*schema.Set).List()), expandAccounts(n.(*schema.Set).List())
+		oldByID := make(map[string]*detective.Account, len(oldAccounts))
+		for _, v := range oldAccounts {
+			oldByID[aws.StringValue(v.AccountId)] = v
+		}
+		newByID := make(map[string]*detective.Account, len(newAccounts))
+		for _, v := range newAccounts {
+			newByID[aws.StringValue(v.AccountId)] = v
+		}
+
+		var del []string
+		var add []*detective.Account
+		for id, v := range oldByID {
+			if nv, ok := newByID[id]; !ok {
+				del = append(del, id)
+			} else if aws.StringValue(nv.EmailAddress) != aws.StringValue(v.EmailAddress) {
+				// The email address of an invited member can't be changed in place.
+				del = append(del, id)
+				add = append(add, nv)
+			}
+		}
+		for id, v := range newByID {
+			if _, ok := oldByID[id]; !ok {
+				add = append(add, v)
+			}
+		}
+
+		if len(del) > 0 {
+			if err := deleteMembers(ctx, conn, d.Id(), del); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Detective Members (%s): %s", d.Id(), err)
+			}
+		}
+
+		if len(add) > 0 {
+			if err := createMembers(ctx, conn, d, d.Id(), add, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Detective Members (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceMembersRead(ctx, d, meta)...)
+}
+
+func resourceMembersDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).DetectiveConn(ctx)
+
+	accountIDs := tfslices.ApplyToAll(expandAccounts(d.Get("member").(*schema.Set).List()), func(v *detective.Account) string {
+		return aws.StringValue(v.AccountId)
+	})
+
+	log.Printf("[DEBUG] Deleting Detective Members: %s", d.Id())
+	err := deleteMembers(ctx, conn, d.Id(), accountIDs)
+
+	if tfawserr.ErrCodeEquals(err, detective.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Detective Members (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func createMembers(ctx context.Context, conn *detective.Detective, d *schema.ResourceData, graphARN string, accounts []*detective.Account, timeout time.Duration) error {
+	for _, chunk := range tfslices.Chunks(accounts, membersBatchSize) {
+		input := &detective.CreateMembersInput{
+			Accounts: chunk,
+			GraphArn: aws.String(graphARN),
+		}
+
+		if v := d.Get("disable_email_notification").(bool); v {
+			input.DisableEmailNotification = aws.Bool(v)
+		}
+
+		if v, ok := d.GetOk(names.AttrMessage); ok {
+			input.Message = aws.String(v.(string))
+		}
+
+		outputRaw, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, timeout, func() (interface{}, error) {
+			return conn.CreateMembersWithContext(ctx, input)
+		}, detective.ErrCodeInternalServerException)
+
+		if err != nil {
+			return err
+		}
+
+		if err := unprocessedAccountsError(outputRaw.(*detective.CreateMembersOutput).UnprocessedAccounts); err != nil {
+			return err
+		}
+
+		for _, v := range chunk {
+			if _, err := waitMemberInvited(ctx, conn, graphARN, aws.StringValue(v.AccountId)); err != nil {
+				return fmt.Errorf("waiting for Detective Member (%s) invited: %w", memberCreateResourceID(graphARN, aws.StringValue(v.AccountId)), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func deleteMembers(ctx context.Context, conn *detective.Detective, graphARN string, accountIDs []string) error {
+	for _, chunk := range tfslices.Chunks(accountIDs, membersBatchSize) {
+		input := &detective.DeleteMembersInput{
+			AccountIds: aws.StringSlice(chunk),
+			GraphArn:   aws.String(graphARN),
+		}
+
+		output, err := conn.DeleteMembersWithContext(ctx, input)
+
+		if err != nil {
+			return err
+		}
+
+		if err := unprocessedAccountsError(output.UnprocessedAccounts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func unprocessedAccountsError(apiObjects []*detective.UnprocessedAccount) error {
+	var errs []error
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %s", aws.StringValue(apiObject.AccountId), aws.StringValue(apiObject.Reason)))
+	}
+
+	return errors.Join(errs...)
+}
+
+func expandAccounts(tfList []interface{}) []*detective.Account {
+	var apiObjects []*detective.Account
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &detective.Account{
+			AccountId:    aws.String(tfMap[names.AttrAccountID].(string)),
+			EmailAddress: aws.String(tfMap["email_address"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenMemberDetails(apiObjects []*detective.MemberDetail) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrAccountID: aws.StringValue(apiObject.AccountId),
+			"email_address":     aws.StringValue(apiObject.EmailAddress),
+		})
+	}
+
+	return tfList
+}
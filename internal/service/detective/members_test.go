@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package detective_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdetective "github.com/hashicorp/terraform-provider-aws/internal/service/detective"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccMembers_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_detective_members.test"
+	dataSourceAlternate := "data.aws_caller_identity.member"
+	email := testAccMemberFromEnv(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckMembersDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.DetectiveServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembersConfig_basic(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembersExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "graph_arn", "aws_detective_graph.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "member.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "member.*.account_id", dataSourceAlternate, names.AttrAccountID),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "member.*", map[string]string{
+						"email_address": email,
+					}),
+				),
+			},
+			{
+				Config:                  testAccMembersConfig_basic(email),
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"disable_email_notification"},
+			},
+		},
+	})
+}
+
+func testAccMembers_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_detective_members.test"
+	email := testAccMemberFromEnv(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckMembersDestroy(ctx),
+		ErrorCheck:               acctest.ErrorCheck(t, names.DetectiveServiceID),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMembersConfig_basic(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMembersExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfdetective.ResourceMembers(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMembersExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn(ctx)
+
+		for _, accountID := range testAccMembersAccountIDs(rs) {
+			if _, err := tfdetective.FindMemberByGraphByTwoPartKey(ctx, conn, rs.Primary.ID, accountID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMembersDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DetectiveConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_detective_members" {
+				continue
+			}
+
+			for _, accountID := range testAccMembersAccountIDs(rs) {
+				_, err := tfdetective.FindMemberByGraphByTwoPartKey(ctx, conn, rs.Primary.ID, accountID)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("Detective Member %s/%s still exists", rs.Primary.ID, accountID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccMembersAccountIDs(rs *terraform.ResourceState) []string {
+	var accountIDs []string
+
+	for k, v := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, "member.") && strings.HasSuffix(k, ".account_id") {
+			accountIDs = append(accountIDs, v)
+		}
+	}
+
+	return accountIDs
+}
+
+func testAccMembersConfig_basic(email string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "member" {
+  provider = "awsalternate"
+}
+
+resource "aws_detective_graph" "test" {}
+
+resource "aws_detective_members" "test" {
+  graph_arn = aws_detective_graph.test.id
+
+  member {
+    account_id    = data.aws_caller_identity.member.account_id
+    email_address = %[1]q
+  }
+}
+`, email))
+}
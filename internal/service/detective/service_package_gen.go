@@ -47,6 +47,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceMember,
 			TypeName: "aws_detective_member",
 		},
+		{
+			Factory:  ResourceMembers,
+			TypeName: "aws_detective_members",
+			Name:     "Members",
+		},
 		{
 			Factory:  ResourceOrganizationAdminAccount,
 			TypeName: "aws_detective_organization_admin_account",
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directconnect
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/directconnect"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_dx_connection_loa")
+func DataSourceConnectionLoa() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceConnectionLoaRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrConnectionID: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"loa_content": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"loa_content_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrProviderName: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceConnectionLoaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DirectConnectConn(ctx)
+
+	connectionID := d.Get(names.AttrConnectionID).(string)
+	input := &directconnect.DescribeLoaInput{
+		ConnectionId:   aws.String(connectionID),
+		LoaContentType: aws.String(directconnect.LoaContentTypeApplicationPdf),
+	}
+
+	if v, ok := d.GetOk(names.AttrProviderName); ok {
+		input.ProviderName = aws.String(v.(string))
+	}
+
+	loa, err := findLoa(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Direct Connect Connection (%s) LOA-CFA: %s", connectionID, err)
+	}
+
+	d.SetId(connectionID)
+	d.Set("loa_content", base64.StdEncoding.EncodeToString(loa.LoaContent))
+	d.Set("loa_content_type", loa.LoaContentType)
+
+	return diags
+}
+
+func findLoa(ctx context.Context, conn *directconnect.DirectConnect, input *directconnect.DescribeLoaInput) (*directconnect.Loa, error) {
+	output, err := conn.DescribeLoaWithContext(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.LoaContent) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
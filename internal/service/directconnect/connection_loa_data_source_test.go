@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package directconnect_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDirectConnectConnectionLoaDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	// Requires an existing DX connection for which an LOA-CFA has been issued set as environmental variable
+	key := "DX_CONNECTION_ID"
+	connectionID := os.Getenv(key)
+	if connectionID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+	dataSourceName := "data.aws_dx_connection_loa.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DirectConnectServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConnectionLoaDataSourceConfig_basic(connectionID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrConnectionID, connectionID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "loa_content"),
+					resource.TestCheckResourceAttr(dataSourceName, "loa_content_type", "application/pdf"),
+				),
+			},
+		},
+	})
+}
+
+func testAccConnectionLoaDataSourceConfig_basic(connectionID string) string {
+	return fmt.Sprintf(`
+data "aws_dx_connection_loa" "test" {
+  connection_id = %[1]q
+}
+`, connectionID)
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,9 +19,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	macSecKeyStateAssociating    = "associating"
+	macSecKeyStateAssociated     = "associated"
+	macSecKeyStateDisassociating = "disassociating"
+	macSecKeyStateDisassociated  = "disassociated"
+)
+
 // @SDKResource("aws_dx_macsec_key_association")
 func ResourceMacSecKeyAssociation() *schema.Resource {
 	return &schema.Resource{
@@ -32,6 +41,11 @@ func ResourceMacSecKeyAssociation() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"cak": {
 				Type:     schema.TypeString,
@@ -104,6 +118,10 @@ func resourceMacSecKeyCreate(ctx context.Context, d *schema.ResourceData, meta i
 
 	d.Set("secret_arn", secret_arn)
 
+	if _, err := waitMacSecKeyAssociated(ctx, conn, aws.StringValue(output.ConnectionId), secret_arn, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MACSec secret key (%s) on Direct Connect Connection (%s) associate: %s", secret_arn, aws.StringValue(output.ConnectionId), err)
+	}
+
 	return append(diags, resourceMacSecKeyRead(ctx, d, meta)...)
 }
 
@@ -116,25 +134,24 @@ func resourceMacSecKeyRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "unexpected format of ID (%s), expected secretArn_connectionId", d.Id())
 	}
 
-	connection, err := FindConnectionByID(ctx, conn, connId)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading Direct Connect Connection (%s): %s", d.Id(), err)
-	}
+	key, err := FindMacSecKeyByTwoPartKey(ctx, conn, connId, secretArn)
 
-	if connection.MacSecKeys == nil {
-		return sdkdiag.AppendErrorf(diags, "no MACSec keys found on Direct Connect Connection (%s)", d.Id())
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MACSec secret key (%s) on Direct Connect Connection (%s) not found, removing from state", secretArn, connId)
+		d.SetId("")
+		return diags
 	}
 
-	for _, key := range connection.MacSecKeys {
-		if aws.StringValue(key.SecretARN) == aws.StringValue(&secretArn) {
-			d.Set("ckn", key.Ckn)
-			d.Set(names.AttrConnectionID, connId)
-			d.Set("secret_arn", key.SecretARN)
-			d.Set("start_on", key.StartOn)
-			d.Set(names.AttrState, key.State)
-		}
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MACSec secret key (%s) on Direct Connect Connection (%s): %s", secretArn, connId, err)
 	}
 
+	d.Set("ckn", key.Ckn)
+	d.Set(names.AttrConnectionID, connId)
+	d.Set("secret_arn", key.SecretARN)
+	d.Set("start_on", key.StartOn)
+	d.Set(names.AttrState, key.State)
+
 	return diags
 }
 
@@ -154,6 +171,10 @@ func resourceMacSecKeyDelete(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "Unable to disassociate MACSec secret key on Direct Connect Connection (%s): %s", *input.ConnectionId, err)
 	}
 
+	if _, err := waitMacSecKeyDisassociated(ctx, conn, aws.StringValue(input.ConnectionId), aws.StringValue(input.SecretARN), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for MACSec secret key (%s) on Direct Connect Connection (%s) disassociate: %s", aws.StringValue(input.SecretARN), *input.ConnectionId, err)
+	}
+
 	return diags
 }
 
@@ -31,6 +31,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceConnection,
 			TypeName: "aws_dx_connection",
 		},
+		{
+			Factory:  DataSourceConnectionLoa,
+			TypeName: "aws_dx_connection_loa",
+		},
 		{
 			Factory:  DataSourceGateway,
 			TypeName: "aws_dx_gateway",
@@ -184,3 +184,37 @@ func waitLagDeleted(ctx context.Context, conn *directconnect.DirectConnect, id s
 
 	return nil, err
 }
+
+func waitMacSecKeyAssociated(ctx context.Context, conn *directconnect.DirectConnect, connectionID, secretARN string, timeout time.Duration) (*directconnect.MacSecKey, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{macSecKeyStateAssociating},
+		Target:  []string{macSecKeyStateAssociated},
+		Refresh: statusMacSecKeyState(ctx, conn, connectionID, secretARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directconnect.MacSecKey); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitMacSecKeyDisassociated(ctx context.Context, conn *directconnect.DirectConnect, connectionID, secretARN string, timeout time.Duration) (*directconnect.MacSecKey, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{macSecKeyStateAssociating, macSecKeyStateAssociated, macSecKeyStateDisassociating},
+		Target:  []string{},
+		Refresh: statusMacSecKeyState(ctx, conn, connectionID, secretARN),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*directconnect.MacSecKey); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -36,6 +36,7 @@ import (
 
 // @FrameworkResource(name="Cluster")
 // @Tags(identifierAttribute="arn")
+// @ARNIdentity
 func newResourceCluster(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceCluster{}
 	r.SetDefaultCreateTimeout(45 * time.Minute)
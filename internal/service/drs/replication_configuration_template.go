@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/drs"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/drs/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -31,6 +32,7 @@ import (
 
 // @FrameworkResource(name="Replication Configuration Template")
 // @Tags(identifierAttribute="arn")
+// @ARNIdentity
 func newReplicationConfigurationTemplateResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &replicationConfigurationTemplateResource{}
 
@@ -159,13 +161,15 @@ func (r *replicationConfigurationTemplateResource) Create(ctx context.Context, r
 
 	input.Tags = getTagsIn(ctx)
 
-	_, err := conn.CreateReplicationConfigurationTemplate(ctx, input)
+	outputC, err := conn.CreateReplicationConfigurationTemplate(ctx, input)
 	if err != nil {
 		response.Diagnostics.AddError("creating DRS Replication Configuration Template", err.Error())
 
 		return
 	}
 
+	data.ID = fwflex.StringToFramework(ctx, outputC.ReplicationConfigurationTemplateID)
+
 	output, err := waitReplicationConfigurationTemplateAvailable(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
 
 	if err != nil {
@@ -405,6 +409,16 @@ func waitReplicationConfigurationTemplateDeleted(ctx context.Context, conn *drs.
 	return nil, err
 }
 
+func (r *replicationConfigurationTemplateResource) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "drs", "replication-configuration-template/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return parts[0], nil
+}
+
 type replicationConfigurationTemplateResourceModel struct {
 	ARN                                 types.String                                                                     `tfsdk:"arn"`
 	AssociateDefaultSecurityGroup       types.Bool                                                                       `tfsdk:"associate_default_security_group"`
@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package drs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/drs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/drs/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Source Network")
+// @Tags(identifierAttribute="arn")
+// @ARNIdentity
+func newSourceNetworkResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &sourceNetworkResource{}
+
+	return r, nil
+}
+
+type sourceNetworkResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[sourceNetworkResourceModel]
+}
+
+func (r *sourceNetworkResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_drs_source_network"
+}
+
+func (r *sourceNetworkResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cfn_stack_name": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"launched_vpc_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"origin_account_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"origin_region": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"replication_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ReplicationStatus](),
+				Computed:   true,
+			},
+			"replication_status_details": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVPCID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *sourceNetworkResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data sourceNetworkResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DRSClient(ctx)
+
+	input := &drs.CreateSourceNetworkInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateSourceNetwork(ctx, input)
+	if err != nil {
+		response.Diagnostics.AddError("creating DRS Source Network", err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.SourceNetworkID)
+
+	sourceNetwork, err := findSourceNetworkByID(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DRS Source Network (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, sourceNetwork)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *sourceNetworkResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data sourceNetworkResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DRSClient(ctx)
+
+	output, err := findSourceNetworkByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DRS Source Network (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *sourceNetworkResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data sourceNetworkResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DRSClient(ctx)
+
+	tflog.Debug(ctx, "deleting DRS Source Network", map[string]interface{}{
+		names.AttrID: data.ID.ValueString(),
+	})
+
+	_, err := conn.DeleteSourceNetwork(ctx, &drs.DeleteSourceNetworkInput{
+		SourceNetworkID: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DRS Source Network (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *sourceNetworkResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findSourceNetwork(ctx context.Context, conn *drs.Client, input *drs.DescribeSourceNetworksInput) (*awstypes.SourceNetwork, error) {
+	output, err := findSourceNetworks(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findSourceNetworks(ctx context.Context, conn *drs.Client, input *drs.DescribeSourceNetworksInput) ([]awstypes.SourceNetwork, error) {
+	var output []awstypes.SourceNetwork
+
+	pages := drs.NewDescribeSourceNetworksPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+func findSourceNetworkByID(ctx context.Context, conn *drs.Client, id string) (*awstypes.SourceNetwork, error) {
+	input := &drs.DescribeSourceNetworksInput{
+		Filters: &awstypes.DescribeSourceNetworksRequestFilters{
+			SourceNetworkIDs: []string{id},
+		},
+	}
+
+	return findSourceNetwork(ctx, conn, input)
+}
+
+func (r *sourceNetworkResource) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "drs", "source-network/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return parts[0], nil
+}
+
+type sourceNetworkResourceModel struct {
+	ARN                      types.String                                   `tfsdk:"arn"`
+	CfnStackName             types.String                                   `tfsdk:"cfn_stack_name"`
+	ID                       types.String                                   `tfsdk:"id"`
+	LaunchedVpcID            types.String                                   `tfsdk:"launched_vpc_id"`
+	OriginAccountID          types.String                                   `tfsdk:"origin_account_id"`
+	OriginRegion             types.String                                   `tfsdk:"origin_region"`
+	ReplicationStatus        fwtypes.StringEnum[awstypes.ReplicationStatus] `tfsdk:"replication_status"`
+	ReplicationStatusDetails types.String                                   `tfsdk:"replication_status_details"`
+	Tags                     types.Map                                      `tfsdk:"tags"`
+	TagsAll                  types.Map                                      `tfsdk:"tags_all"`
+	VpcID                    types.String                                   `tfsdk:"vpc_id"`
+}
+
+func (m *sourceNetworkResourceModel) flatten(ctx context.Context, v *awstypes.SourceNetwork) diag.Diagnostics {
+	diags := fwflex.Flatten(ctx, v, m)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The API reports the creation arguments under different names.
+	m.OriginAccountID = fwflex.StringToFramework(ctx, v.SourceAccountID)
+	m.OriginRegion = fwflex.StringToFramework(ctx, v.SourceRegion)
+	m.VpcID = fwflex.StringToFramework(ctx, v.SourceVpcID)
+
+	return diags
+}
@@ -297,6 +297,22 @@ func (securityGroupRuleType) Values() []securityGroupRuleType {
 	}
 }
 
+type launchTemplateDefaultVersionMode string
+
+const (
+	launchTemplateDefaultVersionModeLatest launchTemplateDefaultVersionMode = "latest"
+	launchTemplateDefaultVersionModeManual launchTemplateDefaultVersionMode = "manual"
+	launchTemplateDefaultVersionModePinned launchTemplateDefaultVersionMode = "pinned"
+)
+
+func (launchTemplateDefaultVersionMode) Values() []launchTemplateDefaultVersionMode {
+	return []launchTemplateDefaultVersionMode{
+		launchTemplateDefaultVersionModeLatest,
+		launchTemplateDefaultVersionModeManual,
+		launchTemplateDefaultVersionModePinned,
+	}
+}
+
 const (
 	ResInstance      = "Instance"
 	ResInstanceState = "Instance State"
@@ -88,7 +88,6 @@ func resourceEBSVolume() *schema.Resource {
 			"multi_attach_enabled": {
 				Type:     schema.TypeBool,
 				Optional: true,
-				ForceNew: true,
 			},
 			"outpost_arn": {
 				Type:         schema.TypeString,
@@ -240,6 +239,10 @@ func resourceEBSVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			input.Iops = aws.Int32(int32(d.Get(names.AttrIOPS).(int)))
 		}
 
+		if d.HasChange("multi_attach_enabled") {
+			input.MultiAttachEnabled = aws.Bool(d.Get("multi_attach_enabled").(bool))
+		}
+
 		if d.HasChange(names.AttrSize) {
 			input.Size = aws.Int32(int32(d.Get(names.AttrSize).(int)))
 		}
@@ -378,6 +381,13 @@ func resourceEBSVolumeCustomizeDiff(_ context.Context, diff *schema.ResourceDiff
 	} else {
 		// Update.
 
+		// Multi-Attach can only be modified in place for io2 volumes.
+		if diff.HasChange("multi_attach_enabled") && volumeType != awstypes.VolumeTypeIo2 {
+			if err := diff.ForceNew("multi_attach_enabled"); err != nil {
+				return err
+			}
+		}
+
 		// Setting 'iops = 0' is a no-op if the volume type does not require Iops to be specified.
 		if diff.HasChange(names.AttrIOPS) && volumeType != awstypes.VolumeTypeIo1 && volumeType != awstypes.VolumeTypeIo2 && volumeType != awstypes.VolumeTypeGp3 && iops == 0 {
 			return diff.Clear(names.AttrIOPS)
@@ -14,6 +14,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -474,6 +475,41 @@ func TestAccEC2EBSVolume_multiAttach_io2(t *testing.T) {
 	})
 }
 
+func TestAccEC2EBSVolume_multiAttach_io2Update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v1, v2 awstypes.Volume
+	resourceName := "aws_ebs_volume.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVolumeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEBSVolumeConfig_multiAttachEnabled(rName, "io2", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVolumeExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "multi_attach_enabled", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccEBSVolumeConfig_multiAttachEnabled(rName, "io2", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVolumeExists(ctx, resourceName, &v2),
+					resource.TestCheckResourceAttr(resourceName, "multi_attach_enabled", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2EBSVolume_multiAttach_gp2(t *testing.T) {
 	ctx := acctest.Context(t)
 	resource.ParallelTest(t, resource.TestCase{
@@ -1322,6 +1358,22 @@ resource "aws_ebs_volume" "test" {
 `, rName, volumeType))
 }
 
+func testAccEBSVolumeConfig_multiAttachEnabled(rName, volumeType string, enabled bool) string {
+	return acctest.ConfigCompose(acctest.ConfigAvailableAZsNoOptIn(), fmt.Sprintf(`
+resource "aws_ebs_volume" "test" {
+  availability_zone    = data.aws_availability_zones.available.names[0]
+  type                 = %[2]q
+  multi_attach_enabled = %[3]t
+  size                 = 4
+  iops                 = 100
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName, volumeType, enabled))
+}
+
 func testAccEBSVolumeConfig_sizeTypeIOPSThroughput(rName, size, volumeType, iops, throughput string) string {
 	if volumeType == "" {
 		volumeType = "null"
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2BYOIPCIDRAdvertisement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "EC2_BYOIP_PROVISIONED_CIDR"
+	cidrBlock := os.Getenv(key)
+	if cidrBlock == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+	resourceName := "aws_ec2_byoip_cidr_advertisement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBYOIPCIDRAdvertisementDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBYOIPCIDRAdvertisementConfig_basic(cidrBlock),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBYOIPCIDRAdvertisementExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "cidr", cidrBlock),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.ByoipCidrStateAdvertised)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckBYOIPCIDRAdvertisementExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindBYOIPCIDRByCIDR(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if output.State != awstypes.ByoipCidrStateAdvertised {
+			return fmt.Errorf("EC2 BYOIP CIDR (%s) is not advertised: %s", rs.Primary.ID, output.State)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBYOIPCIDRAdvertisementDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_byoip_cidr_advertisement" {
+				continue
+			}
+
+			output, err := tfec2.FindBYOIPCIDRByCIDR(ctx, conn, rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			if output.State == awstypes.ByoipCidrStateAdvertised {
+				return fmt.Errorf("EC2 BYOIP CIDR (%s) is still advertised", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccBYOIPCIDRAdvertisementConfig_basic(cidrBlock string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_byoip_cidr_advertisement" "test" {
+  cidr = %[1]q
+}
+`, cidrBlock)
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
@@ -35,6 +36,7 @@ import (
 
 // @FrameworkResource("aws_ec2_capacity_block_reservation",name="Capacity Block Reservation")
 // @Tags(identifierAttribute="id")
+// @ARNIdentity
 // @Testing(tagsTest=false)
 func newResourceCapacityBlockReservation(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceCapacityBlockReservation{}
@@ -263,6 +265,16 @@ func (r *resourceCapacityBlockReservation) ModifyPlan(ctx context.Context, reque
 	r.SetTagsAll(ctx, request, response)
 }
 
+func (r *resourceCapacityBlockReservation) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, names.EC2, "capacity-reservation/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return parts[0], nil
+}
+
 type resourceCapacityBlockReservationData struct {
 	ARN                     types.String                                                     `tfsdk:"arn"`
 	AvailabilityZone        types.String                                                     `tfsdk:"availability_zone"`
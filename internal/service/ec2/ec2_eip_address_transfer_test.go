@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2EIPAddressTransfer_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_eip_address_transfer.test"
+	eipResourceName := "aws_eip.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckEIPAddressTransferDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEIPAddressTransferConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEIPAddressTransferExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "address_transfer_status", string(awstypes.AddressTransferStatusPending)),
+					resource.TestCheckResourceAttrPair(resourceName, "allocation_id", eipResourceName, "allocation_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "public_ip", eipResourceName, "public_ip"),
+					resource.TestCheckResourceAttrSet(resourceName, "transfer_offer_expiration_timestamp"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2EIPAddressTransfer_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_eip_address_transfer.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckEIPAddressTransferDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEIPAddressTransferConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEIPAddressTransferExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfec2.ResourceEIPAddressTransfer, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEIPAddressTransferExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		_, err := tfec2.FindEIPAddressTransferByAllocationID(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckEIPAddressTransferDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_eip_address_transfer" {
+				continue
+			}
+
+			_, err := tfec2.FindEIPAddressTransferByAllocationID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 EIP Address Transfer %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccEIPAddressTransferConfig_basic(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+resource "aws_eip" "test" {
+  domain = "vpc"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+data "aws_caller_identity" "target" {
+  provider = "awsalternate"
+}
+
+resource "aws_eip_address_transfer" "test" {
+  allocation_id       = aws_eip.test.allocation_id
+  transfer_account_id = data.aws_caller_identity.target.account_id
+}
+`, rName))
+}
@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_ec2_fleet_instances", name="Fleet Instances")
+func dataSourceFleetInstances() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceFleetInstancesRead,
+
+		Schema: map[string]*schema.Schema{
+			"active_instances": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_health": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrInstanceID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrInstanceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"spot_instance_request_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrFilter: customFiltersSchema(),
+			"fleet_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrIDs: {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceFleetInstancesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	fleetID := d.Get("fleet_id").(string)
+	input := &ec2.DescribeFleetInstancesInput{
+		FleetId: aws.String(fleetID),
+	}
+
+	input.Filters = append(input.Filters, newCustomFilterListV2(
+		d.Get(names.AttrFilter).(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	output, err := findFleetInstances(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Fleet (%s) instances: %s", fleetID, err)
+	}
+
+	var instanceIDs []string
+
+	for _, v := range output {
+		instanceIDs = append(instanceIDs, aws.ToString(v.InstanceId))
+	}
+
+	d.SetId(fleetID)
+	if err := d.Set("active_instances", flattenActiveInstances(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting active_instances: %s", err)
+	}
+	d.Set(names.AttrIDs, instanceIDs)
+
+	return diags
+}
+
+func flattenActiveInstances(apiObjects []awstypes.ActiveInstance) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"instance_health":          string(apiObject.InstanceHealth),
+			names.AttrInstanceID:       aws.ToString(apiObject.InstanceId),
+			names.AttrInstanceType:     aws.ToString(apiObject.InstanceType),
+			"spot_instance_request_id": aws.ToString(apiObject.SpotInstanceRequestId),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEC2FleetInstancesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ec2_fleet_instances.test"
+	resourceName := "aws_ec2_fleet.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckFleet(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFleetInstancesDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "fleet_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "active_instances.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "ids.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func testAccFleetInstancesDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFleetConfig_basic(rName), `
+data "aws_ec2_fleet_instances" "test" {
+  fleet_id = aws_ec2_fleet.test.id
+}
+`)
+}
@@ -37,6 +37,7 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/timestamp"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -54,7 +55,11 @@ func resourceInstance() *schema.Resource {
 		DeleteWithoutTimeout: resourceInstanceDelete,
 
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("allow_stop_for_update", true)
+
+				return []*schema.ResourceData{d}, nil
+			},
 		},
 
 		SchemaVersion: 1,
@@ -68,6 +73,11 @@ func resourceInstance() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"allow_stop_for_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
 			"ami": {
 				Type:         schema.TypeString,
 				ForceNew:     true,
@@ -312,6 +322,11 @@ func resourceInstance() *schema.Resource {
 				Computed: true,
 				ForceNew: true,
 			},
+			"ena_support": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
 			"enclave_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -781,6 +796,20 @@ func resourceInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"sriov_net_support": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{SriovNetSupportSimple}, false),
+			},
+			"stop_for_update_window": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidOnceAWeekWindowFormat,
+				StateFunc: func(v interface{}) string {
+					return strings.ToLower(v.(string))
+				},
+			},
 			names.AttrSubnetID: {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -899,6 +928,34 @@ func resourceInstance() *schema.Resource {
 			customdiff.ComputedIf("launch_template.0.name", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("launch_template.0.id")
 			}),
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				if diff.Id() == "" || diff.Get("instance_state").(string) == string(awstypes.InstanceStateNameStopped) {
+					return nil
+				}
+
+				for _, k := range instanceStopForUpdateAttributes {
+					if (k == "user_data" || k == "user_data_base64") && diff.Get("user_data_replace_on_change").(bool) {
+						continue
+					}
+
+					if !diff.HasChange(k) {
+						continue
+					}
+
+					if !diff.Get("allow_stop_for_update").(bool) {
+						return fmt.Errorf("updating %s requires the instance to be stopped; set allow_stop_for_update to true", k)
+					}
+
+					// Check the window at plan time. Update checks it again in case the apply runs later.
+					if err := instanceStopForUpdateAllowed(true, diff.Get("stop_for_update_window").(string), time.Now()); err != nil {
+						return fmt.Errorf("updating %s: %w", k, err)
+					}
+
+					return nil
+				}
+
+				return nil
+			},
 			customdiff.ForceNewIf("user_data", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.Get("user_data_replace_on_change").(bool)
 			}),
@@ -972,7 +1029,8 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 	tagSpecifications := getTagSpecificationsInV2(ctx, awstypes.ResourceTypeInstance)
 
 	// block devices
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig(ctx)
+
 	tagSpecifications = append(tagSpecifications,
 		tagSpecificationsFromKeyValue(
 			defaultTagsConfig.MergeTags(tftags.New(ctx, d.Get("volume_tags").(map[string]interface{}))),
@@ -1170,6 +1228,9 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 		d.Set("hibernation", v.Configured)
 	}
 
+	d.Set("ena_support", instance.EnaSupport)
+	d.Set("sriov_net_support", instance.SriovNetSupport)
+
 	if err := d.Set("enclave_options", flattenEnclaveOptions(instance.EnclaveOptions)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting enclave_options: %s", err)
 	}
@@ -1330,8 +1391,9 @@ func resourceInstanceRead(ctx context.Context, d *schema.ResourceData, meta inte
 			return sdkdiag.AppendErrorf(diags, "reading EC2 Instance (%s): %s", d.Id(), err)
 		}
 
-		defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+		defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig(ctx)
 		ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
 		tags := keyValueTagsV2(ctx, volumeTags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
 
 		if err := d.Set("volume_tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
@@ -1507,6 +1569,13 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
+	// Backstop for the plan-time check in CustomizeDiff.
+	if d.HasChanges(instanceStopForUpdateAttributes...) && !d.IsNewResource() {
+		if err := instanceStopForUpdateAllowed(d.Get("allow_stop_for_update").(bool), d.Get("stop_for_update_window").(string), time.Now()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s): %s", d.Id(), err)
+		}
+	}
+
 	if d.HasChange("volume_tags") && !d.IsNewResource() {
 		volIDs, err := getInstanceVolIDs(ctx, conn, d.Id())
 		if err != nil {
@@ -1773,7 +1842,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		}
 	}
 
-	if d.HasChanges(names.AttrInstanceType, "user_data", "user_data_base64") && !d.IsNewResource() {
+	if d.HasChanges(names.AttrInstanceType, "ena_support", "sriov_net_support", "user_data", "user_data_base64") && !d.IsNewResource() {
 		// For each argument change, we start and stop the instance
 		// to account for behaviors occurring outside terraform.
 		// Only one attribute can be modified at a time, else we get
@@ -1794,6 +1863,32 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			}
 		}
 
+		if d.HasChange("ena_support") {
+			input := &ec2.ModifyInstanceAttributeInput{
+				EnaSupport: &awstypes.AttributeBooleanValue{
+					Value: aws.Bool(d.Get("ena_support").(bool)),
+				},
+				InstanceId: aws.String(d.Id()),
+			}
+
+			if err := modifyInstanceAttributeWithStopStart(ctx, conn, input, "EnaSupport"); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) ENA support: %s", d.Id(), err)
+			}
+		}
+
+		if d.HasChange("sriov_net_support") {
+			input := &ec2.ModifyInstanceAttributeInput{
+				InstanceId: aws.String(d.Id()),
+				SriovNetSupport: &awstypes.AttributeValue{
+					Value: aws.String(d.Get("sriov_net_support").(string)),
+				},
+			}
+
+			if err := modifyInstanceAttributeWithStopStart(ctx, conn, input, "SriovNetSupport"); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 Instance (%s) SR-IOV networking support: %s", d.Id(), err)
+			}
+		}
+
 		// From the API reference:
 		// "If you are using an AWS SDK or command line tool,
 		// base64-encoding is performed for you, and you can load the text from a file.
@@ -2212,6 +2307,40 @@ func disableInstanceAPITermination(ctx context.Context, conn *ec2.Client, id str
 	return nil
 }
 
+// instanceStopForUpdateAttributes are the arguments whose in-place update stops and restarts the instance.
+var instanceStopForUpdateAttributes = []string{
+	"capacity_reservation_specification",
+	"ena_support",
+	names.AttrInstanceType,
+	"sriov_net_support",
+	"user_data",
+	"user_data_base64",
+}
+
+// instanceStopForUpdateAllowed returns an error if the instance may not be stopped at the specified time
+// to apply an update.
+func instanceStopForUpdateAllowed(allowStop bool, window string, now time.Time) error {
+	if !allowStop {
+		return errors.New("the update requires the instance to be stopped and allow_stop_for_update is false")
+	}
+
+	if window == "" {
+		return nil
+	}
+
+	ok, err := timestamp.New(window).OnceAWeekWindowContains(now)
+
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return fmt.Errorf("the update requires the instance to be stopped and the current time (%s) is outside stop_for_update_window (%s)", now.UTC().Format(time.RFC3339), window)
+	}
+
+	return nil
+}
+
 // modifyInstanceAttributeWithStopStart modifies a specific attribute provided
 // as input by first stopping the EC2 instance before the modification
 // and then starting up the EC2 instance after modification.
@@ -2318,7 +2447,7 @@ func readBlockDevicesFromInstance(ctx context.Context, d *schema.ResourceData, m
 		return nil, err
 	}
 
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig(ctx)
 	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 
 	for _, vol := range volResp.Volumes {
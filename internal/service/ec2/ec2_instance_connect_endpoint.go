@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
@@ -33,6 +34,7 @@ import (
 
 // @FrameworkResource("aws_ec2_instance_connect_endpoint", name="Instance Connect Endpoint")
 // @Tags(identifierAttribute="id")
+// @ARNIdentity
 // @Testing(tagsTest=false)
 func newInstanceConnectEndpointResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &instanceConnectEndpointResource{}
@@ -254,6 +256,16 @@ func (r *instanceConnectEndpointResource) ModifyPlan(ctx context.Context, reques
 }
 
 // See https://docs.aws.amazon.com/AWSEC2/latest/APIReference/API_Ec2InstanceConnectEndpoint.html.
+func (r *instanceConnectEndpointResource) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, names.EC2, "instance-connect-endpoint/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return parts[0], nil
+}
+
 type instanceConnectEndpointResourceModel struct {
 	InstanceConnectEndpointArn types.String   `tfsdk:"arn"`
 	AvailabilityZone           types.String   `tfsdk:"availability_zone"`
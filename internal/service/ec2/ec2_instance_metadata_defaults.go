@@ -22,7 +22,6 @@ import (
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
-	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -39,6 +38,7 @@ func newInstanceMetadataDefaultsResource(_ context.Context) (resource.ResourceWi
 
 type instanceMetadataDefaultsResource struct {
 	framework.ResourceWithConfigure
+	framework.WithImportByID
 }
 
 func (*instanceMetadataDefaultsResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
@@ -137,37 +137,37 @@ func (r *instanceMetadataDefaultsResource) Read(ctx context.Context, request res
 
 	output, err := findInstanceMetadataDefaults(ctx, conn)
 
-	switch {
-	case err == nil && itypes.IsZero(output):
-		err = tfresource.NewEmptyResultError(nil)
-		fallthrough
-	case tfresource.NotFound(err):
+	if tfresource.NotFound(err) {
 		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
 		response.State.RemoveResource(ctx)
 
 		return
-	case err != nil:
+	}
+
+	if err != nil {
 		response.Diagnostics.AddError("reading EC2 Instance Metadata Defaults", err.Error())
 
 		return
 	}
 
+	// An account always has instance metadata defaults. Settings that have been reset to "no-preference"
+	// are omitted from the API response and are reported as such so that drift is detected.
 	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
 	if response.Diagnostics.HasError() {
 		return
 	}
 
 	// Defaults.
-	if data.HttpEndpoint.IsNull() {
+	if data.HttpEndpoint.IsNull() || data.HttpEndpoint.ValueString() == "" {
 		data.HttpEndpoint = fwtypes.StringEnumValue(awstypes.DefaultInstanceMetadataEndpointStateNoPreference)
 	}
 	if data.HttpPutResponseHopLimit.IsNull() || data.HttpPutResponseHopLimit.ValueInt64() == 0 {
 		data.HttpPutResponseHopLimit = types.Int64Value(httpPutResponseHopLimitNoPreference)
 	}
-	if data.HttpTokens.IsNull() {
+	if data.HttpTokens.IsNull() || data.HttpTokens.ValueString() == "" {
 		data.HttpTokens = fwtypes.StringEnumValue(awstypes.MetadataDefaultHttpTokensStateNoPreference)
 	}
-	if data.InstanceMetadataTags.IsNull() {
+	if data.InstanceMetadataTags.IsNull() || data.InstanceMetadataTags.ValueString() == "" {
 		data.InstanceMetadataTags = fwtypes.StringEnumValue(awstypes.DefaultInstanceMetadataTagsStateNoPreference)
 	}
 
@@ -26,6 +26,7 @@ func TestAccEC2InstanceMetadataDefaults_serial(t *testing.T) {
 		acctest.CtBasic:      testAccInstanceMetadataDefaults_basic,
 		acctest.CtDisappears: testAccInstanceMetadataDefaults_disappears,
 		"empty":              testAccInstanceMetadataDefaults_empty,
+		"noPreference":       testAccInstanceMetadataDefaults_noPreference,
 	}
 
 	acctest.RunSerialTests1Level(t, testCases, 0)
@@ -51,6 +52,11 @@ func testAccInstanceMetadataDefaults_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "instance_metadata_tags", "disabled"),
 				),
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 			{
 				Config: testAccInstanceMetadataDefaultsConfig_partial,
 				Check: resource.ComposeAggregateTestCheckFunc(
@@ -82,6 +88,34 @@ func testAccInstanceMetadataDefaults_empty(t *testing.T) {
 	})
 }
 
+func testAccInstanceMetadataDefaults_noPreference(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_instance_metadata_defaults.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceMetadataDefaultsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceMetadataDefaultsConfig_noPreference,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInstanceMetadataDefaultsExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "http_endpoint", "no-preference"),
+					resource.TestCheckResourceAttr(resourceName, "http_put_response_hop_limit", "-1"),
+					resource.TestCheckResourceAttr(resourceName, "http_tokens", "no-preference"),
+					resource.TestCheckResourceAttr(resourceName, "instance_metadata_tags", "no-preference"),
+				),
+			},
+			{
+				Config:   testAccInstanceMetadataDefaultsConfig_noPreference,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccInstanceMetadataDefaults_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_ec2_instance_metadata_defaults.test"
@@ -158,6 +192,12 @@ resource "aws_ec2_instance_metadata_defaults" "test" {
   http_put_response_hop_limit = 1
 }
 `
+	testAccInstanceMetadataDefaultsConfig_noPreference = `
+resource "aws_ec2_instance_metadata_defaults" "test" {
+  http_tokens = "no-preference"
+}
+`
+
 	testAccInstanceMetadataDefaultsConfig_partial = `
 resource "aws_ec2_instance_metadata_defaults" "test" {
   http_tokens                 = "required" # non-default
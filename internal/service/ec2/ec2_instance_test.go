@@ -2185,6 +2185,57 @@ func TestAccEC2Instance_changeInstanceType(t *testing.T) {
 	})
 }
 
+func TestAccEC2Instance_changeInstanceTypeAllowStopForUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.Instance
+	resourceName := "aws_instance.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInstanceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInstanceConfig_typeAllowStopForUpdate(rName, "t2.medium", false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "allow_stop_for_update", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "t2.medium"),
+				),
+			},
+			{
+				Config:      testAccInstanceConfig_typeAllowStopForUpdate(rName, "t2.large", false, ""),
+				ExpectError: regexache.MustCompile(`updating instance_type requires the instance to be stopped`),
+			},
+			{
+				Config:      testAccInstanceConfig_typeAllowStopForUpdate(rName, "t2.large", true, testAccInstanceStopForUpdateWindowClosed()),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`is outside stop_for_update_window`),
+			},
+			{
+				Config: testAccInstanceConfig_typeAllowStopForUpdate(rName, "t2.large", true, "sun:00:00-sat:23:59"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckInstanceExists(ctx, resourceName, &after),
+					testAccCheckInstanceNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "allow_stop_for_update", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, names.AttrInstanceType, "t2.large"),
+					resource.TestCheckResourceAttr(resourceName, "stop_for_update_window", "sun:00:00-sat:23:59"),
+				),
+			},
+		},
+	})
+}
+
+// testAccInstanceStopForUpdateWindowClosed returns a one-hour stop_for_update_window that starts 12 hours from now.
+func testAccInstanceStopForUpdateWindowClosed() string {
+	start := time.Now().UTC().Add(12 * time.Hour)
+	end := start.Add(1 * time.Hour)
+
+	return strings.ToLower(start.Format("Mon:15:04") + "-" + end.Format("Mon:15:04"))
+}
+
 func TestAccEC2Instance_changeInstanceTypeReplace(t *testing.T) {
 	ctx := acctest.Context(t)
 	var before, after awstypes.Instance
@@ -6089,6 +6140,26 @@ resource "aws_instance" "test" {
 `, instanceType, rName))
 }
 
+func testAccInstanceConfig_typeAllowStopForUpdate(rName, instanceType string, allowStop bool, window string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI(),
+		testAccInstanceVPCConfig(rName, false, 0),
+		fmt.Sprintf(`
+resource "aws_instance" "test" {
+  ami       = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  subnet_id = aws_subnet.test.id
+
+  instance_type          = %[1]q
+  allow_stop_for_update  = %[3]t
+  stop_for_update_window = %[4]q
+
+  tags = {
+    Name = %[2]q
+  }
+}
+`, instanceType, rName, allowStop, window))
+}
+
 func testAccInstanceConfig_typeReplace(rName, instanceType string) string {
 	arch := acctest.ConfigLatestAmazonLinux2HVMEBSX8664AMI()
 	archs := "x86_64"
@@ -5,9 +5,11 @@ package ec2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -25,9 +27,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2/types/nullable"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -199,6 +203,15 @@ func resourceLaunchTemplate() *schema.Resource {
 				Computed:      true,
 				ConflictsWith: []string{"update_default_version"},
 				ValidateFunc:  validation.IntAtLeast(1),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return launchTemplateDefaultVersionMode(d.Get("default_version_mode").(string)) == launchTemplateDefaultVersionModeManual
+				},
+			},
+			"default_version_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ConflictsWith:    []string{"update_default_version"},
+				ValidateDiagFunc: enum.Validate[launchTemplateDefaultVersionMode](),
 			},
 			names.AttrDescription: {
 				Type:         schema.TypeString,
@@ -945,8 +958,10 @@ func resourceLaunchTemplate() *schema.Resource {
 				ConflictsWith: []string{"default_version"},
 			},
 			"user_data": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     verify.ValidBase64String,
+				DiffSuppressFunc: suppressEquivalentLaunchTemplateUserData,
 			},
 			names.AttrVPCSecurityGroupIDs: {
 				Type:          schema.TypeSet,
@@ -965,7 +980,7 @@ func resourceLaunchTemplate() *schema.Resource {
 					case "name", "name_prefix", "description":
 						continue
 					default:
-						return diff.Get("update_default_version").(bool)
+						return launchTemplateDefaultVersionFollowsLatest(diff)
 					}
 				}
 				return false
@@ -973,7 +988,7 @@ func resourceLaunchTemplate() *schema.Resource {
 			customdiff.ComputedIf("latest_version", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				for _, changedKey := range diff.GetChangedKeysPrefix("") {
 					switch changedKey {
-					case "name", "name_prefix", "description", "default_version", "update_default_version":
+					case "name", "name_prefix", "description", "default_version", "default_version_mode", "update_default_version":
 						continue
 					default:
 						return true
@@ -981,6 +996,8 @@ func resourceLaunchTemplate() *schema.Resource {
 				}
 				return false
 			}),
+			customizeDiffLaunchTemplateDefaultVersion,
+			customizeDiffLaunchTemplateMetadataOptions,
 			verify.SetTagsDiff,
 		),
 	}
@@ -1128,12 +1145,12 @@ func resourceLaunchTemplateUpdate(ctx context.Context, d *schema.ResourceData, m
 		latestVersion = aws.ToInt64(output.LaunchTemplateVersion.VersionNumber)
 	}
 
-	if d.Get("update_default_version").(bool) || d.HasChange("default_version") {
+	if followsLatest := launchTemplateDefaultVersionFollowsLatest(d); followsLatest || d.HasChange("default_version") {
 		input := &ec2.ModifyLaunchTemplateInput{
 			LaunchTemplateId: aws.String(d.Id()),
 		}
 
-		if d.Get("update_default_version").(bool) {
+		if followsLatest {
 			input.DefaultVersion = aws.String(strconv.FormatInt(latestVersion, 10))
 		} else if d.HasChange("default_version") {
 			input.DefaultVersion = aws.String(strconv.Itoa(d.Get("default_version").(int)))
@@ -1169,6 +1186,84 @@ func resourceLaunchTemplateDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+// launchTemplateDefaultVersionFollowsLatest returns whether the default version should track the latest version.
+func launchTemplateDefaultVersionFollowsLatest(d sdkv2.ResourceDiffer) bool {
+	return d.Get("update_default_version").(bool) || launchTemplateDefaultVersionMode(d.Get("default_version_mode").(string)) == launchTemplateDefaultVersionModeLatest
+}
+
+func customizeDiffLaunchTemplateDefaultVersion(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	defaultVersionConfigured := !diff.GetRawConfig().GetAttr("default_version").IsNull()
+
+	switch mode := launchTemplateDefaultVersionMode(diff.Get("default_version_mode").(string)); mode {
+	case launchTemplateDefaultVersionModePinned:
+		if !defaultVersionConfigured {
+			return fmt.Errorf(`default_version must be set when default_version_mode is "%s"`, mode)
+		}
+	case launchTemplateDefaultVersionModeLatest, launchTemplateDefaultVersionModeManual:
+		if defaultVersionConfigured {
+			return fmt.Errorf(`default_version cannot be set when default_version_mode is "%s"`, mode)
+		}
+	}
+
+	return nil
+}
+
+func customizeDiffLaunchTemplateMetadataOptions(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	v := diff.GetRawConfig().GetAttr("metadata_options")
+
+	if !v.IsKnown() || v.IsNull() {
+		return nil
+	}
+
+	for _, tfObj := range v.AsValueSlice() {
+		if !tfObj.IsKnown() || tfObj.IsNull() {
+			continue
+		}
+
+		getAttr := func(name string) string {
+			if v := tfObj.GetAttr(name); v.IsKnown() && !v.IsNull() {
+				return v.AsString()
+			}
+
+			return ""
+		}
+
+		if getAttr("http_endpoint") != string(awstypes.LaunchTemplateInstanceMetadataEndpointStateDisabled) {
+			continue
+		}
+
+		if getAttr("http_protocol_ipv6") == string(awstypes.LaunchTemplateInstanceMetadataProtocolIpv6Enabled) {
+			return errors.New(`metadata_options: http_protocol_ipv6 cannot be "enabled" when http_endpoint is "disabled"`)
+		}
+
+		if getAttr("instance_metadata_tags") == string(awstypes.LaunchTemplateInstanceMetadataTagsStateEnabled) {
+			return errors.New(`metadata_options: instance_metadata_tags cannot be "enabled" when http_endpoint is "disabled"`)
+		}
+	}
+
+	return nil
+}
+
+// suppressEquivalentLaunchTemplateUserData suppresses differences between base64-encoded
+// user data values that decode to the same content, ignoring trailing newlines.
+func suppressEquivalentLaunchTemplateUserData(k, old, new string, d *schema.ResourceData) bool {
+	if old == new {
+		return true
+	}
+
+	oldBytes, err := itypes.Base64Decode(old)
+	if err != nil {
+		return false
+	}
+
+	newBytes, err := itypes.Base64Decode(new)
+	if err != nil {
+		return false
+	}
+
+	return strings.TrimRight(string(oldBytes), "\r\n") == strings.TrimRight(string(newBytes), "\r\n")
+}
+
 func expandRequestLaunchTemplateData(ctx context.Context, conn *ec2.Client, d *schema.ResourceData) (*awstypes.RequestLaunchTemplateData, error) {
 	apiObject := &awstypes.RequestLaunchTemplateData{
 		// Always set at least one field.
@@ -3216,6 +3216,111 @@ func TestAccEC2LaunchTemplate_updateDefaultVersion(t *testing.T) {
 	})
 }
 
+func TestAccEC2LaunchTemplate_defaultVersionMode(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLaunchTemplateConfig_descriptionDefaultVersionMode(rName, "Test Description 1", "pinned"),
+				ExpectError: regexache.MustCompile(`default_version must be set when default_version_mode is "pinned"`),
+			},
+			{
+				Config: testAccLaunchTemplateConfig_descriptionDefaultVersionMode(rName, "Test Description 1", "latest"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "default_version_mode", "latest"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", acctest.Ct1),
+				),
+			},
+			// Updating a field should create a new version and make it the default.
+			{
+				Config: testAccLaunchTemplateConfig_descriptionDefaultVersionMode(rName, "Test Description 2", "latest"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_version", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", acctest.Ct2),
+				),
+			},
+			// In manual mode new versions are created but the default is left alone.
+			{
+				Config: testAccLaunchTemplateConfig_descriptionDefaultVersionMode(rName, "Test Description 3", "manual"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_version", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "default_version_mode", "manual"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", acctest.Ct3),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"default_version_mode",
+				},
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplate_userDataTrailingNewline(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template awstypes.LaunchTemplate
+	resourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLaunchTemplateConfig_userDataRaw(rName, "#!/bin/bash"),
+				ExpectError: regexache.MustCompile(`must be base64-encoded`),
+			},
+			{
+				Config: testAccLaunchTemplateConfig_userData(rName, `#!/bin/bash\necho hello`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", acctest.Ct1),
+				),
+			},
+			{
+				Config:   testAccLaunchTemplateConfig_userData(rName, `#!/bin/bash\necho hello\n`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplate_metadataOptionsInvalidCombination(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLaunchTemplateConfig_metadataOptionsDisabledEndpoint(rName, "http_protocol_ipv6"),
+				ExpectError: regexache.MustCompile(`http_protocol_ipv6 cannot be "enabled" when http_endpoint is "disabled"`),
+			},
+			{
+				Config:      testAccLaunchTemplateConfig_metadataOptionsDisabledEndpoint(rName, "instance_metadata_tags"),
+				ExpectError: regexache.MustCompile(`instance_metadata_tags cannot be "enabled" when http_endpoint is "disabled"`),
+			},
+		},
+	})
+}
+
 func testAccCheckLaunchTemplateExists(ctx context.Context, n string, v *awstypes.LaunchTemplate) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -4201,3 +4306,44 @@ resource "aws_launch_template" "test" {
 }
 `, rName, description, update)
 }
+
+func testAccLaunchTemplateConfig_descriptionDefaultVersionMode(rName, description, mode string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name                 = %[1]q
+  description          = %[2]q
+  default_version_mode = %[3]q
+}
+`, rName, description, mode)
+}
+
+func testAccLaunchTemplateConfig_userData(rName, userData string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name      = %[1]q
+  user_data = base64encode("%[2]s")
+}
+`, rName, userData)
+}
+
+func testAccLaunchTemplateConfig_userDataRaw(rName, userData string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name      = %[1]q
+  user_data = %[2]q
+}
+`, rName, userData)
+}
+
+func testAccLaunchTemplateConfig_metadataOptionsDisabledEndpoint(rName, enabledKey string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name = %[1]q
+
+  metadata_options {
+    http_endpoint = "disabled"
+    %[2]s = "enabled"
+  }
+}
+`, rName, enabledKey)
+}
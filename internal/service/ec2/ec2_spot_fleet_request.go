@@ -16,6 +16,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -366,7 +367,6 @@ func resourceSpotFleetRequest() *schema.Resource {
 			"launch_template_config": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"launch_template_specification": {
@@ -378,19 +378,16 @@ func resourceSpotFleetRequest() *schema.Resource {
 									names.AttrID: {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ForceNew:     true,
 										ValidateFunc: verify.ValidLaunchTemplateID,
 									},
 									names.AttrName: {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ForceNew:     true,
 										ValidateFunc: verify.ValidLaunchTemplateName,
 									},
 									names.AttrVersion: {
 										Type:         schema.TypeString,
 										Optional:     true,
-										ForceNew:     true,
 										ValidateFunc: validation.StringLenBetween(1, 255),
 									},
 								},
@@ -399,38 +396,32 @@ func resourceSpotFleetRequest() *schema.Resource {
 						"overrides": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							ForceNew: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									names.AttrAvailabilityZone: {
 										Type:     schema.TypeString,
 										Optional: true,
-										ForceNew: true,
 									},
 									"instance_requirements": {
 										Type:     schema.TypeList,
 										Optional: true,
-										ForceNew: true,
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
 												"accelerator_count": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															names.AttrMax: {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(0),
 															},
 															names.AttrMin: {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 														},
@@ -439,7 +430,6 @@ func resourceSpotFleetRequest() *schema.Resource {
 												"accelerator_manufacturers": {
 													Type:     schema.TypeSet,
 													Optional: true,
-													ForceNew: true,
 													Elem: &schema.Schema{
 														Type:             schema.TypeString,
 														ValidateDiagFunc: enum.Validate[awstypes.AcceleratorManufacturer](),
@@ -448,7 +438,6 @@ func resourceSpotFleetRequest() *schema.Resource {
 												"accelerator_names": {
 													Type:     schema.TypeSet,
 													Optional: true,
-													ForceNew: true,
 													Elem: &schema.Schema{
 														Type:             schema.TypeString,
 														ValidateDiagFunc: enum.Validate[awstypes.AcceleratorName](),
@@ -457,20 +446,17 @@ func resourceSpotFleetRequest() *schema.Resource {
 												"accelerator_total_memory_mib": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															names.AttrMax: {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 															names.AttrMin: {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 														},
@@ -479,7 +465,6 @@ func resourceSpotFleetRequest() *schema.Resource {
 												"accelerator_types": {
 													Type:     schema.TypeSet,
 													Optional: true,
-													ForceNew: true,
 													Elem: &schema.Schema{
 														Type:             schema.TypeString,
 														ValidateDiagFunc: enum.Validate[awstypes.AcceleratorType](),
@@ -488,33 +473,28 @@ func resourceSpotFleetRequest() *schema.Resource {
 												"allowed_instance_types": {
 													Type:     schema.TypeSet,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 400,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
 												"bare_metal": {
 													Type:             schema.TypeString,
 													Optional:         true,
-													ForceNew:         true,
 													ValidateDiagFunc: enum.Validate[awstypes.BareMetal](),
 												},
 												"baseline_ebs_bandwidth_mbps": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															names.AttrMax: {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 															names.AttrMin: {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 														},
@@ -523,13 +503,11 @@ func resourceSpotFleetRequest() *schema.Resource {
 												"burstable_performance": {
 													Type:             schema.TypeString,
 													Optional:         true,
-													ForceNew:         true,
 													ValidateDiagFunc: enum.Validate[awstypes.BurstablePerformance](),
 												},
 												"cpu_manufacturers": {
 													Type:     schema.TypeSet,
 													Optional: true,
-													ForceNew: true,
 													Elem: &schema.Schema{
 														Type:             schema.TypeString,
 														ValidateDiagFunc: enum.Validate[awstypes.CpuManufacturer](),
@@ -538,14 +516,12 @@ func resourceSpotFleetRequest() *schema.Resource {
 												"excluded_instance_types": {
 													Type:     schema.TypeSet,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 400,
 													Elem:     &schema.Schema{Type: schema.TypeString},
 												},
 												"instance_generations": {
 													Type:     schema.TypeSet,
 													Optional: true,
-													ForceNew: true,
 													Elem: &schema.Schema{
 														Type:             schema.TypeString,
 														ValidateDiagFunc: enum.Validate[awstypes.InstanceGeneration](),
@@ -554,13 +530,11 @@ func resourceSpotFleetRequest() *schema.Resource {
 												"local_storage": {
 													Type:             schema.TypeString,
 													Optional:         true,
-													ForceNew:         true,
 													ValidateDiagFunc: enum.Validate[awstypes.LocalStorage](),
 												},
 												"local_storage_types": {
 													Type:     schema.TypeSet,
 													Optional: true,
-													ForceNew: true,
 													Elem: &schema.Schema{
 														Type:             schema.TypeString,
 														ValidateDiagFunc: enum.Validate[awstypes.LocalStorageType](),
@@ -569,20 +543,17 @@ func resourceSpotFleetRequest() *schema.Resource {
 												"memory_gib_per_vcpu": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															names.AttrMax: {
 																Type:         schema.TypeFloat,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: verify.FloatGreaterThan(0.0),
 															},
 															names.AttrMin: {
 																Type:         schema.TypeFloat,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: verify.FloatGreaterThan(0.0),
 															},
 														},
@@ -591,20 +562,17 @@ func resourceSpotFleetRequest() *schema.Resource {
 												"memory_mib": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															names.AttrMax: {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 															names.AttrMin: {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 														},
@@ -613,20 +581,17 @@ func resourceSpotFleetRequest() *schema.Resource {
 												"network_bandwidth_gbps": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															names.AttrMax: {
 																Type:         schema.TypeFloat,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: verify.FloatGreaterThan(0.0),
 															},
 															names.AttrMin: {
 																Type:         schema.TypeFloat,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: verify.FloatGreaterThan(0.0),
 															},
 														},
@@ -635,20 +600,17 @@ func resourceSpotFleetRequest() *schema.Resource {
 												"network_interface_count": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															names.AttrMax: {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 															names.AttrMin: {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 														},
@@ -657,37 +619,31 @@ func resourceSpotFleetRequest() *schema.Resource {
 												"on_demand_max_price_percentage_over_lowest_price": {
 													Type:         schema.TypeInt,
 													Optional:     true,
-													ForceNew:     true,
 													ValidateFunc: validation.IntAtLeast(1),
 												},
 												"require_hibernate_support": {
 													Type:     schema.TypeBool,
 													Optional: true,
-													ForceNew: true,
 												},
 												"spot_max_price_percentage_over_lowest_price": {
 													Type:         schema.TypeInt,
 													Optional:     true,
-													ForceNew:     true,
 													ValidateFunc: validation.IntAtLeast(1),
 												},
 												"total_local_storage_gb": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															names.AttrMax: {
 																Type:         schema.TypeFloat,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: verify.FloatGreaterThan(0.0),
 															},
 															names.AttrMin: {
 																Type:         schema.TypeFloat,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: verify.FloatGreaterThan(0.0),
 															},
 														},
@@ -696,20 +652,17 @@ func resourceSpotFleetRequest() *schema.Resource {
 												"vcpu_count": {
 													Type:     schema.TypeList,
 													Optional: true,
-													ForceNew: true,
 													MaxItems: 1,
 													Elem: &schema.Resource{
 														Schema: map[string]*schema.Schema{
 															names.AttrMax: {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 															names.AttrMin: {
 																Type:         schema.TypeInt,
 																Optional:     true,
-																ForceNew:     true,
 																ValidateFunc: validation.IntAtLeast(1),
 															},
 														},
@@ -721,31 +674,26 @@ func resourceSpotFleetRequest() *schema.Resource {
 									names.AttrInstanceType: {
 										Type:     schema.TypeString,
 										Optional: true,
-										ForceNew: true,
 									},
 									names.AttrPriority: {
 										Type:     schema.TypeFloat,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 									},
 									"spot_price": {
 										Type:     schema.TypeString,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 									},
 									names.AttrSubnetID: {
 										Type:     schema.TypeString,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 									},
 									"weighted_capacity": {
 										Type:     schema.TypeFloat,
 										Optional: true,
 										Computed: true,
-										ForceNew: true,
 									},
 								},
 							},
@@ -869,7 +817,10 @@ func resourceSpotFleetRequest() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			resourceSpotFleetRequestCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -1141,6 +1092,12 @@ func resourceSpotFleetRequestUpdate(ctx context.Context, d *schema.ResourceData,
 			}
 		}
 
+		if d.HasChange("launch_template_config") {
+			if v, ok := d.GetOk("launch_template_config"); ok && v.(*schema.Set).Len() > 0 {
+				input.LaunchTemplateConfigs = expandLaunchTemplateConfigs(v.(*schema.Set).List())
+			}
+		}
+
 		log.Printf("[DEBUG] Modifying EC2 Spot Fleet Request: %s", d.Id())
 		if _, err := conn.ModifySpotFleetRequest(ctx, input); err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating EC2 Spot Fleet Request (%s): %s", d.Id(), err)
@@ -1211,6 +1168,17 @@ func resourceSpotFleetRequestDelete(ctx context.Context, d *schema.ResourceData,
 	return diags
 }
 
+func resourceSpotFleetRequestCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Launch template configurations can only be modified for fleets of type "maintain".
+	if diff.Id() != "" && diff.HasChange("launch_template_config") && diff.Get("fleet_type").(string) != string(awstypes.FleetTypeMaintain) {
+		if err := diff.ForceNew("launch_template_config"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func buildSpotFleetLaunchSpecification(ctx context.Context, d map[string]interface{}, meta interface{}) (awstypes.SpotFleetLaunchSpecification, error) {
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
 
@@ -427,6 +427,49 @@ func TestAccEC2SpotFleetRequest_launchTemplateWithInstanceRequirementsOverrides(
 	})
 }
 
+func TestAccEC2SpotFleetRequest_updateLaunchTemplateInstanceRequirements(t *testing.T) {
+	ctx := acctest.Context(t)
+	var before, after awstypes.SpotFleetRequestConfig
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	validUntil := time.Now().UTC().Add(24 * time.Hour).Format(time.RFC3339)
+	resourceName := "aws_spot_fleet_request.test"
+
+	publicKey, _, err := sdkacctest.RandSSHKeyPair(acctest.DefaultEmailAddress)
+	if err != nil {
+		t.Fatalf("error generating random SSH key: %s", err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSpotFleetRequest(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSpotFleetRequestDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsVCPUCount(rName, publicKey, validUntil, 4),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &before),
+					resource.TestCheckResourceAttr(resourceName, "fleet_type", "maintain"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						"instance_requirements.0.vcpu_count.0.max": acctest.Ct4,
+					}),
+				),
+			},
+			{
+				Config: testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsVCPUCount(rName, publicKey, validUntil, 8),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSpotFleetRequestExists(ctx, resourceName, &after),
+					testAccCheckSpotFleetRequestNotRecreated(&before, &after),
+					resource.TestCheckResourceAttr(resourceName, "spot_request_state", "active"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "launch_template_config.*.overrides.*", map[string]string{
+						"instance_requirements.0.vcpu_count.0.max": "8",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEC2SpotFleetRequest_launchTemplateToLaunchSpec(t *testing.T) {
 	ctx := acctest.Context(t)
 	var before, after awstypes.SpotFleetRequestConfig
@@ -1786,6 +1829,16 @@ func testAccCheckSpotFleetRequestRecreatedConfig(t *testing.T,
 	}
 }
 
+func testAccCheckSpotFleetRequestNotRecreated(before, after *awstypes.SpotFleetRequestConfig) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.ToString(before.SpotFleetRequestId), aws.ToString(after.SpotFleetRequestId); before != after {
+			return fmt.Errorf("EC2 Spot Fleet Request (%s) recreated (%s)", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckSpotFleetRequestExists(ctx context.Context, n string, v *awstypes.SpotFleetRequestConfig) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -2379,6 +2432,51 @@ resource "aws_spot_fleet_request" "test" {
 `, rName, validUntil))
 }
 
+func testAccSpotFleetRequestConfig_launchTemplateInstanceRequirementsVCPUCount(rName, publicKey, validUntil string, vcpuMax int) string {
+	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  image_id      = data.aws_ami.amzn2-ami-minimal-hvm-ebs-x86_64.id
+  instance_type = data.aws_ec2_instance_type_offering.available.instance_type
+  key_name      = aws_key_pair.test.key_name
+}
+
+resource "aws_spot_fleet_request" "test" {
+  iam_fleet_role                      = aws_iam_role.test.arn
+  spot_price                          = "0.07"
+  target_capacity                     = 1
+  valid_until                         = %[2]q
+  terminate_instances_with_expiration = true
+  fleet_type                          = "maintain"
+
+  launch_template_config {
+    launch_template_specification {
+      name    = aws_launch_template.test.name
+      version = aws_launch_template.test.latest_version
+    }
+
+    overrides {
+      availability_zone = data.aws_availability_zones.available.names[2]
+
+      instance_requirements {
+        vcpu_count {
+          min = 1
+          max = %[3]d
+        }
+
+        memory_mib {
+          min = 500
+          max = 50000
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_iam_policy_attachment.test]
+}
+`, rName, validUntil, vcpuMax))
+}
+
 func testAccSpotFleetRequestConfig_excessCapacityTermination(rName, publicKey, validUntil string) string {
 	return acctest.ConfigCompose(testAccSpotFleetRequestConfig_base(rName, publicKey), fmt.Sprintf(`
 resource "aws_spot_fleet_request" "test" {
@@ -22,10 +22,12 @@ var (
 	ResourceEBSEncryptionByDefault                   = resourceEBSEncryptionByDefault
 	ResourceEBSFastSnapshotRestore                   = newEBSFastSnapshotRestoreResource
 	ResourceEBSSnapshot                              = resourceEBSSnapshot
+	ResourceEBSSnapshotBlockPublicAccess             = resourceEBSSnapshotBlockPublicAccess
 	ResourceEBSSnapshotCopy                          = resourceEBSSnapshotCopy
 	ResourceEBSSnapshotImport                        = resourceEBSSnapshotImport
 	ResourceEBSVolume                                = resourceEBSVolume
 	ResourceEIP                                      = resourceEIP
+	ResourceEIPAddressTransfer                       = newEIPAddressTransferResource
 	ResourceEIPAssociation                           = resourceEIPAssociation
 	ResourceEIPDomainName                            = newEIPDomainNameResource
 	ResourceFleet                                    = resourceFleet
@@ -57,6 +59,7 @@ var (
 	ResourceRouteTable                               = resourceRouteTable
 	ResourceSecurityGroupEgressRule                  = newSecurityGroupEgressRuleResource
 	ResourceSecurityGroupIngressRule                 = newSecurityGroupIngressRuleResource
+	ResourceSecurityGroupRules                       = resourceSecurityGroupRules
 	ResourceSnapshotCreateVolumePermission           = resourceSnapshotCreateVolumePermission
 	ResourceSpotDataFeedSubscription                 = resourceSpotDataFeedSubscription
 	ResourceSpotFleetRequest                         = resourceSpotFleetRequest
@@ -64,6 +67,7 @@ var (
 	ResourceTag                                      = resourceTag
 	ResourceTrafficMirrorFilter                      = resourceTrafficMirrorFilter
 	ResourceTrafficMirrorFilterRule                  = resourceTrafficMirrorFilterRule
+	ResourceTrafficMirrorFilterRules                 = resourceTrafficMirrorFilterRules
 	ResourceTrafficMirrorSession                     = resourceTrafficMirrorSession
 	ResourceTrafficMirrorTarget                      = resourceTrafficMirrorTarget
 	ResourceTransitGatewayConnect                    = resourceTransitGatewayConnect
@@ -94,6 +98,7 @@ var (
 	ErrCodeDefaultSubnetAlreadyExistsInAvailabilityZone        = errCodeDefaultSubnetAlreadyExistsInAvailabilityZone
 	ErrCodeInvalidSpotDatafeedNotFound                         = errCodeInvalidSpotDatafeedNotFound
 	FindAvailabilityZones                                      = findAvailabilityZones
+	FindBYOIPCIDRByCIDR                                        = findBYOIPCIDRByCIDR
 	FindCapacityReservationByID                                = findCapacityReservationByID
 	FindCarrierGatewayByID                                     = findCarrierGatewayByID
 	FindClientVPNAuthorizationRuleByThreePartKey               = findClientVPNAuthorizationRuleByThreePartKey
@@ -102,8 +107,10 @@ var (
 	FindClientVPNRouteByThreePartKey                           = findClientVPNRouteByThreePartKey
 	FindCreateSnapshotCreateVolumePermissionByTwoPartKey       = findCreateSnapshotCreateVolumePermissionByTwoPartKey
 	FindCustomerGatewayByID                                    = findCustomerGatewayByID
+	FindEBSSnapshotBlockPublicAccessState                      = findEBSSnapshotBlockPublicAccessState
 	FindEBSVolumeAttachment                                    = findVolumeAttachment
 	FindEBSVolumeByID                                          = findEBSVolumeByID
+	FindEIPAddressTransferByAllocationID                       = findEIPAddressTransferByAllocationID
 	FindEIPByAllocationID                                      = findEIPByAllocationID
 	FindEIPByAssociationID                                     = findEIPByAssociationID
 	FindEIPDomainNameAttributeByAllocationID                   = findEIPDomainNameAttributeByAllocationID
@@ -199,6 +199,33 @@ func findFleetByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.
 	return output, nil
 }
 
+func findFleetInstances(ctx context.Context, conn *ec2.Client, input *ec2.DescribeFleetInstancesInput) ([]awstypes.ActiveInstance, error) {
+	var output []awstypes.ActiveInstance
+
+	err := describeFleetInstancesPages(ctx, conn, input, func(page *ec2.DescribeFleetInstancesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		output = append(output, page.ActiveInstances...)
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidFleetIdNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func findHostByID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.Host, error) {
 	input := &ec2.DescribeHostsInput{
 		HostIds: []string{id},
@@ -1844,6 +1871,10 @@ func findVPCEndpointServicePrivateDNSNameConfigurationByID(ctx context.Context,
 		return nil, err
 	}
 
+	if out.PrivateDnsNameConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(id)
+	}
+
 	return out.PrivateDnsNameConfiguration, nil
 }
 
@@ -2431,6 +2462,44 @@ func findVPNConnectionByID(ctx context.Context, conn *ec2.Client, id string) (*a
 	return output, nil
 }
 
+func findVPNConnectionDeviceSampleConfiguration(ctx context.Context, conn *ec2.Client, input *ec2.GetVpnConnectionDeviceSampleConfigurationInput) (*string, error) {
+	output, err := conn.GetVpnConnectionDeviceSampleConfiguration(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidVPNConnectionIDNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.VpnConnectionDeviceSampleConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.VpnConnectionDeviceSampleConfiguration, nil
+}
+
+func findVPNConnectionDeviceTypes(ctx context.Context, conn *ec2.Client, input *ec2.GetVpnConnectionDeviceTypesInput) ([]awstypes.VpnConnectionDeviceType, error) {
+	var output []awstypes.VpnConnectionDeviceType
+
+	pages := ec2.NewGetVpnConnectionDeviceTypesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.VpnConnectionDeviceTypes...)
+	}
+
+	return output, nil
+}
+
 func findVPNConnectionRouteByTwoPartKey(ctx context.Context, conn *ec2.Client, vpnConnectionID, cidrBlock string) (*awstypes.VpnStaticRoute, error) {
 	input := &ec2.DescribeVpnConnectionsInput{
 		Filters: newAttributeFilterListV2(map[string]string{
@@ -3157,6 +3226,21 @@ func findImageBlockPublicAccessState(ctx context.Context, conn *ec2.Client) (*st
 	return output.ImageBlockPublicAccessState, nil
 }
 
+func findEBSSnapshotBlockPublicAccessState(ctx context.Context, conn *ec2.Client) (awstypes.SnapshotBlockPublicAccessState, error) {
+	input := &ec2.GetSnapshotBlockPublicAccessStateInput{}
+	output, err := conn.GetSnapshotBlockPublicAccessState(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.State == "" {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return output.State, nil
+}
+
 func findImageLaunchPermissionsByID(ctx context.Context, conn *ec2.Client, id string) ([]awstypes.LaunchPermission, error) {
 	input := &ec2.DescribeImageAttributeInput{
 		Attribute: awstypes.ImageAttributeNameLaunchPermission,
@@ -4349,6 +4433,68 @@ func findEIPDomainNameAttributeByAllocationID(ctx context.Context, conn *ec2.Cli
 	return output, nil
 }
 
+func findEIPAddressTransfers(ctx context.Context, conn *ec2.Client, input *ec2.DescribeAddressTransfersInput) ([]awstypes.AddressTransfer, error) {
+	var output []awstypes.AddressTransfer
+
+	pages := ec2.NewDescribeAddressTransfersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidAllocationIDNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AddressTransfers...)
+	}
+
+	return output, nil
+}
+
+func findEIPAddressTransfer(ctx context.Context, conn *ec2.Client, input *ec2.DescribeAddressTransfersInput) (*awstypes.AddressTransfer, error) {
+	output, err := findEIPAddressTransfers(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findEIPAddressTransferByAllocationID(ctx context.Context, conn *ec2.Client, id string) (*awstypes.AddressTransfer, error) {
+	input := &ec2.DescribeAddressTransfersInput{
+		AllocationIds: []string{id},
+	}
+
+	output, err := findEIPAddressTransfer(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if status := output.AddressTransferStatus; status == awstypes.AddressTransferStatusDisabled {
+		return nil, &retry.NotFoundError{
+			Message:     string(status),
+			LastRequest: input,
+		}
+	}
+
+	// Eventual consistency check.
+	if aws.ToString(output.AllocationId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
 func findKeyPair(ctx context.Context, conn *ec2.Client, input *ec2.DescribeKeyPairsInput) (*awstypes.KeyPairInfo, error) {
 	output, err := findKeyPairs(ctx, conn, input)
 
@@ -5211,6 +5357,28 @@ func findTrafficMirrorSessionByID(ctx context.Context, conn *ec2.Client, id stri
 	return output, nil
 }
 
+// findTrafficMirrorSessionNumbersByNetworkInterfaceID returns the session numbers in use on the specified network interface, mapped to the owning session ID.
+func findTrafficMirrorSessionNumbersByNetworkInterfaceID(ctx context.Context, conn *ec2.Client, networkInterfaceID string) (map[int32]string, error) {
+	input := &ec2.DescribeTrafficMirrorSessionsInput{
+		Filters: newAttributeFilterListV2(map[string]string{
+			"network-interface-id": networkInterfaceID,
+		}),
+	}
+
+	output, err := findTrafficMirrorSessions(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sessionNumbers := make(map[int32]string, len(output))
+	for _, v := range output {
+		sessionNumbers[aws.ToInt32(v.SessionNumber)] = aws.ToString(v.TrafficMirrorSessionId)
+	}
+
+	return sessionNumbers, nil
+}
+
 func findTrafficMirrorTarget(ctx context.Context, conn *ec2.Client, input *ec2.DescribeTrafficMirrorTargetsInput) (*awstypes.TrafficMirrorTarget, error) {
 	output, err := findTrafficMirrorTargets(ctx, conn, input)
 
@@ -5286,6 +5454,27 @@ func findNetworkInsightsAnalysis(ctx context.Context, conn *ec2.Client, input *e
 	return tfresource.AssertSingleValueResult(output)
 }
 
+func findMostRecentNetworkInsightsAnalysis(ctx context.Context, conn *ec2.Client, input *ec2.DescribeNetworkInsightsAnalysesInput) (*awstypes.NetworkInsightsAnalysis, error) {
+	output, err := findNetworkInsightsAnalyses(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	mostRecent := output[0]
+	for _, v := range output[1:] {
+		if aws.ToTime(v.StartDate).After(aws.ToTime(mostRecent.StartDate)) {
+			mostRecent = v
+		}
+	}
+
+	return &mostRecent, nil
+}
+
 func findNetworkInsightsAnalyses(ctx context.Context, conn *ec2.Client, input *ec2.DescribeNetworkInsightsAnalysesInput) ([]awstypes.NetworkInsightsAnalysis, error) {
 	var output []awstypes.NetworkInsightsAnalysis
 
@@ -5375,3 +5564,51 @@ func findNetworkInsightsPathByID(ctx context.Context, conn *ec2.Client, id strin
 
 	return output, nil
 }
+
+func findBYOIPCIDRs(ctx context.Context, conn *ec2.Client, input *ec2.DescribeByoipCidrsInput) ([]awstypes.ByoipCidr, error) {
+	var output []awstypes.ByoipCidr
+
+	pages := ec2.NewDescribeByoipCidrsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ByoipCidrs...)
+	}
+
+	return output, nil
+}
+
+func findBYOIPCIDRByCIDR(ctx context.Context, conn *ec2.Client, cidrBlock string) (*awstypes.ByoipCidr, error) {
+	input := &ec2.DescribeByoipCidrsInput{
+		MaxResults: aws.Int32(100),
+	}
+
+	output, err := findBYOIPCIDRs(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	output = tfslices.Filter(output, func(v awstypes.ByoipCidr) bool {
+		return aws.ToString(v.Cidr) == cidrBlock
+	})
+
+	byoipCIDR, err := tfresource.AssertSingleValueResult(output)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if state := byoipCIDR.State; state == awstypes.ByoipCidrStateDeprovisioned {
+		return nil, &retry.NotFoundError{
+			Message:     string(state),
+			LastRequest: input,
+		}
+	}
+
+	return byoipCIDR, nil
+}
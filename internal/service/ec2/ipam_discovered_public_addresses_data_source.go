@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_vpc_ipam_discovered_public_addresses", name="IPAM Discovered Public Addresses")
+func dataSourceIPAMDiscoveredPublicAddresses() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceIPAMDiscoveredPublicAddressesRead,
+
+		Schema: map[string]*schema.Schema{
+			"address_region": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"addresses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrAddress: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address_allocation_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address_owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"association_status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrInstanceID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_border_group": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"network_interface_description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrNetworkInterfaceID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public_ipv4_pool_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sample_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_resource": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrSubnetID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrVPCID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrFilter: customFiltersSchema(),
+			"ipam_resource_discovery_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"oldest_sample_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceIPAMDiscoveredPublicAddressesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	resourceDiscoveryID := d.Get("ipam_resource_discovery_id").(string)
+	addressRegion := d.Get("address_region").(string)
+	input := &ec2.GetIpamDiscoveredPublicAddressesInput{
+		AddressRegion:           aws.String(addressRegion),
+		IpamResourceDiscoveryId: aws.String(resourceDiscoveryID),
+	}
+
+	input.Filters = append(input.Filters, newCustomFilterListV2(
+		d.Get(names.AttrFilter).(*schema.Set),
+	)...)
+
+	if len(input.Filters) == 0 {
+		input.Filters = nil
+	}
+
+	var oldestSampleTime *time.Time
+	var addresses []awstypes.IpamDiscoveredPublicAddress
+	err := getIPAMDiscoveredPublicAddressesPages(ctx, conn, input, func(page *ec2.GetIpamDiscoveredPublicAddressesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		if oldestSampleTime == nil {
+			oldestSampleTime = page.OldestSampleTime
+		}
+		addresses = append(addresses, page.IpamDiscoveredPublicAddresses...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IPAM Resource Discovery (%s) discovered public addresses: %s", resourceDiscoveryID, err)
+	}
+
+	d.SetId(resourceDiscoveryID + "," + addressRegion)
+	if err := d.Set("addresses", flattenIPAMDiscoveredPublicAddresses(addresses)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting addresses: %s", err)
+	}
+	if oldestSampleTime != nil {
+		d.Set("oldest_sample_time", aws.ToTime(oldestSampleTime).Format(time.RFC3339))
+	} else {
+		d.Set("oldest_sample_time", nil)
+	}
+
+	return diags
+}
+
+func flattenIPAMDiscoveredPublicAddresses(apiObjects []awstypes.IpamDiscoveredPublicAddress) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrAddress:               aws.ToString(apiObject.Address),
+			"address_allocation_id":         aws.ToString(apiObject.AddressAllocationId),
+			"address_owner_id":              aws.ToString(apiObject.AddressOwnerId),
+			"address_type":                  apiObject.AddressType,
+			"association_status":            apiObject.AssociationStatus,
+			names.AttrInstanceID:            aws.ToString(apiObject.InstanceId),
+			"network_border_group":          aws.ToString(apiObject.NetworkBorderGroup),
+			"network_interface_description": aws.ToString(apiObject.NetworkInterfaceDescription),
+			names.AttrNetworkInterfaceID:    aws.ToString(apiObject.NetworkInterfaceId),
+			"public_ipv4_pool_id":           aws.ToString(apiObject.PublicIpv4PoolId),
+			"service":                       apiObject.Service,
+			"service_resource":              aws.ToString(apiObject.ServiceResource),
+			names.AttrSubnetID:              aws.ToString(apiObject.SubnetId),
+			names.AttrVPCID:                 aws.ToString(apiObject.VpcId),
+		}
+
+		if v := apiObject.SampleTime; v != nil {
+			tfMap["sample_time"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
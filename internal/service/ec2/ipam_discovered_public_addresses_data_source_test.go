@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIPAMDiscoveredPublicAddressesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_vpc_ipam_discovered_public_addresses.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPAMDiscoveredPublicAddressesDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "addresses.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "address_region", "data.aws_region.current", names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "ipam_resource_discovery_id", "aws_vpc_ipam_resource_discovery.test", names.AttrID),
+				),
+			},
+		},
+	})
+}
+
+const testAccIPAMDiscoveredPublicAddressesDataSourceConfig_basic = testAccIPAMResourceDiscoveryConfig_base + `
+data "aws_vpc_ipam_discovered_public_addresses" "test" {
+  address_region             = data.aws_region.current.name
+  ipam_resource_discovery_id = aws_vpc_ipam_resource_discovery.test.id
+}
+`
@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeSpotFleetInstances,DescribeSpotFleetRequestHistory,DescribeVpcEndpointServices -AWSSDKVersion=2"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=DescribeFleetInstances,DescribeSpotFleetInstances,DescribeSpotFleetRequestHistory,DescribeVpcEndpointServices,GetIpamDiscoveredPublicAddresses -AWSSDKVersion=2"; DO NOT EDIT.
 
 package ec2
 
@@ -9,6 +9,22 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 )
 
+func describeFleetInstancesPages(ctx context.Context, conn *ec2.Client, input *ec2.DescribeFleetInstancesInput, fn func(*ec2.DescribeFleetInstancesOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeFleetInstances(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
 func describeSpotFleetInstancesPages(ctx context.Context, conn *ec2.Client, input *ec2.DescribeSpotFleetInstancesInput, fn func(*ec2.DescribeSpotFleetInstancesOutput, bool) bool) error {
 	for {
 		output, err := conn.DescribeSpotFleetInstances(ctx, input)
@@ -57,3 +73,19 @@ func describeVPCEndpointServicesPages(ctx context.Context, conn *ec2.Client, inp
 	}
 	return nil
 }
+func getIPAMDiscoveredPublicAddressesPages(ctx context.Context, conn *ec2.Client, input *ec2.GetIpamDiscoveredPublicAddressesInput, fn func(*ec2.GetIpamDiscoveredPublicAddressesOutput, bool) bool) error {
+	for {
+		output, err := conn.GetIpamDiscoveredPublicAddresses(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
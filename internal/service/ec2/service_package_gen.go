@@ -35,6 +35,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newEBSFastSnapshotRestoreResource,
 			Name:    "EBS Fast Snapshot Restore",
 		},
+		{
+			Factory: newEIPAddressTransferResource,
+			Name:    "EIP Address Transfer",
+		},
 		{
 			Factory: newEIPDomainNameResource,
 			Name:    "EIP Domain Name",
@@ -45,6 +49,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				ARNAttribute: names.AttrARN,
+			},
 		},
 		{
 			Factory: newInstanceMetadataDefaultsResource,
@@ -56,6 +63,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				ARNAttribute: names.AttrARN,
+			},
 		},
 		{
 			Factory: newResourceEndpointPrivateDNS,
@@ -71,6 +81,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				ARNAttribute: names.AttrARN,
+			},
 		},
 		{
 			Factory: newSecurityGroupIngressRuleResource,
@@ -78,6 +91,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
 			},
+			Identity: &types.ServicePackageResourceIdentity{
+				ARNAttribute: names.AttrARN,
+			},
 		},
 	}
 }
@@ -157,6 +173,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceCoIPPools,
 			TypeName: "aws_ec2_coip_pools",
 		},
+		{
+			Factory:  dataSourceFleetInstances,
+			TypeName: "aws_ec2_fleet_instances",
+			Name:     "Fleet Instances",
+		},
 		{
 			Factory:  dataSourceHost,
 			TypeName: "aws_ec2_host",
@@ -452,6 +473,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceVPCEndpointService,
 			TypeName: "aws_vpc_endpoint_service",
 		},
+		{
+			Factory:  dataSourceIPAMDiscoveredPublicAddresses,
+			TypeName: "aws_vpc_ipam_discovered_public_addresses",
+			Name:     "IPAM Discovered Public Addresses",
+		},
 		{
 			Factory:  dataSourceIPAMPool,
 			TypeName: "aws_vpc_ipam_pool",
@@ -485,6 +511,16 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Factory:  DataSourceVPCs,
 			TypeName: "aws_vpcs",
 		},
+		{
+			Factory:  dataSourceVPNConnectionDeviceSampleConfiguration,
+			TypeName: "aws_vpn_connection_device_sample_configuration",
+			Name:     "VPN Connection Device Sample Configuration",
+		},
+		{
+			Factory:  dataSourceVPNConnectionDeviceTypes,
+			TypeName: "aws_vpn_connection_device_types",
+			Name:     "VPN Connection Device Types",
+		},
 		{
 			Factory:  dataSourceVPNGateway,
 			TypeName: "aws_vpn_gateway",
@@ -599,6 +635,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  resourceEBSSnapshotBlockPublicAccess,
+			TypeName: "aws_ebs_snapshot_block_public_access",
+			Name:     "EBS Snapshot Block Public Access",
+		},
 		{
 			Factory:  resourceEBSSnapshotCopy,
 			TypeName: "aws_ebs_snapshot_copy",
@@ -628,6 +669,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_ec2_availability_zone_group",
 			Name:     "Availability Zone Group",
 		},
+		{
+			Factory:  resourceBYOIPCIDRAdvertisement,
+			TypeName: "aws_ec2_byoip_cidr_advertisement",
+			Name:     "BYOIP CIDR Advertisement",
+		},
 		{
 			Factory:  resourceCapacityReservation,
 			TypeName: "aws_ec2_capacity_reservation",
@@ -760,6 +806,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_ec2_traffic_mirror_filter_rule",
 			Name:     "Traffic Mirror Filter Rule",
 		},
+		{
+			Factory:  resourceTrafficMirrorFilterRules,
+			TypeName: "aws_ec2_traffic_mirror_filter_rules",
+			Name:     "Traffic Mirror Filter Rules",
+		},
 		{
 			Factory:  resourceTrafficMirrorSession,
 			TypeName: "aws_ec2_traffic_mirror_session",
@@ -1282,6 +1333,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceVPCPeeringConnectionOptions,
 			TypeName: "aws_vpc_peering_connection_options",
 		},
+		{
+			Factory:  resourceSecurityGroupRules,
+			TypeName: "aws_vpc_security_group_rules",
+			Name:     "Security Group Rules",
+		},
 		{
 			Factory:  resourceVPNConnection,
 			TypeName: "aws_vpn_connection",
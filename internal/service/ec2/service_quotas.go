@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ec2_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// Service Quotas checked when planning resources.
+// See https://docs.aws.amazon.com/vpc/latest/userguide/amazon-vpc-limits.html.
+const (
+	serviceQuotaServiceCodeEC2 = "ec2"
+	serviceQuotaServiceCodeVPC = "vpc"
+
+	serviceQuotaCodeEIPs                  = "L-0263D0A3" // EC2-VPC Elastic IPs
+	serviceQuotaCodeRulesPerSecurityGroup = "L-0EA8095F" // Inbound or outbound rules per security group
+	serviceQuotaCodeVPCsPerRegion         = "L-F678F1CE" // VPCs per Region
+)
+
+// serviceQuotaCustomizeDiff returns a CustomizeDiffFunc that counts a new resource against the specified Service Quota.
+func serviceQuotaCustomizeDiff(serviceCode, quotaCode string, current func(context.Context, *conns.AWSClient) (int, error)) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+		// Only resource creation is counted.
+		if diff.Id() != "" {
+			return nil
+		}
+
+		client := meta.(*conns.AWSClient)
+
+		return serviceQuotaDiagnosticsError(client.CheckServiceQuota(ctx, serviceCode, quotaCode, "", func(ctx context.Context) (int, error) {
+			return current(ctx, client)
+		}))
+	}
+}
+
+// serviceQuotaDiagnosticsError returns an error for each diagnostic from a Service Quota check.
+// CustomizeDiff can't return warnings, so a plan that would exceed a quota fails for SDKv2 resources regardless of check_service_quotas_mode.
+func serviceQuotaDiagnosticsError(diags diag.Diagnostics) error {
+	var errs []error
+
+	for _, d := range diags {
+		errs = append(errs, sdkdiag.DiagnosticError(d))
+	}
+
+	return errors.Join(errs...)
+}
+
+func currentVPCsServiceQuotaUsage(ctx context.Context, client *conns.AWSClient) (int, error) {
+	output, err := findVPCs(ctx, client.EC2Client(ctx), &ec2_sdkv2.DescribeVpcsInput{})
+
+	if err != nil {
+		return 0, err
+	}
+
+	return len(output), nil
+}
+
+func currentEIPsServiceQuotaUsage(ctx context.Context, client *conns.AWSClient) (int, error) {
+	input := &ec2_sdkv2.DescribeAddressesInput{
+		Filters: newAttributeFilterListV2(map[string]string{
+			"domain": string(awstypes.DomainTypeVpc),
+		}),
+	}
+
+	output, err := findEIPs(ctx, client.EC2Client(ctx), input)
+
+	if err != nil {
+		return 0, err
+	}
+
+	return len(output), nil
+}
+
+// checkSecurityGroupRulesServiceQuota counts a new rule against the rules per security group Service Quota.
+// The quota applies separately to inbound and outbound rules of each security group.
+func checkSecurityGroupRulesServiceQuota(ctx context.Context, client *conns.AWSClient, securityGroupID string, isEgress bool) diag.Diagnostics {
+	scope := securityGroupID + "/ingress"
+	if isEgress {
+		scope = securityGroupID + "/egress"
+	}
+
+	return client.CheckServiceQuota(ctx, serviceQuotaServiceCodeVPC, serviceQuotaCodeRulesPerSecurityGroup, scope, func(ctx context.Context) (int, error) {
+		output, err := FindSecurityGroupRulesBySecurityGroupID(ctx, client.EC2Conn(ctx), securityGroupID)
+
+		if err != nil {
+			return 0, err
+		}
+
+		return len(tfslices.Filter(output, func(v *ec2.SecurityGroupRule) bool {
+			return aws.ToBool(v.IsEgress) == isEgress
+		})), nil
+	})
+}
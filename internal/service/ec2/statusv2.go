@@ -1229,3 +1229,19 @@ func statusNetworkInsightsAnalysis(ctx context.Context, conn *ec2.Client, id str
 		return output, string(output.Status), nil
 	}
 }
+
+func statusBYOIPCIDR(ctx context.Context, conn *ec2.Client, cidrBlock string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findBYOIPCIDRByCIDR(ctx, conn, cidrBlock)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
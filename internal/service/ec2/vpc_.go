@@ -55,6 +55,7 @@ func ResourceVPC() *schema.Resource {
 		CustomizeDiff: customdiff.All(
 			resourceVPCCustomizeDiff,
 			verify.SetTagsDiff,
+			serviceQuotaCustomizeDiff(serviceQuotaServiceCodeVPC, serviceQuotaCodeVPCsPerRegion, currentVPCsServiceQuotaUsage),
 		),
 
 		SchemaVersion: 1,
@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"regexp"
@@ -16,7 +17,9 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -213,7 +216,10 @@ func resourceVPCEndpoint() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			resourceVPCEndpointCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -436,7 +442,17 @@ func resourceVPCEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 
 		if d.HasChange(names.AttrIPAddressType) {
-			input.IpAddressType = awstypes.IpAddressType(d.Get(names.AttrIPAddressType).(string))
+			ipAddressType := awstypes.IpAddressType(d.Get(names.AttrIPAddressType).(string))
+			input.IpAddressType = ipAddressType
+
+			// The DNS record IP type must be compatible with the new IP address type.
+			// If it isn't configured, move it along with the IP address type.
+			if !vpcEndpointDNSRecordIPTypeConfigured(d.GetRawConfig()) {
+				if input.DnsOptions == nil {
+					input.DnsOptions = &awstypes.DnsOptionsSpecification{}
+				}
+				input.DnsOptions.DnsRecordIpType = awstypes.DnsRecordIpType(ipAddressType)
+			}
 		}
 
 		privateDNSEnabled := d.Get("private_dns_enabled").(bool)
@@ -486,6 +502,49 @@ func resourceVPCEndpointUpdate(ctx context.Context, d *schema.ResourceData, meta
 	return append(diags, resourceVPCEndpointRead(ctx, d, meta)...)
 }
 
+func resourceVPCEndpointCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// The service name and private DNS setting can't be validated until they're known.
+	if v, ok := diff.GetOk("dns_options.0.private_dns_only_for_inbound_resolver_endpoint"); ok && v.(bool) && diff.NewValueKnown(names.AttrServiceName) && diff.NewValueKnown("private_dns_enabled") {
+		if serviceName := diff.Get(names.AttrServiceName).(string); !isAmazonS3VPCEndpoint(serviceName) {
+			return fmt.Errorf("dns_options.0.private_dns_only_for_inbound_resolver_endpoint is only supported for Amazon S3 interface endpoints, not %s", serviceName)
+		}
+
+		if !diff.Get("private_dns_enabled").(bool) {
+			return errors.New("dns_options.0.private_dns_only_for_inbound_resolver_endpoint requires private_dns_enabled to be true")
+		}
+	}
+
+	// An in-place change of IP address type also changes an unconfigured DNS record IP type.
+	if diff.Id() != "" && diff.HasChange(names.AttrIPAddressType) && !vpcEndpointDNSRecordIPTypeConfigured(diff.GetRawConfig()) {
+		if err := diff.SetNewComputed("dns_options"); err != nil {
+			return fmt.Errorf("setting dns_options to computed: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// vpcEndpointDNSRecordIPTypeConfigured returns whether dns_options.0.dns_record_ip_type is set in configuration.
+func vpcEndpointDNSRecordIPTypeConfigured(rawConfig cty.Value) bool {
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return false
+	}
+
+	v := rawConfig.GetAttr("dns_options")
+
+	if !v.IsKnown() || v.IsNull() || v.LengthInt() == 0 {
+		return false
+	}
+
+	v = v.Index(cty.NumberIntVal(0))
+
+	if !v.IsKnown() || v.IsNull() {
+		return false
+	}
+
+	return !v.GetAttr("dns_record_ip_type").IsNull()
+}
+
 func resourceVPCEndpointDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).EC2Client(ctx)
@@ -10,14 +10,17 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -104,7 +107,25 @@ func (r *resourceEndpointServicePrivateDNSVerification) Create(ctx context.Conte
 
 	if plan.WaitForVerification.ValueBool() {
 		createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
-		_, err := waitVPCEndpointServicePrivateDNSNameVerified(ctx, conn, plan.ServiceID.ValueString(), createTimeout)
+		_, err := tfresource.RetryWhen(ctx, createTimeout,
+			func() (interface{}, error) {
+				return waitVPCEndpointServicePrivateDNSNameVerified(ctx, conn, plan.ServiceID.ValueString(), createTimeout)
+			},
+			func(err error) (bool, error) {
+				// Verification fails if the TXT record hasn't propagated yet, e.g. when the
+				// Route 53 record is created in the same apply. Start verification again.
+				var unexpectedStateErr *retry.UnexpectedStateError
+				if errors.As(err, &unexpectedStateErr) && unexpectedStateErr.State == string(awstypes.DnsNameStateFailed) {
+					if _, err := conn.StartVpcEndpointServicePrivateDnsVerification(ctx, in); err != nil {
+						return false, err
+					}
+
+					return true, err
+				}
+
+				return false, err
+			},
+		)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				create.ProblemStandardMessage(names.EC2, create.ErrActionWaitingForCreation, ResNameEndpointServicePrivateDNSVerification, plan.ServiceID.String(), err),
@@ -317,6 +317,8 @@ func TestAccVPCEndpointService_privateDNSName(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "private_dns_name", domainName2),
 					resource.TestCheckResourceAttr(resourceName, "private_dns_name_configuration.#", acctest.Ct1),
 					resource.TestCheckResourceAttr(resourceName, "private_dns_name_configuration.0.type", "TXT"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_dns_name_configuration.0.name"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_dns_name_configuration.0.value"),
 				),
 			},
 		},
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -14,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -162,7 +164,10 @@ func ResourceFlowLog() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			resourceFlowLogCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -356,6 +361,80 @@ func resourceLogFlowDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
+var (
+	// Fields available in flow logs for VPCs, subnets and network interfaces, up to version 8.
+	// See https://docs.aws.amazon.com/vpc/latest/userguide/flow-log-records.html#flow-logs-fields.
+	flowLogFieldsVPC = []string{
+		// Version 2.
+		"version", "account-id", "interface-id", "srcaddr", "dstaddr", "srcport", "dstport", "protocol", "packets", "bytes", "start", "end", "action", "log-status",
+		// Version 3.
+		"vpc-id", "subnet-id", "instance-id", "tcp-flags", "type", "pkt-srcaddr", "pkt-dstaddr",
+		// Version 4.
+		"region", "az-id", "sublocation-type", "sublocation-id",
+		// Version 5.
+		"pkt-src-aws-service", "pkt-dst-aws-service", "flow-direction", "traffic-path",
+		// Version 7.
+		"ecs-cluster-arn", "ecs-cluster-name", "ecs-container-instance-arn", "ecs-container-instance-id", "ecs-container-id", "ecs-second-container-id", "ecs-service-name", "ecs-task-definition-arn", "ecs-task-arn", "ecs-task-id",
+		// Version 8.
+		"reject-reason",
+	}
+	// Fields available in flow logs for transit gateways and transit gateway attachments.
+	// See https://docs.aws.amazon.com/vpc/latest/tgw/tgw-flow-logs.html#flow-log-records.
+	flowLogFieldsTransitGateway = []string{
+		"version", "resource-type", "account-id", "tgw-id", "tgw-attachment-id", "tgw-src-vpc-account-id", "tgw-dst-vpc-account-id", "tgw-src-vpc-id", "tgw-dst-vpc-id", "tgw-src-subnet-id", "tgw-dst-subnet-id", "tgw-src-eni", "tgw-dst-eni", "tgw-src-az-id", "tgw-dst-az-id", "tgw-pair-attachment-id",
+		"srcaddr", "dstaddr", "srcport", "dstport", "protocol", "packets", "bytes", "start", "end", "log-status", "type",
+		"packets-lost-no-route", "packets-lost-blackhole", "packets-lost-mtu-exceeded", "packets-lost-ttl-expired", "tcp-flags", "region", "flow-direction", "pkt-src-aws-service", "pkt-dst-aws-service",
+	}
+)
+
+func resourceFlowLogCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" {
+		return nil
+	}
+
+	rawConfig := diff.GetRawConfig()
+	transitGateway := !rawConfig.GetAttr(names.AttrTransitGatewayID).IsNull() || !rawConfig.GetAttr(names.AttrTransitGatewayAttachmentID).IsNull()
+
+	if transitGateway {
+		if v := rawConfig.GetAttr("max_aggregation_interval"); v.IsKnown() && diff.Get("max_aggregation_interval").(int) != 60 {
+			return fmt.Errorf("max_aggregation_interval must be 60 for transit gateway flow logs")
+		}
+	}
+
+	if !diff.NewValueKnown("log_format") {
+		return nil
+	}
+
+	logFormat := diff.Get("log_format").(string)
+	if logFormat == "" {
+		return nil
+	}
+
+	validFields, resourceType := flowLogFieldsVPC, "VPC, subnet or network interface"
+	if transitGateway {
+		validFields, resourceType = flowLogFieldsTransitGateway, "transit gateway"
+	}
+
+	for _, field := range flowLogFormatFields(logFormat) {
+		if !slices.Contains(validFields, field) {
+			return fmt.Errorf("log_format field %q is not supported in %s flow logs", field, resourceType)
+		}
+	}
+
+	return nil
+}
+
+// flowLogFormatFields returns the field names referenced in a flow log format, e.g. "${version} ${srcaddr}".
+func flowLogFormatFields(logFormat string) []string {
+	var fields []string
+
+	for _, v := range strings.Fields(logFormat) {
+		fields = append(fields, strings.TrimSuffix(strings.TrimPrefix(v, "${"), "}"))
+	}
+
+	return fields
+}
+
 func expandDestinationOptionsRequest(tfMap map[string]interface{}) *ec2.DestinationOptionsRequest {
 	if tfMap == nil {
 		return nil
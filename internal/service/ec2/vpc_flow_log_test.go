@@ -89,6 +89,24 @@ func TestAccVPCFlowLog_logFormat(t *testing.T) {
 	})
 }
 
+func TestAccVPCFlowLog_LogFormat_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowLogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCFlowLogConfig_formatInvalid(rName),
+				ExpectError: regexache.MustCompile(`log_format field "tgw-id" is not supported in VPC, subnet or network interface flow logs`),
+			},
+		},
+	})
+}
+
 func TestAccVPCFlowLog_subnetID(t *testing.T) {
 	ctx := acctest.Context(t)
 	var flowLog ec2.FlowLog
@@ -163,6 +181,61 @@ func TestAccVPCFlowLog_transitGatewayID(t *testing.T) {
 	})
 }
 
+func TestAccVPCFlowLog_TransitGatewayID_destinationOptions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var flowLog ec2.FlowLog
+	resourceName := "aws_flow_log.test"
+	s3ResourceName := "aws_s3_bucket.test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	logFormat := "${version} ${tgw-id} ${tgw-attachment-id} ${srcaddr} ${dstaddr} ${packets-lost-no-route}"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowLogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCFlowLogConfig_transitGatewayIDDestinationOptions(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFlowLogExists(ctx, resourceName, &flowLog),
+					resource.TestCheckResourceAttrPair(resourceName, "log_destination", s3ResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "log_destination_type", "s3"),
+					resource.TestCheckResourceAttr(resourceName, "log_format", logFormat),
+					resource.TestCheckResourceAttr(resourceName, "destination_options.0.file_format", "parquet"),
+					resource.TestCheckResourceAttr(resourceName, "destination_options.0.hive_compatible_partitions", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "destination_options.0.per_hour_partition", acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTransitGatewayID, transitGatewayResourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCFlowLog_TransitGatewayID_invalidLogFormat(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFlowLogDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCFlowLogConfig_transitGatewayIDInvalidLogFormat(rName),
+				ExpectError: regexache.MustCompile(`log_format field "interface-id" is not supported in transit gateway flow logs`),
+			},
+		},
+	})
+}
+
 func TestAccVPCFlowLog_transitGatewayAttachmentID(t *testing.T) {
 	ctx := acctest.Context(t)
 	var flowLog ec2.FlowLog
@@ -872,6 +945,27 @@ resource "aws_flow_log" "test" {
 `, rName))
 }
 
+func testAccVPCFlowLogConfig_formatInvalid(rName string) string {
+	return acctest.ConfigCompose(testAccFlowLogConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_flow_log" "test" {
+  log_destination      = aws_s3_bucket.test.arn
+  log_destination_type = "s3"
+  log_format           = "$${version} $${tgw-id}"
+  traffic_type         = "ALL"
+  vpc_id               = aws_vpc.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccVPCFlowLogConfig_subnetID(rName string) string {
 	return acctest.ConfigCompose(testAccFlowLogConfig_base(rName), fmt.Sprintf(`
 data "aws_partition" "current" {}
@@ -1151,6 +1245,66 @@ resource "aws_flow_log" "test" {
 `, rName))
 }
 
+func testAccVPCFlowLogConfig_transitGatewayIDDestinationOptions(rName string) string {
+	return acctest.ConfigCompose(testAccFlowLogConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_flow_log" "test" {
+  log_destination          = aws_s3_bucket.test.arn
+  log_destination_type     = "s3"
+  log_format               = "$${version} $${tgw-id} $${tgw-attachment-id} $${srcaddr} $${dstaddr} $${packets-lost-no-route}"
+  max_aggregation_interval = 60
+  transit_gateway_id       = aws_ec2_transit_gateway.test.id
+
+  destination_options {
+    file_format                = "parquet"
+    hive_compatible_partitions = true
+    per_hour_partition         = true
+  }
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
+func testAccVPCFlowLogConfig_transitGatewayIDInvalidLogFormat(rName string) string {
+	return acctest.ConfigCompose(testAccFlowLogConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_flow_log" "test" {
+  log_destination          = aws_s3_bucket.test.arn
+  log_destination_type     = "s3"
+  log_format               = "$${version} $${interface-id} $${srcaddr}"
+  max_aggregation_interval = 60
+  transit_gateway_id       = aws_ec2_transit_gateway.test.id
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName))
+}
+
 func testAccVPCFlowLogConfig_transitGatewayAttachmentID(rName string) string {
 	return acctest.ConfigCompose(testAccFlowLogConfig_base(rName), fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
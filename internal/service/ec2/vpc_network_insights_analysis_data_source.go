@@ -9,6 +9,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -60,8 +61,14 @@ func dataSourceNetworkInsightsAnalysis() *schema.Resource {
 					Optional: true,
 					Computed: true,
 				},
+				names.AttrMostRecent: {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
 				"network_insights_path_id": {
 					Type:     schema.TypeString,
+					Optional: true,
 					Computed: true,
 				},
 				"path_found": {
@@ -101,6 +108,10 @@ func dataSourceNetworkInsightsAnalysisRead(ctx context.Context, d *schema.Resour
 		input.NetworkInsightsAnalysisIds = []string{v.(string)}
 	}
 
+	if v, ok := d.GetOk("network_insights_path_id"); ok {
+		input.NetworkInsightsPathId = aws.String(v.(string))
+	}
+
 	input.Filters = append(input.Filters, newCustomFilterListV2(
 		d.Get(names.AttrFilter).(*schema.Set),
 	)...)
@@ -110,7 +121,14 @@ func dataSourceNetworkInsightsAnalysisRead(ctx context.Context, d *schema.Resour
 		input.Filters = nil
 	}
 
-	output, err := findNetworkInsightsAnalysis(ctx, conn, input)
+	var output *awstypes.NetworkInsightsAnalysis
+	var err error
+
+	if d.Get(names.AttrMostRecent).(bool) {
+		output, err = findMostRecentNetworkInsightsAnalysis(ctx, conn, input)
+	} else {
+		output, err = findNetworkInsightsAnalysis(ctx, conn, input)
+	}
 
 	if err != nil {
 		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("EC2 Network Insights Analysis", err))
@@ -47,6 +47,31 @@ func TestAccVPCNetworkInsightsAnalysisDataSource_basic(t *testing.T) {
 	})
 }
 
+func TestAccVPCNetworkInsightsAnalysisDataSource_mostRecent(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_network_insights_analysis.test2"
+	datasourceName := "data.aws_ec2_network_insights_analysis.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCNetworkInsightsAnalysisDataSourceConfig_mostRecent(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(datasourceName, names.AttrMostRecent, acctest.CtTrue),
+					resource.TestCheckResourceAttrPair(datasourceName, "network_insights_analysis_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrPair(datasourceName, "network_insights_path_id", resourceName, "network_insights_path_id"),
+					resource.TestCheckResourceAttrPair(datasourceName, "path_found", resourceName, "path_found"),
+					resource.TestCheckResourceAttrPair(datasourceName, "start_date", resourceName, "start_date"),
+				),
+			},
+		},
+	})
+}
+
 func testAccVPCNetworkInsightsAnalysisDataSourceConfig_basic(rName string) string {
 	return acctest.ConfigCompose(testAccVPCNetworkInsightsAnalysisConfig_base(rName), fmt.Sprintf(`
 resource "aws_ec2_network_insights_analysis" "test" {
@@ -63,3 +88,34 @@ data "aws_ec2_network_insights_analysis" "test" {
 }
 `, rName))
 }
+
+func testAccVPCNetworkInsightsAnalysisDataSourceConfig_mostRecent(rName string) string {
+	return acctest.ConfigCompose(testAccVPCNetworkInsightsAnalysisConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_network_insights_analysis" "test1" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+  wait_for_completion      = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_network_insights_analysis" "test2" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+  wait_for_completion      = true
+
+  tags = {
+    Name = %[1]q
+  }
+
+  depends_on = [aws_ec2_network_insights_analysis.test1]
+}
+
+data "aws_ec2_network_insights_analysis" "test" {
+  network_insights_path_id = aws_ec2_network_insights_path.test.id
+  most_recent              = true
+
+  depends_on = [aws_ec2_network_insights_analysis.test2]
+}
+`, rName))
+}
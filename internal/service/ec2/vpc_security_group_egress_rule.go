@@ -14,6 +14,7 @@ import (
 
 // @FrameworkResource("aws_vpc_security_group_egress_rule", name="Security Group Egress Rule")
 // @Tags(identifierAttribute="id")
+// @ARNIdentity
 // @Testing(existsType="github.com/aws/aws-sdk-go/service/ec2;ec2.SecurityGroupRule")
 func newSecurityGroupEgressRuleResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &securityGroupEgressRuleResource{}
@@ -62,6 +63,10 @@ func (r *securityGroupEgressRuleResource) delete(ctx context.Context, data *secu
 	return err
 }
 
+func (r *securityGroupEgressRuleResource) isEgress() bool {
+	return true
+}
+
 func (r *securityGroupEgressRuleResource) findByID(ctx context.Context, id string) (*ec2.SecurityGroupRule, error) {
 	conn := r.Meta().EC2Conn(ctx)
 
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -31,6 +32,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/framework"
 	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -42,6 +44,7 @@ import (
 
 // @FrameworkResource("aws_vpc_security_group_ingress_rule", name="Security Group Ingress Rule")
 // @Tags(identifierAttribute="id")
+// @ARNIdentity
 // @Testing(existsType="github.com/aws/aws-sdk-go/service/ec2;ec2.SecurityGroupRule")
 func newSecurityGroupIngressRuleResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &securityGroupIngressRuleResource{}
@@ -95,6 +98,10 @@ func (r *securityGroupIngressRuleResource) delete(ctx context.Context, data *sec
 	return err
 }
 
+func (r *securityGroupIngressRuleResource) isEgress() bool {
+	return false
+}
+
 func (r *securityGroupIngressRuleResource) findByID(ctx context.Context, id string) (*ec2.SecurityGroupRule, error) {
 	conn := r.Meta().EC2Conn(ctx)
 
@@ -164,6 +171,7 @@ type securityGroupRule interface {
 	create(context.Context, *securityGroupRuleResourceModel) (string, error)
 	delete(context.Context, *securityGroupRuleResourceModel) error
 	findByID(context.Context, string) (*ec2.SecurityGroupRule, error)
+	isEgress() bool
 }
 
 type securityGroupRuleResource struct {
@@ -387,6 +395,27 @@ func (r *securityGroupRuleResource) Delete(ctx context.Context, request resource
 }
 
 func (r *securityGroupRuleResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.State.Raw.IsNull() && !request.Plan.Raw.IsNull() {
+		var securityGroupID types.String
+		response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root("security_group_id"), &securityGroupID)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if !securityGroupID.IsUnknown() {
+			diags := checkSecurityGroupRulesServiceQuota(ctx, r.Meta(), securityGroupID.ValueString(), r.securityGroupRule.isEgress())
+			for _, d := range sdkdiag.Warnings(diags) {
+				response.Diagnostics.AddAttributeWarning(path.Root("security_group_id"), d.Summary, d.Detail)
+			}
+			for _, d := range sdkdiag.Errors(diags) {
+				response.Diagnostics.AddAttributeError(path.Root("security_group_id"), d.Summary, d.Detail)
+			}
+			if response.Diagnostics.HasError() {
+				return
+			}
+		}
+	}
+
 	if !request.State.Raw.IsNull() && !request.Plan.Raw.IsNull() {
 		var old, new securityGroupRuleResourceModel
 		response.Diagnostics.Append(request.State.Get(ctx, &old)...)
@@ -422,6 +451,16 @@ func (r *securityGroupRuleResource) securityGroupRuleARN(_ context.Context, id s
 	return types.StringValue(r.RegionalARN(names.EC2, fmt.Sprintf("security-group-rule/%s", id)))
 }
 
+func (r *securityGroupRuleResource) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, names.EC2, "security-group-rule/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return parts[0], nil
+}
+
 func flattenReferencedSecurityGroup(ctx context.Context, apiObject *ec2.ReferencedSecurityGroup, accountID string) types.String {
 	if apiObject == nil {
 		return types.StringNull()
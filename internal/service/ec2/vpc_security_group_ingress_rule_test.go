@@ -97,6 +97,12 @@ func TestAccVPCSecurityGroupIngressRule_basic(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccSecurityGroupRuleImportStateARNFunc(resourceName),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -906,3 +912,14 @@ resource "aws_vpc_security_group_ingress_rule" "test" {
 }
 `, rName, acctest.Region()))
 }
+
+func testAccSecurityGroupRuleImportStateARNFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return rs.Primary.Attributes[names.AttrARN], nil
+	}
+}
@@ -46,6 +46,8 @@ func ResourceSecurityGroupRule() *schema.Resource {
 			Create: schema.DefaultTimeout(5 * time.Minute),
 		},
 
+		CustomizeDiff: resourceSecurityGroupRuleCustomizeDiff,
+
 		SchemaVersion: 2,
 		MigrateState:  SecurityGroupRuleMigrateState,
 
@@ -416,6 +418,17 @@ func resourceSecurityGroupRuleDelete(ctx context.Context, d *schema.ResourceData
 	return diags
 }
 
+func resourceSecurityGroupRuleCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// Only rule creation is counted against the rules per security group Service Quota.
+	if diff.Id() != "" || !diff.NewValueKnown("security_group_id") {
+		return nil
+	}
+
+	isEgress := securityGroupRuleType(diff.Get(names.AttrType).(string)) == securityGroupRuleTypeEgress
+
+	return serviceQuotaDiagnosticsError(checkSecurityGroupRulesServiceQuota(ctx, meta.(*conns.AWSClient), diff.Get("security_group_id").(string), isEgress))
+}
+
 func resourceSecurityGroupRuleImport(_ context.Context, d *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
 	invalidIDError := func(msg string) error {
 		return fmt.Errorf("unexpected format for ID (%q), expected SECURITYGROUPID_TYPE_PROTOCOL_FROMPORT_TOPORT_SOURCE[_SOURCE]*: %s", d.Id(), msg)
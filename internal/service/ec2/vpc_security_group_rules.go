@@ -0,0 +1,561 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	securityGroupRulesDirectionEgress  = "egress"
+	securityGroupRulesDirectionIngress = "ingress"
+)
+
+// @SDKResource("aws_vpc_security_group_rules", name="Security Group Rules")
+func resourceSecurityGroupRules() *schema.Resource {
+	securityGroupRulesRuleSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"cidr_ipv4": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: verify.ValidIPv4CIDRNetworkAddress,
+					},
+					"cidr_ipv6": {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: verify.ValidIPv6CIDRNetworkAddress,
+					},
+					names.AttrDescription: {
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validation.StringLenBetween(0, 255),
+					},
+					"from_port": {
+						Type:             schema.TypeInt,
+						Optional:         true,
+						ValidateFunc:     validation.IntBetween(-1, 65535),
+						DiffSuppressFunc: securityGroupRulesPortDiffSuppress,
+					},
+					"ip_protocol": {
+						Type:     schema.TypeString,
+						Required: true,
+						DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+							return protocolForValue(old) == protocolForValue(new)
+						},
+					},
+					"prefix_list_id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"referenced_security_group_id": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+					"security_group_rule_id": {
+						Type:     schema.TypeString,
+						Computed: true,
+					},
+					"to_port": {
+						Type:             schema.TypeInt,
+						Optional:         true,
+						ValidateFunc:     validation.IntBetween(-1, 65535),
+						DiffSuppressFunc: securityGroupRulesPortDiffSuppress,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSecurityGroupRulesCreate,
+		ReadWithoutTimeout:   resourceSecurityGroupRulesRead,
+		UpdateWithoutTimeout: resourceSecurityGroupRulesUpdate,
+		DeleteWithoutTimeout: resourceSecurityGroupRulesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceSecurityGroupRulesCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			securityGroupRulesDirectionEgress:  securityGroupRulesRuleSchema(),
+			securityGroupRulesDirectionIngress: securityGroupRulesRuleSchema(),
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSecurityGroupRulesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	groupID := d.Get("security_group_id").(string)
+
+	if err := syncSecurityGroupRules(ctx, conn, groupID, meta.(*conns.AWSClient).AccountID, d.Get(securityGroupRulesDirectionIngress).([]interface{}), d.Get(securityGroupRulesDirectionEgress).([]interface{})); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating VPC Security Group (%s) Rules: %s", groupID, err)
+	}
+
+	d.SetId(groupID)
+
+	return append(diags, resourceSecurityGroupRulesRead(ctx, d, meta)...)
+}
+
+func resourceSecurityGroupRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	group, err := FindSecurityGroupByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Security Group %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Security Group (%s) Rules: %s", d.Id(), err)
+	}
+
+	accountID := meta.(*conns.AWSClient).AccountID
+	ingress, egress, err := findSecurityGroupRulesByDirection(ctx, conn, d.Id(), accountID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Security Group (%s) Rules: %s", d.Id(), err)
+	}
+
+	if err := d.Set(securityGroupRulesDirectionEgress, orderSecurityGroupRules(egress, d.Get(securityGroupRulesDirectionEgress).([]interface{}), accountID)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting egress: %s", err)
+	}
+	if err := d.Set(securityGroupRulesDirectionIngress, orderSecurityGroupRules(ingress, d.Get(securityGroupRulesDirectionIngress).([]interface{}), accountID)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting ingress: %s", err)
+	}
+	d.Set("security_group_id", group.GroupId)
+
+	return diags
+}
+
+func resourceSecurityGroupRulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	if d.HasChanges(securityGroupRulesDirectionEgress, securityGroupRulesDirectionIngress) {
+		if err := syncSecurityGroupRules(ctx, conn, d.Id(), meta.(*conns.AWSClient).AccountID, d.Get(securityGroupRulesDirectionIngress).([]interface{}), d.Get(securityGroupRulesDirectionEgress).([]interface{})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating VPC Security Group (%s) Rules: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSecurityGroupRulesRead(ctx, d, meta)...)
+}
+
+func resourceSecurityGroupRulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	log.Printf("[DEBUG] Deleting VPC Security Group Rules: %s", d.Id())
+	err := syncSecurityGroupRules(ctx, conn, d.Id(), meta.(*conns.AWSClient).AccountID, nil, nil)
+
+	if tfresource.NotFound(err) || tfawserr.ErrCodeEquals(err, errCodeInvalidGroupNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting VPC Security Group (%s) Rules: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// securityGroupRulesPortDiffSuppress suppresses port differences for rules that allow all protocols,
+// for which AWS always reports a port range of -1 to -1.
+func securityGroupRulesPortDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	prefix := k[:strings.LastIndex(k, ".")+1]
+
+	return protocolForValue(d.Get(prefix+"ip_protocol").(string)) == "-1"
+}
+
+// findSecurityGroupRulesByDirection returns the flattened ingress and egress rules of the specified security group.
+func findSecurityGroupRulesByDirection(ctx context.Context, conn *ec2.EC2, groupID, accountID string) ([]interface{}, []interface{}, error) {
+	rules, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, groupID)
+
+	if tfresource.NotFound(err) {
+		return nil, nil, nil
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ingress, egress []interface{}
+
+	for _, rule := range rules {
+		if aws.BoolValue(rule.IsEgress) {
+			egress = append(egress, flattenSecurityGroupRulesRule(rule, accountID))
+		} else {
+			ingress = append(ingress, flattenSecurityGroupRulesRule(rule, accountID))
+		}
+	}
+
+	return ingress, egress, nil
+}
+
+// syncSecurityGroupRules reconciles the rules of the specified security group with the configured rules.
+// Rules are matched on all of their arguments except the description. Configured rules without an existing match
+// are authorized before existing rules without a configured match are revoked, so that traffic allowed by both
+// the old and new rules isn't interrupted. Matched rules whose description differs are updated in place.
+func syncSecurityGroupRules(ctx context.Context, conn *ec2.EC2, groupID, accountID string, ingressList, egressList []interface{}) error {
+	ingress, egress, err := findSecurityGroupRulesByDirection(ctx, conn, groupID, accountID)
+
+	if err != nil {
+		return err
+	}
+
+	for _, v := range []struct {
+		direction string
+		have      []interface{}
+		want      []interface{}
+	}{
+		{securityGroupRulesDirectionIngress, ingress, ingressList},
+		{securityGroupRulesDirectionEgress, egress, egressList},
+	} {
+		want := make(map[string]map[string]interface{})
+		for _, tfMapRaw := range v.want {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			key := securityGroupRulesRuleKey(tfMap, accountID)
+			if _, ok := want[key]; ok {
+				return fmt.Errorf("duplicate %s rule (%s)", v.direction, key)
+			}
+			want[key] = tfMap
+		}
+
+		var revoke []string
+		for _, tfMapRaw := range v.have {
+			tfMap := tfMapRaw.(map[string]interface{})
+			key := securityGroupRulesRuleKey(tfMap, accountID)
+			ruleID := tfMap["security_group_rule_id"].(string)
+
+			if tfMapWant, ok := want[key]; ok {
+				delete(want, key)
+
+				if tfMapWant[names.AttrDescription].(string) != tfMap[names.AttrDescription].(string) {
+					if err := updateSecurityGroupRuleDescription(ctx, conn, groupID, expandSecurityGroupRulesIPPermission(tfMapWant), v.direction); err != nil {
+						return fmt.Errorf("updating %s rule (%s) description: %w", v.direction, ruleID, err)
+					}
+				}
+
+				continue
+			}
+
+			revoke = append(revoke, ruleID)
+		}
+
+		for _, tfMapRaw := range v.want {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			key := securityGroupRulesRuleKey(tfMap, accountID)
+			if _, ok := want[key]; !ok {
+				continue
+			}
+
+			if err := authorizeSecurityGroupRule(ctx, conn, groupID, expandSecurityGroupRulesIPPermission(tfMap), v.direction); err != nil {
+				return fmt.Errorf("authorizing %s rule (%s): %w", v.direction, key, err)
+			}
+		}
+
+		for _, ruleID := range revoke {
+			if err := revokeSecurityGroupRule(ctx, conn, groupID, ruleID, v.direction); err != nil {
+				return fmt.Errorf("revoking %s rule (%s): %w", v.direction, ruleID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func authorizeSecurityGroupRule(ctx context.Context, conn *ec2.EC2, groupID string, ipPermission *ec2.IpPermission, direction string) error {
+	var err error
+
+	if direction == securityGroupRulesDirectionEgress {
+		_, err = conn.AuthorizeSecurityGroupEgressWithContext(ctx, &ec2.AuthorizeSecurityGroupEgressInput{
+			GroupId:       aws.String(groupID),
+			IpPermissions: []*ec2.IpPermission{ipPermission},
+		})
+	} else {
+		_, err = conn.AuthorizeSecurityGroupIngressWithContext(ctx, &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId:       aws.String(groupID),
+			IpPermissions: []*ec2.IpPermission{ipPermission},
+		})
+	}
+
+	return err
+}
+
+func updateSecurityGroupRuleDescription(ctx context.Context, conn *ec2.EC2, groupID string, ipPermission *ec2.IpPermission, direction string) error {
+	var err error
+
+	if direction == securityGroupRulesDirectionEgress {
+		_, err = conn.UpdateSecurityGroupRuleDescriptionsEgressWithContext(ctx, &ec2.UpdateSecurityGroupRuleDescriptionsEgressInput{
+			GroupId:       aws.String(groupID),
+			IpPermissions: []*ec2.IpPermission{ipPermission},
+		})
+	} else {
+		_, err = conn.UpdateSecurityGroupRuleDescriptionsIngressWithContext(ctx, &ec2.UpdateSecurityGroupRuleDescriptionsIngressInput{
+			GroupId:       aws.String(groupID),
+			IpPermissions: []*ec2.IpPermission{ipPermission},
+		})
+	}
+
+	return err
+}
+
+func revokeSecurityGroupRule(ctx context.Context, conn *ec2.EC2, groupID, ruleID, direction string) error {
+	var err error
+
+	if direction == securityGroupRulesDirectionEgress {
+		_, err = conn.RevokeSecurityGroupEgressWithContext(ctx, &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: aws.StringSlice([]string{ruleID}),
+		})
+	} else {
+		_, err = conn.RevokeSecurityGroupIngressWithContext(ctx, &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(groupID),
+			SecurityGroupRuleIds: aws.StringSlice([]string{ruleID}),
+		})
+	}
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidPermissionNotFound, errCodeInvalidSecurityGroupRuleIdNotFound) {
+		return nil
+	}
+
+	return err
+}
+
+func resourceSecurityGroupRulesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rawConfig := d.GetRawConfig()
+
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return nil
+	}
+
+	for _, direction := range []string{securityGroupRulesDirectionIngress, securityGroupRulesDirectionEgress} {
+		v := rawConfig.GetAttr(direction)
+
+		if !v.IsKnown() || v.IsNull() {
+			continue
+		}
+
+		for i, it := 0, v.ElementIterator(); it.Next(); i++ {
+			_, rule := it.Element()
+
+			if err := validateSecurityGroupRulesRule(rule); err != nil {
+				return fmt.Errorf("%s.%d: %w", direction, i, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateSecurityGroupRulesRule validates that exactly one traffic source or destination is configured.
+// Rules with an unknown source or destination are not validated.
+func validateSecurityGroupRulesRule(rule cty.Value) error {
+	if !rule.IsKnown() || rule.IsNull() {
+		return nil
+	}
+
+	var n int
+
+	for _, k := range []string{"cidr_ipv4", "cidr_ipv6", "prefix_list_id", "referenced_security_group_id"} {
+		v := rule.GetAttr(k)
+
+		if !v.IsKnown() {
+			return nil
+		}
+
+		if !v.IsNull() && v.AsString() != "" {
+			n++
+		}
+	}
+
+	if n != 1 {
+		return fmt.Errorf(`exactly one of "cidr_ipv4", "cidr_ipv6", "prefix_list_id" or "referenced_security_group_id" must be specified`)
+	}
+
+	return nil
+}
+
+// securityGroupRulesRuleKey returns a key that uniquely identifies a rule within a direction.
+// Protocols are normalized to their names and ports are ignored for rules that allow all protocols.
+// The description is not part of a rule's identity.
+func securityGroupRulesRuleKey(tfMap map[string]interface{}, accountID string) string {
+	protocol := protocolForValue(tfMap["ip_protocol"].(string))
+	fromPort, toPort := tfMap["from_port"].(int), tfMap["to_port"].(int)
+
+	if protocol == "-1" {
+		fromPort, toPort = -1, -1
+	}
+
+	// [UserID/]GroupID.
+	referencedGroupID := tfMap["referenced_security_group_id"].(string)
+	if userID, groupID, ok := strings.Cut(referencedGroupID, "/"); ok && userID == accountID {
+		referencedGroupID = groupID
+	}
+
+	return strings.Join([]string{
+		protocol,
+		fmt.Sprintf("%d-%d", fromPort, toPort),
+		tfMap["cidr_ipv4"].(string),
+		tfMap["cidr_ipv6"].(string),
+		tfMap["prefix_list_id"].(string),
+		referencedGroupID,
+	}, "/")
+}
+
+// orderSecurityGroupRules orders the rules read from the API to match the configured rule order.
+// Rules not present in configuration are appended in API order.
+func orderSecurityGroupRules(apiList, tfList []interface{}, accountID string) []interface{} {
+	position := make(map[string]int)
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		position[securityGroupRulesRuleKey(tfMap, accountID)] = i
+	}
+
+	apiList = slices.Clone(apiList)
+	slices.SortStableFunc(apiList, func(a, b interface{}) int {
+		posA, okA := position[securityGroupRulesRuleKey(a.(map[string]interface{}), accountID)]
+		posB, okB := position[securityGroupRulesRuleKey(b.(map[string]interface{}), accountID)]
+
+		switch {
+		case okA && okB:
+			return posA - posB
+		case okA:
+			return -1
+		case okB:
+			return 1
+		}
+
+		return 0
+	})
+
+	if apiList == nil {
+		return []interface{}{}
+	}
+
+	return apiList
+}
+
+func expandSecurityGroupRulesIPPermission(tfMap map[string]interface{}) *ec2.IpPermission { // nosemgrep:ci.caps5-in-func-name
+	apiObject := &ec2.IpPermission{
+		IpProtocol: aws.String(protocolForValue(tfMap["ip_protocol"].(string))),
+	}
+
+	// InvalidParameterValue: When protocol is ALL, you cannot specify from-port.
+	if aws.StringValue(apiObject.IpProtocol) != "-1" {
+		apiObject.FromPort = aws.Int64(int64(tfMap["from_port"].(int)))
+		apiObject.ToPort = aws.Int64(int64(tfMap["to_port"].(int)))
+	}
+
+	var description *string
+	if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+		description = aws.String(v)
+	}
+
+	if v, ok := tfMap["cidr_ipv4"].(string); ok && v != "" {
+		apiObject.IpRanges = []*ec2.IpRange{{
+			CidrIp:      aws.String(v),
+			Description: description,
+		}}
+	}
+
+	if v, ok := tfMap["cidr_ipv6"].(string); ok && v != "" {
+		apiObject.Ipv6Ranges = []*ec2.Ipv6Range{{
+			CidrIpv6:    aws.String(v),
+			Description: description,
+		}}
+	}
+
+	if v, ok := tfMap["prefix_list_id"].(string); ok && v != "" {
+		apiObject.PrefixListIds = []*ec2.PrefixListId{{
+			PrefixListId: aws.String(v),
+			Description:  description,
+		}}
+	}
+
+	if v, ok := tfMap["referenced_security_group_id"].(string); ok && v != "" {
+		apiObject.UserIdGroupPairs = []*ec2.UserIdGroupPair{{
+			Description: description,
+		}}
+
+		// [UserID/]GroupID.
+		if userID, groupID, ok := strings.Cut(v, "/"); ok {
+			apiObject.UserIdGroupPairs[0].GroupId = aws.String(groupID)
+			apiObject.UserIdGroupPairs[0].UserId = aws.String(userID)
+		} else {
+			apiObject.UserIdGroupPairs[0].GroupId = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenSecurityGroupRulesRule(apiObject *ec2.SecurityGroupRule, accountID string) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		"cidr_ipv4":                    aws.StringValue(apiObject.CidrIpv4),
+		"cidr_ipv6":                    aws.StringValue(apiObject.CidrIpv6),
+		names.AttrDescription:          aws.StringValue(apiObject.Description),
+		"from_port":                    int(aws.Int64Value(apiObject.FromPort)),
+		"ip_protocol":                  aws.StringValue(apiObject.IpProtocol),
+		"prefix_list_id":               aws.StringValue(apiObject.PrefixListId),
+		"referenced_security_group_id": "",
+		"security_group_rule_id":       aws.StringValue(apiObject.SecurityGroupRuleId),
+		"to_port":                      int(aws.Int64Value(apiObject.ToPort)),
+	}
+
+	if v := apiObject.ReferencedGroupInfo; v != nil {
+		if v.UserId == nil || aws.StringValue(v.UserId) == accountID {
+			tfMap["referenced_security_group_id"] = aws.StringValue(v.GroupId)
+		} else {
+			// [UserID/]GroupID.
+			tfMap["referenced_security_group_id"] = aws.StringValue(v.UserId) + "/" + aws.StringValue(v.GroupId)
+		}
+	}
+
+	return tfMap
+}
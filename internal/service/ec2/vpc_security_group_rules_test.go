@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCSecurityGroupRules_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "security_group_id", "aws_security_group.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ingress.0.cidr_ipv4", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.0.from_port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.0.ip_protocol", "tcp"),
+					resource.TestCheckResourceAttrSet(resourceName, "ingress.0.security_group_rule_id"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.0.to_port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "egress.0.cidr_ipv4", "0.0.0.0/0"),
+					resource.TestCheckResourceAttr(resourceName, "egress.0.ip_protocol", "-1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "ingress.0.description", "HTTPS"),
+					resource.TestCheckResourceAttr(resourceName, "ingress.1.from_port", "22"),
+					resource.TestCheckResourceAttrPair(resourceName, "ingress.1.referenced_security_group_id", "aws_security_group.source", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", acctest.Ct0),
+				),
+			},
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_empty(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesCount(ctx, resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "egress.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRules_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRulesCount(ctx, resourceName, 2),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceSecurityGroupRules(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroupRules_outOfBand(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_vpc_security_group_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecurityGroupRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecurityGroupRulesCount(ctx, resourceName, 2),
+					testAccCheckSecurityGroupRulesAuthorizeIngress(ctx, resourceName, "192.168.0.0/16", 8080),
+					testAccCheckSecurityGroupRulesCount(ctx, resourceName, 3),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccVPCSecurityGroupRulesConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSecurityGroupRulesCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "ingress.#", acctest.Ct1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecurityGroupRulesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_vpc_security_group_rules" {
+				continue
+			}
+
+			output, err := tfec2.FindSecurityGroupRulesBySecurityGroupID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if n := len(output); n > 0 {
+				return fmt.Errorf("VPC Security Group %s still has %d rules", rs.Primary.ID, n)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupRulesCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := tfec2.FindSecurityGroupRulesBySecurityGroupID(ctx, conn, rs.Primary.ID)
+
+		if err != nil && !tfresource.NotFound(err) {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("VPC Security Group %s has %d rules, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSecurityGroupRulesAuthorizeIngress(ctx context.Context, n, cidrBlock string, port int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		_, err := conn.AuthorizeSecurityGroupIngressWithContext(ctx, &ec2.AuthorizeSecurityGroupIngressInput{
+			GroupId: aws.String(rs.Primary.ID),
+			IpPermissions: []*ec2.IpPermission{{
+				FromPort:   aws.Int64(port),
+				IpProtocol: aws.String("tcp"),
+				IpRanges:   []*ec2.IpRange{{CidrIp: aws.String(cidrBlock)}},
+				ToPort:     aws.Int64(port),
+			}},
+		})
+
+		return err
+	}
+}
+
+func testAccVPCSecurityGroupRulesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_rules" "test" {
+  security_group_id = aws_security_group.test.id
+
+  ingress {
+    cidr_ipv4   = "10.0.0.0/8"
+    from_port   = 443
+    ip_protocol = "tcp"
+    to_port     = 443
+  }
+
+  egress {
+    cidr_ipv4   = "0.0.0.0/0"
+    ip_protocol = "-1"
+  }
+}
+`)
+}
+
+func testAccVPCSecurityGroupRulesConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), fmt.Sprintf(`
+resource "aws_security_group" "source" {
+  vpc_id = aws_vpc.test.id
+  name   = "%[1]s-source"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_vpc_security_group_rules" "test" {
+  security_group_id = aws_security_group.test.id
+
+  ingress {
+    cidr_ipv4   = "10.0.0.0/8"
+    description = "HTTPS"
+    from_port   = 443
+    ip_protocol = "6"
+    to_port     = 443
+  }
+
+  ingress {
+    from_port                    = 22
+    ip_protocol                  = "tcp"
+    referenced_security_group_id = aws_security_group.source.id
+    to_port                      = 22
+  }
+}
+`, rName))
+}
+
+func testAccVPCSecurityGroupRulesConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccVPCSecurityGroupRuleConfig_base(rName), `
+resource "aws_vpc_security_group_rules" "test" {
+  security_group_id = aws_security_group.test.id
+}
+`)
+}
@@ -0,0 +1,399 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_ec2_traffic_mirror_filter_rules", name="Traffic Mirror Filter Rules")
+func resourceTrafficMirrorFilterRules() *schema.Resource {
+	trafficMirrorPortRangeSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"from_port": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IsPortNumberOrZero,
+					},
+					"to_port": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IsPortNumberOrZero,
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTrafficMirrorFilterRulesCreate,
+		ReadWithoutTimeout:   resourceTrafficMirrorFilterRulesRead,
+		UpdateWithoutTimeout: resourceTrafficMirrorFilterRulesUpdate,
+		DeleteWithoutTimeout: resourceTrafficMirrorFilterRulesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrRule: {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"destination_cidr_block": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+						"destination_port_range": trafficMirrorPortRangeSchema(),
+						names.AttrProtocol: {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"rule_action": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.TrafficMirrorRuleAction](),
+						},
+						"rule_number": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 32766),
+						},
+						"source_cidr_block": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidCIDRNetworkAddress,
+						},
+						"source_port_range": trafficMirrorPortRangeSchema(),
+						"traffic_direction": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.TrafficDirection](),
+						},
+						"traffic_mirror_filter_rule_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"traffic_mirror_filter_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceTrafficMirrorFilterRulesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	filterID := d.Get("traffic_mirror_filter_id").(string)
+
+	if err := syncTrafficMirrorFilterRules(ctx, conn, filterID, d.Get(names.AttrRule).([]interface{})); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Traffic Mirror Filter (%s) Rules: %s", filterID, err)
+	}
+
+	d.SetId(filterID)
+
+	return append(diags, resourceTrafficMirrorFilterRulesRead(ctx, d, meta)...)
+}
+
+func resourceTrafficMirrorFilterRulesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	filter, err := findTrafficMirrorFilterByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Traffic Mirror Filter %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Traffic Mirror Filter (%s) Rules: %s", d.Id(), err)
+	}
+
+	rules := slices.Concat(filter.IngressFilterRules, filter.EgressFilterRules)
+	if err := d.Set(names.AttrRule, flattenTrafficMirrorFilterRules(orderTrafficMirrorFilterRules(rules, d.Get(names.AttrRule).([]interface{})))); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
+	}
+	d.Set("traffic_mirror_filter_id", filter.TrafficMirrorFilterId)
+
+	return diags
+}
+
+func resourceTrafficMirrorFilterRulesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	if d.HasChange(names.AttrRule) {
+		if err := syncTrafficMirrorFilterRules(ctx, conn, d.Id(), d.Get(names.AttrRule).([]interface{})); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating EC2 Traffic Mirror Filter (%s) Rules: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceTrafficMirrorFilterRulesRead(ctx, d, meta)...)
+}
+
+func resourceTrafficMirrorFilterRulesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	log.Printf("[DEBUG] Deleting EC2 Traffic Mirror Filter Rules: %s", d.Id())
+	err := syncTrafficMirrorFilterRules(ctx, conn, d.Id(), nil)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting EC2 Traffic Mirror Filter (%s) Rules: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// syncTrafficMirrorFilterRules reconciles the rules of the specified traffic mirror filter with the configured rules.
+// Rules are matched on traffic direction and rule number. Rules that no longer match their configuration are replaced.
+func syncTrafficMirrorFilterRules(ctx context.Context, conn *ec2.Client, filterID string, tfList []interface{}) error {
+	filter, err := findTrafficMirrorFilterByID(ctx, conn, filterID)
+
+	if err != nil {
+		return err
+	}
+
+	want := make(map[string]map[string]interface{})
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		key := trafficMirrorFilterRuleKey(tfMap["traffic_direction"].(string), int32(tfMap["rule_number"].(int)))
+		if _, ok := want[key]; ok {
+			return fmt.Errorf("duplicate %s rule number (%d)", tfMap["traffic_direction"].(string), tfMap["rule_number"].(int))
+		}
+		want[key] = tfMap
+	}
+
+	for _, rule := range slices.Concat(filter.IngressFilterRules, filter.EgressFilterRules) {
+		key := trafficMirrorFilterRuleKey(string(rule.TrafficDirection), aws.ToInt32(rule.RuleNumber))
+
+		if tfMap, ok := want[key]; ok && trafficMirrorFilterRuleEqual(tfMap, flattenTrafficMirrorFilterRule(rule)) {
+			delete(want, key)
+			continue
+		}
+
+		ruleID := aws.ToString(rule.TrafficMirrorFilterRuleId)
+		_, err := conn.DeleteTrafficMirrorFilterRule(ctx, &ec2.DeleteTrafficMirrorFilterRuleInput{
+			TrafficMirrorFilterRuleId: aws.String(ruleID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidTrafficMirrorFilterRuleIdNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting rule (%s): %w", ruleID, err)
+		}
+	}
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		key := trafficMirrorFilterRuleKey(tfMap["traffic_direction"].(string), int32(tfMap["rule_number"].(int)))
+		if _, ok := want[key]; !ok {
+			continue
+		}
+
+		input := expandCreateTrafficMirrorFilterRuleInput(tfMap)
+		input.TrafficMirrorFilterId = aws.String(filterID)
+
+		if _, err := conn.CreateTrafficMirrorFilterRule(ctx, input); err != nil {
+			return fmt.Errorf("creating %s rule (%d): %w", tfMap["traffic_direction"].(string), tfMap["rule_number"].(int), err)
+		}
+	}
+
+	return nil
+}
+
+func trafficMirrorFilterRuleKey(direction string, ruleNumber int32) string {
+	return fmt.Sprintf("%s/%d", direction, ruleNumber)
+}
+
+// trafficMirrorFilterRuleEqual returns whether the configured rule matches the rule read from the API.
+func trafficMirrorFilterRuleEqual(tfMap, apiMap map[string]interface{}) bool {
+	for _, k := range []string{names.AttrDescription, "destination_cidr_block", "destination_port_range", names.AttrProtocol, "rule_action", "source_cidr_block", "source_port_range"} {
+		if !reflect.DeepEqual(normalizeTrafficMirrorFilterRuleValue(tfMap[k]), normalizeTrafficMirrorFilterRuleValue(apiMap[k])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func normalizeTrafficMirrorFilterRuleValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case []interface{}:
+		if len(v) == 0 || v[0] == nil {
+			return nil
+		}
+		tfMap := v[0].(map[string]interface{})
+		// A port range of 0-0 is equivalent to no port range.
+		if tfMap["from_port"] == 0 && tfMap["to_port"] == 0 {
+			return nil
+		}
+		return tfMap
+	default:
+		return v
+	}
+}
+
+// orderTrafficMirrorFilterRules orders the rules read from the API to match the configured rule order.
+// Rules not present in configuration are appended in direction and rule number order.
+func orderTrafficMirrorFilterRules(apiObjects []awstypes.TrafficMirrorFilterRule, tfList []interface{}) []awstypes.TrafficMirrorFilterRule {
+	position := make(map[string]int)
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		position[trafficMirrorFilterRuleKey(tfMap["traffic_direction"].(string), int32(tfMap["rule_number"].(int)))] = i
+	}
+
+	apiObjects = slices.Clone(apiObjects)
+	slices.SortStableFunc(apiObjects, func(a, b awstypes.TrafficMirrorFilterRule) int {
+		posA, okA := position[trafficMirrorFilterRuleKey(string(a.TrafficDirection), aws.ToInt32(a.RuleNumber))]
+		posB, okB := position[trafficMirrorFilterRuleKey(string(b.TrafficDirection), aws.ToInt32(b.RuleNumber))]
+
+		switch {
+		case okA && okB:
+			return posA - posB
+		case okA:
+			return -1
+		case okB:
+			return 1
+		}
+
+		if a.TrafficDirection != b.TrafficDirection {
+			if a.TrafficDirection == awstypes.TrafficDirectionIngress {
+				return -1
+			}
+			return 1
+		}
+
+		return int(aws.ToInt32(a.RuleNumber) - aws.ToInt32(b.RuleNumber))
+	})
+
+	return apiObjects
+}
+
+func expandCreateTrafficMirrorFilterRuleInput(tfMap map[string]interface{}) *ec2.CreateTrafficMirrorFilterRuleInput {
+	apiObject := &ec2.CreateTrafficMirrorFilterRuleInput{
+		ClientToken:          aws.String(id.UniqueId()),
+		DestinationCidrBlock: aws.String(tfMap["destination_cidr_block"].(string)),
+		RuleAction:           awstypes.TrafficMirrorRuleAction(tfMap["rule_action"].(string)),
+		RuleNumber:           aws.Int32(int32(tfMap["rule_number"].(int))),
+		SourceCidrBlock:      aws.String(tfMap["source_cidr_block"].(string)),
+		TrafficDirection:     awstypes.TrafficDirection(tfMap["traffic_direction"].(string)),
+	}
+
+	if v, ok := tfMap[names.AttrDescription].(string); ok && v != "" {
+		apiObject.Description = aws.String(v)
+	}
+
+	if v, ok := tfMap["destination_port_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.DestinationPortRange = expandTrafficMirrorPortRangeRequest(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap[names.AttrProtocol].(int); ok && v != 0 {
+		apiObject.Protocol = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["source_port_range"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SourcePortRange = expandTrafficMirrorPortRangeRequest(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func flattenTrafficMirrorFilterRules(apiObjects []awstypes.TrafficMirrorFilterRule) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, flattenTrafficMirrorFilterRule(apiObject))
+	}
+
+	return tfList
+}
+
+func flattenTrafficMirrorFilterRule(apiObject awstypes.TrafficMirrorFilterRule) map[string]interface{} {
+	tfMap := map[string]interface{}{
+		names.AttrDescription:           aws.ToString(apiObject.Description),
+		"destination_cidr_block":        aws.ToString(apiObject.DestinationCidrBlock),
+		"destination_port_range":        []interface{}{},
+		names.AttrProtocol:              int(aws.ToInt32(apiObject.Protocol)),
+		"rule_action":                   string(apiObject.RuleAction),
+		"rule_number":                   int(aws.ToInt32(apiObject.RuleNumber)),
+		"source_cidr_block":             aws.ToString(apiObject.SourceCidrBlock),
+		"source_port_range":             []interface{}{},
+		"traffic_direction":             string(apiObject.TrafficDirection),
+		"traffic_mirror_filter_rule_id": aws.ToString(apiObject.TrafficMirrorFilterRuleId),
+	}
+
+	if v := apiObject.DestinationPortRange; v != nil {
+		tfMap["destination_port_range"] = []interface{}{flattenTrafficMirrorFilterRulePortRange(v)}
+	}
+
+	if v := apiObject.SourcePortRange; v != nil {
+		tfMap["source_port_range"] = []interface{}{flattenTrafficMirrorFilterRulePortRange(v)}
+	}
+
+	return tfMap
+}
+
+func flattenTrafficMirrorFilterRulePortRange(apiObject *awstypes.TrafficMirrorPortRange) map[string]interface{} {
+	return map[string]interface{}{
+		"from_port": int(aws.ToInt32(apiObject.FromPort)),
+		"to_port":   int(aws.ToInt32(apiObject.ToPort)),
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVPCTrafficMirrorFilterRules_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_traffic_mirror_filter_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTrafficMirrorFilterRule(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrafficMirrorFilterRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCTrafficMirrorFilterRulesConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrafficMirrorFilterRulesCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttrPair(resourceName, "traffic_mirror_filter_id", "aws_ec2_traffic_mirror_filter.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.traffic_direction", "ingress"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule_number", "100"),
+					resource.TestCheckResourceAttrSet(resourceName, "rule.0.traffic_mirror_filter_rule_id"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.traffic_direction", "egress"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.rule_number", "100"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccVPCTrafficMirrorFilterRulesConfig_updated,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrafficMirrorFilterRulesCount(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule_number", "100"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.rule_action", "reject"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.rule_number", "200"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.protocol", "6"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.destination_port_range.0.from_port", "443"),
+					resource.TestCheckResourceAttr(resourceName, "rule.2.traffic_direction", "egress"),
+				),
+			},
+			{
+				Config: testAccVPCTrafficMirrorFilterRulesConfig_empty,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTrafficMirrorFilterRulesCount(ctx, resourceName, 0),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCTrafficMirrorFilterRules_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_ec2_traffic_mirror_filter_rules.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTrafficMirrorFilterRule(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrafficMirrorFilterRulesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCTrafficMirrorFilterRulesConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterRulesCount(ctx, resourceName, 2),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceTrafficMirrorFilterRules(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTrafficMirrorFilterRulesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_ec2_traffic_mirror_filter_rules" {
+				continue
+			}
+
+			output, err := tfec2.FindTrafficMirrorFilterByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if n := len(slices.Concat(output.IngressFilterRules, output.EgressFilterRules)); n > 0 {
+				return fmt.Errorf("EC2 Traffic Mirror Filter %s still has %d rules", rs.Primary.ID, n)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTrafficMirrorFilterRulesCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
+
+		output, err := tfec2.FindTrafficMirrorFilterByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(slices.Concat(output.IngressFilterRules, output.EgressFilterRules)); got != want {
+			return fmt.Errorf("EC2 Traffic Mirror Filter %s has %d rules, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+const testAccVPCTrafficMirrorFilterRulesConfig_basic = `
+resource "aws_ec2_traffic_mirror_filter" "test" {}
+
+resource "aws_ec2_traffic_mirror_filter_rules" "test" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+
+  rule {
+    traffic_direction      = "ingress"
+    rule_number            = 100
+    rule_action            = "accept"
+    destination_cidr_block = "10.0.0.0/8"
+    source_cidr_block      = "0.0.0.0/0"
+  }
+
+  rule {
+    traffic_direction      = "egress"
+    rule_number            = 100
+    rule_action            = "accept"
+    destination_cidr_block = "0.0.0.0/0"
+    source_cidr_block      = "10.0.0.0/8"
+  }
+}
+`
+
+const testAccVPCTrafficMirrorFilterRulesConfig_updated = `
+resource "aws_ec2_traffic_mirror_filter" "test" {}
+
+resource "aws_ec2_traffic_mirror_filter_rules" "test" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+
+  rule {
+    traffic_direction      = "ingress"
+    rule_number            = 100
+    rule_action            = "reject"
+    destination_cidr_block = "10.0.0.0/8"
+    source_cidr_block      = "0.0.0.0/0"
+  }
+
+  rule {
+    description            = "HTTPS"
+    traffic_direction      = "ingress"
+    rule_number            = 200
+    rule_action            = "accept"
+    destination_cidr_block = "10.0.0.0/8"
+    source_cidr_block      = "0.0.0.0/0"
+    protocol               = 6
+
+    destination_port_range {
+      from_port = 443
+      to_port   = 443
+    }
+  }
+
+  rule {
+    traffic_direction      = "egress"
+    rule_number            = 100
+    rule_action            = "accept"
+    destination_cidr_block = "0.0.0.0/0"
+    source_cidr_block      = "10.0.0.0/8"
+  }
+}
+`
+
+const testAccVPCTrafficMirrorFilterRulesConfig_empty = `
+resource "aws_ec2_traffic_mirror_filter" "test" {}
+
+resource "aws_ec2_traffic_mirror_filter_rules" "test" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+}
+`
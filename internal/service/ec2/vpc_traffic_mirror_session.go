@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"context"
+	"errors"
 	"log"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -24,6 +25,11 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+const (
+	trafficMirrorSessionNumberMin = 1
+	trafficMirrorSessionNumberMax = 32766
+)
+
 // @SDKResource("aws_ec2_traffic_mirror_session", name="Traffic Mirror Session")
 // @Tags(identifierAttribute="id")
 // @Testing(tagsTest=false)
@@ -65,8 +71,9 @@ func resourceTrafficMirrorSession() *schema.Resource {
 			},
 			"session_number": {
 				Type:         schema.TypeInt,
-				Required:     true,
-				ValidateFunc: validation.IntBetween(1, 32766),
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(trafficMirrorSessionNumberMin, trafficMirrorSessionNumberMax),
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -108,8 +115,34 @@ func resourceTrafficMirrorSessionCreate(ctx context.Context, d *schema.ResourceD
 		input.PacketLength = aws.Int32(int32(v.(int)))
 	}
 
+	// Session numbers must be unique per network interface.
+	// Serialize picking a session number and creating the session so that concurrent creates don't pick the same number.
+	networkInterfaceID := aws.ToString(input.NetworkInterfaceId)
+	conns.GlobalMutexKV.Lock(networkInterfaceID)
+	defer conns.GlobalMutexKV.Unlock(networkInterfaceID)
+
+	sessionNumbers, err := findTrafficMirrorSessionNumbersByNetworkInterfaceID(ctx, conn, networkInterfaceID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Traffic Mirror Sessions for network interface (%s): %s", networkInterfaceID, err)
+	}
+
 	if v, ok := d.GetOk("session_number"); ok {
-		input.SessionNumber = aws.Int32(int32(v.(int)))
+		sessionNumber := int32(v.(int))
+
+		if sessionID, ok := sessionNumbers[sessionNumber]; ok {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 Traffic Mirror Session: session number (%d) is already in use by EC2 Traffic Mirror Session (%s) on network interface (%s)", sessionNumber, sessionID, networkInterfaceID)
+		}
+
+		input.SessionNumber = aws.Int32(sessionNumber)
+	} else {
+		sessionNumber, err := nextTrafficMirrorSessionNumber(sessionNumbers)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating EC2 Traffic Mirror Session: %s", err)
+		}
+
+		input.SessionNumber = aws.Int32(sessionNumber)
 	}
 
 	if v, ok := d.GetOk("virtual_network_id"); ok {
@@ -176,7 +209,22 @@ func resourceTrafficMirrorSessionUpdate(ctx context.Context, d *schema.ResourceD
 		}
 
 		if d.HasChange("session_number") {
-			input.SessionNumber = aws.Int32(int32(d.Get("session_number").(int)))
+			sessionNumber := int32(d.Get("session_number").(int))
+			networkInterfaceID := d.Get(names.AttrNetworkInterfaceID).(string)
+			conns.GlobalMutexKV.Lock(networkInterfaceID)
+			defer conns.GlobalMutexKV.Unlock(networkInterfaceID)
+
+			sessionNumbers, err := findTrafficMirrorSessionNumbersByNetworkInterfaceID(ctx, conn, networkInterfaceID)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading EC2 Traffic Mirror Sessions for network interface (%s): %s", networkInterfaceID, err)
+			}
+
+			if sessionID, ok := sessionNumbers[sessionNumber]; ok && sessionID != d.Id() {
+				return sdkdiag.AppendErrorf(diags, "updating EC2 Traffic Mirror Session (%s): session number (%d) is already in use by EC2 Traffic Mirror Session (%s) on network interface (%s)", d.Id(), sessionNumber, sessionID, networkInterfaceID)
+			}
+
+			input.SessionNumber = aws.Int32(sessionNumber)
 		}
 
 		if d.HasChange("traffic_mirror_filter_id") {
@@ -246,3 +294,14 @@ func resourceTrafficMirrorSessionDelete(ctx context.Context, d *schema.ResourceD
 
 	return diags
 }
+
+// nextTrafficMirrorSessionNumber returns the lowest session number not in use.
+func nextTrafficMirrorSessionNumber(sessionNumbers map[int32]string) (int32, error) {
+	for n := int32(trafficMirrorSessionNumberMin); n <= trafficMirrorSessionNumberMax; n++ {
+		if _, ok := sessionNumbers[n]; !ok {
+			return n, nil
+		}
+	}
+
+	return 0, errors.New("no session numbers available")
+}
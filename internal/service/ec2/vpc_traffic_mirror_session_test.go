@@ -198,6 +198,59 @@ func TestAccVPCTrafficMirrorSession_updateTrafficMirrorTarget(t *testing.T) {
 	})
 }
 
+func TestAccVPCTrafficMirrorSession_sessionNumberAutoAssigned(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.TrafficMirrorSession
+	resourceName := "aws_ec2_traffic_mirror_session.test"
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTrafficMirrorSession(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrafficMirrorSessionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCTrafficMirrorSessionConfig_sessionNumberAutoAssigned(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorSessionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "session_number", acctest.Ct1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccVPCTrafficMirrorSession_sessionNumberConflict(t *testing.T) {
+	ctx := acctest.Context(t)
+	session := sdkacctest.RandIntRange(1, 32766)
+	rName := fmt.Sprintf("tf-acc-test-%s", sdkacctest.RandString(10))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckTrafficMirrorSession(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrafficMirrorSessionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCTrafficMirrorSessionConfig_sessionNumberConflict(rName, session),
+				ExpectError: regexache.MustCompile(`session number \(\d+\) is already in use`),
+			},
+		},
+	})
+}
+
 func testAccPreCheckTrafficMirrorSession(ctx context.Context, t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Client(ctx)
 
@@ -317,6 +370,36 @@ resource "aws_ec2_traffic_mirror_session" "test" {
 `, session))
 }
 
+func testAccVPCTrafficMirrorSessionConfig_sessionNumberAutoAssigned(rName string) string {
+	return acctest.ConfigCompose(testAccTrafficMirrorSessionConfig_base(rName), `
+resource "aws_ec2_traffic_mirror_session" "test" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  traffic_mirror_target_id = aws_ec2_traffic_mirror_target.test.id
+  network_interface_id     = aws_instance.test.primary_network_interface_id
+}
+`)
+}
+
+func testAccVPCTrafficMirrorSessionConfig_sessionNumberConflict(rName string, session int) string {
+	return acctest.ConfigCompose(testAccTrafficMirrorSessionConfig_base(rName), fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_session" "test1" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  traffic_mirror_target_id = aws_ec2_traffic_mirror_target.test.id
+  network_interface_id     = aws_instance.test.primary_network_interface_id
+  session_number           = %[1]d
+}
+
+resource "aws_ec2_traffic_mirror_session" "test2" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  traffic_mirror_target_id = aws_ec2_traffic_mirror_target.test.id
+  network_interface_id     = aws_instance.test.primary_network_interface_id
+  session_number           = %[1]d
+
+  depends_on = [aws_ec2_traffic_mirror_session.test1]
+}
+`, session))
+}
+
 func testAccVPCTrafficMirrorSessionConfig_tags1(rName, tagKey1, tagValue1 string, session int) string {
 	return acctest.ConfigCompose(testAccTrafficMirrorSessionConfig_base(rName), fmt.Sprintf(`
 resource "aws_ec2_traffic_mirror_session" "test" {
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+)
+
+// @SDKDataSource("aws_vpn_connection_device_types", name="VPN Connection Device Types")
+func dataSourceVPNConnectionDeviceTypes() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceVPNConnectionDeviceTypesRead,
+
+		Schema: map[string]*schema.Schema{
+			"device_types": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"platform": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"software": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vendor": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpn_connection_device_type_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVPNConnectionDeviceTypesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Client(ctx)
+
+	output, err := findVPNConnectionDeviceTypes(ctx, conn, &ec2.GetVpnConnectionDeviceTypesInput{})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 VPN Connection Device Types: %s", err)
+	}
+
+	d.SetId(meta.(*conns.AWSClient).Region)
+	if err := d.Set("device_types", flattenVPNConnectionDeviceTypes(output)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting device_types: %s", err)
+	}
+
+	return diags
+}
+
+func flattenVPNConnectionDeviceTypes(apiObjects []awstypes.VpnConnectionDeviceType) []interface{} {
+	tfList := []interface{}{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"platform":                      aws.ToString(apiObject.Platform),
+			"software":                      aws.ToString(apiObject.Software),
+			"vendor":                        aws.ToString(apiObject.Vendor),
+			"vpn_connection_device_type_id": aws.ToString(apiObject.VpnConnectionDeviceTypeId),
+		})
+	}
+
+	return tfList
+}
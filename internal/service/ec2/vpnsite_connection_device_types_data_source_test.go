@@ -0,0 +1,37 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSiteVPNConnectionDeviceTypesDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_vpn_connection_device_types.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EC2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSiteVPNConnectionDeviceTypesDataSourceConfig_basic,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "device_types.#", 0),
+					resource.TestCheckResourceAttrSet(dataSourceName, "device_types.0.vendor"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "device_types.0.vpn_connection_device_type_id"),
+				),
+			},
+		},
+	})
+}
+
+const testAccSiteVPNConnectionDeviceTypesDataSourceConfig_basic = `
+data "aws_vpn_connection_device_types" "test" {}
+`
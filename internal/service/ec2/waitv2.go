@@ -2610,3 +2610,43 @@ func waitNetworkInsightsAnalysisCreated(ctx context.Context, conn *ec2.Client, i
 
 	return nil, err
 }
+
+func waitBYOIPCIDRAdvertised(ctx context.Context, conn *ec2.Client, cidrBlock string, timeout time.Duration) (*awstypes.ByoipCidr, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ByoipCidrStateProvisioned),
+		Target:  enum.Slice(awstypes.ByoipCidrStateAdvertised),
+		Refresh: statusBYOIPCIDR(ctx, conn, cidrBlock),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ByoipCidr); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitBYOIPCIDRWithdrawn(ctx context.Context, conn *ec2.Client, cidrBlock string, timeout time.Duration) (*awstypes.ByoipCidr, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ByoipCidrStateAdvertised),
+		Target:  enum.Slice(awstypes.ByoipCidrStateProvisioned),
+		Refresh: statusBYOIPCIDR(ctx, conn, cidrBlock),
+		Timeout: timeout,
+		Delay:   5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ByoipCidr); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusMessage)))
+
+		return output, err
+	}
+
+	return nil, err
+}
@@ -471,6 +471,27 @@ func ResourceService() *schema.Resource {
 					},
 				},
 			},
+			"service_connect_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"discovery_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"discovery_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"dns_names": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			"service_registries": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -886,6 +907,10 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta inter
 	//	return sdkdiag.AppendErrorf(diags, "setting service_connect_configuration: %s", err)
 	//}
 
+	if err := d.Set("service_connect_endpoints", flattenServiceConnectEndpoints(service.Deployments)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting service_connect_endpoints: %s", err)
+	}
+
 	if err := d.Set("service_registries", flattenServiceRegistries(service.ServiceRegistries)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting service_registries: %s", err)
 	}
@@ -1722,6 +1747,56 @@ func expandClientAliases(srv []interface{}) []*ecs.ServiceConnectClientAlias {
 	return out
 }
 
+// flattenServiceConnectEndpoints returns the Service Connect endpoints of the service's PRIMARY deployment.
+// Each endpoint's DNS names are the client aliases configured for the matching Service Connect service,
+// falling back to the discovery name that clients in the namespace use when no alias DNS name is set.
+func flattenServiceConnectEndpoints(deployments []*ecs.Deployment) []interface{} {
+	var deployment *ecs.Deployment
+	for _, v := range deployments {
+		if aws.StringValue(v.Status) == deploymentStatusPrimary {
+			deployment = v
+			break
+		}
+	}
+
+	if deployment == nil || len(deployment.ServiceConnectResources) == 0 {
+		return nil
+	}
+
+	dnsNames := make(map[string][]interface{})
+	if config := deployment.ServiceConnectConfiguration; config != nil {
+		for _, service := range config.Services {
+			discoveryName := aws.StringValue(service.DiscoveryName)
+			if discoveryName == "" {
+				discoveryName = aws.StringValue(service.PortName)
+			}
+
+			for _, alias := range service.ClientAliases {
+				dnsName := aws.StringValue(alias.DnsName)
+				if dnsName == "" {
+					dnsName = discoveryName
+				}
+
+				dnsNames[discoveryName] = append(dnsNames[discoveryName], dnsName)
+			}
+		}
+	}
+
+	tfList := make([]interface{}, 0, len(deployment.ServiceConnectResources))
+	for _, apiObject := range deployment.ServiceConnectResources {
+		discoveryName := aws.StringValue(apiObject.DiscoveryName)
+		tfMap := map[string]interface{}{
+			"discovery_arn":  aws.StringValue(apiObject.DiscoveryArn),
+			"discovery_name": discoveryName,
+			"dns_names":      dnsNames[discoveryName],
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
 func flattenServiceRegistries(srs []*ecs.ServiceRegistry) []map[string]interface{} {
 	if len(srs) == 0 {
 		return nil
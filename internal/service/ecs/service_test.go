@@ -1420,6 +1420,11 @@ func TestAccECSService_ServiceConnect_full(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckServiceExists(ctx, resourceName, &service),
 					resource.TestCheckResourceAttr(resourceName, "service_connect_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_endpoints.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "service_connect_endpoints.0.discovery_arn"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_endpoints.0.discovery_name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_endpoints.0.dns_names.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "service_connect_endpoints.0.dns_names.0", "example.com"),
 				),
 			},
 		},
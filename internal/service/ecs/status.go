@@ -23,6 +23,8 @@ const (
 	taskSetStatusActive   = "ACTIVE"
 	taskSetStatusDraining = "DRAINING"
 	taskSetStatusPrimary  = "PRIMARY"
+
+	deploymentStatusPrimary = "PRIMARY"
 )
 
 func statusCapacityProvider(ctx context.Context, conn *ecs.ECS, arn string) retry.StateRefreshFunc {
@@ -16,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/eks"
 	"github.com/aws/aws-sdk-go-v2/service/eks/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -43,7 +44,10 @@ func resourceAddon() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceAddonConfigurationValuesCustomizeDiff,
+		),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
@@ -90,6 +94,23 @@ func resourceAddon() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"pod_identity_association": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrRoleARN: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"service_account": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
 			"preserve": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -148,6 +169,10 @@ func resourceAddonCreate(ctx context.Context, d *schema.ResourceData, meta inter
 		input.ConfigurationValues = aws.String(v.(string))
 	}
 
+	if v, ok := d.GetOk("pod_identity_association"); ok && v.(*schema.Set).Len() > 0 {
+		input.PodIdentityAssociations = expandAddonPodIdentityAssociations(v.(*schema.Set).List())
+	}
+
 	if v, ok := d.GetOk("resolve_conflicts"); ok {
 		input.ResolveConflicts = types.ResolveConflicts(v.(string))
 	} else if v, ok := d.GetOk("resolve_conflicts_on_create"); ok {
@@ -226,6 +251,13 @@ func resourceAddonRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("configuration_values", addon.ConfigurationValues)
 	d.Set(names.AttrCreatedAt, aws.ToTime(addon.CreatedAt).Format(time.RFC3339))
 	d.Set("modified_at", aws.ToTime(addon.ModifiedAt).Format(time.RFC3339))
+	podIdentityAssociations, err := findAddonPodIdentityAssociations(ctx, conn, clusterName, addon.PodIdentityAssociations)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Add-On (%s) pod identity associations: %s", d.Id(), err)
+	}
+	if err := d.Set("pod_identity_association", flattenAddonPodIdentityAssociations(podIdentityAssociations)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting pod_identity_association: %s", err)
+	}
 	d.Set("service_account_role_arn", addon.ServiceAccountRoleArn)
 
 	setTagsOut(ctx, addon.Tags)
@@ -242,7 +274,7 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if d.HasChanges("addon_version", "service_account_role_arn", "configuration_values") {
+	if d.HasChanges("addon_version", "service_account_role_arn", "configuration_values", "pod_identity_association") {
 		input := &eks.UpdateAddonInput{
 			AddonName:          aws.String(addonName),
 			ClientRequestToken: aws.String(sdkid.UniqueId()),
@@ -257,6 +289,14 @@ func resourceAddonUpdate(ctx context.Context, d *schema.ResourceData, meta inter
 			input.ConfigurationValues = aws.String(d.Get("configuration_values").(string))
 		}
 
+		if d.HasChange("pod_identity_association") {
+			// An empty list removes all pod identity associations from the add-on.
+			input.PodIdentityAssociations = expandAddonPodIdentityAssociations(d.Get("pod_identity_association").(*schema.Set).List())
+			if input.PodIdentityAssociations == nil {
+				input.PodIdentityAssociations = []types.AddonPodIdentityAssociations{}
+			}
+		}
+
 		var conflictResolutionAttr string
 		var conflictResolution types.ResolveConflicts
 
@@ -356,6 +396,55 @@ func findAddonByTwoPartKey(ctx context.Context, conn *eks.Client, clusterName, a
 	return output.Addon, nil
 }
 
+func findAddonConfigurationSchema(ctx context.Context, conn *eks.Client, addonName, addonVersion string) (string, error) {
+	input := &eks.DescribeAddonConfigurationInput{
+		AddonName:    aws.String(addonName),
+		AddonVersion: aws.String(addonVersion),
+	}
+
+	output, err := conn.DescribeAddonConfiguration(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.ConfigurationSchema == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.ToString(output.ConfigurationSchema), nil
+}
+
+func findAddonPodIdentityAssociations(ctx context.Context, conn *eks.Client, clusterName string, associationARNs []string) ([]types.PodIdentityAssociation, error) {
+	var apiObjects []types.PodIdentityAssociation
+
+	for _, associationARN := range associationARNs {
+		// arn:${Partition}:eks:${Region}:${Account}:podidentityassociation/${ClusterName}/${AssociationID}
+		associationID := associationARN[strings.LastIndex(associationARN, "/")+1:]
+
+		apiObject, err := findPodIdentityAssociationByTwoPartKey(ctx, conn, associationID, clusterName)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		apiObjects = append(apiObjects, *apiObject)
+	}
+
+	return apiObjects, nil
+}
+
 func findAddonUpdateByThreePartKey(ctx context.Context, conn *eks.Client, clusterName, addonName, id string) (*types.Update, error) {
 	input := &eks.DescribeUpdateInput{
 		AddonName: aws.String(addonName),
@@ -513,3 +602,80 @@ func errorDetailsError(apiObjects []types.ErrorDetail) error {
 
 	return errors.Join(errs...)
 }
+
+func resourceAddonConfigurationValuesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChanges("addon_version", "configuration_values") {
+		return nil
+	}
+
+	if !d.NewValueKnown("addon_version") || !d.NewValueKnown("configuration_values") {
+		return nil
+	}
+
+	addonName, addonVersion, configurationValues := d.Get("addon_name").(string), d.Get("addon_version").(string), d.Get("configuration_values").(string)
+	if addonName == "" || addonVersion == "" || configurationValues == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	configurationSchema, err := findAddonConfigurationSchema(ctx, conn, addonName, addonVersion)
+
+	if err != nil {
+		// Don't fail the plan if the configuration schema can't be retrieved, e.g. due to missing IAM permissions.
+		log.Printf("[WARN] reading EKS Add-On (%s/%s) configuration schema: %s", addonName, addonVersion, err)
+		return nil
+	}
+
+	if err := validateAddonConfigurationValues(configurationSchema, configurationValues); err != nil {
+		return fmt.Errorf("configuration_values are not valid for EKS Add-On (%s) version %s: %w", addonName, addonVersion, err)
+	}
+
+	return nil
+}
+
+func expandAddonPodIdentityAssociations(tfList []interface{}) []types.AddonPodIdentityAssociations {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.AddonPodIdentityAssociations
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.AddonPodIdentityAssociations{}
+
+		if v, ok := tfMap[names.AttrRoleARN].(string); ok && v != "" {
+			apiObject.RoleArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["service_account"].(string); ok && v != "" {
+			apiObject.ServiceAccount = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAddonPodIdentityAssociations(apiObjects []types.PodIdentityAssociation) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrRoleARN: aws.ToString(apiObject.RoleArn),
+			"service_account": aws.ToString(apiObject.ServiceAccount),
+		})
+	}
+
+	return tfList
+}
@@ -5,6 +5,7 @@ package eks
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -49,6 +50,7 @@ func resourceCluster() *schema.Resource {
 				// You cannot disable envelope encryption after enabling it. This action is irreversible.
 				return len(old.([]interface{})) == 1 && len(new.([]interface{})) == 0
 			}),
+			clusterUpgradeInsightsCustomizeDiff,
 		),
 
 		Timeouts: &schema.ResourceTimeout{
@@ -166,6 +168,14 @@ func resourceCluster() *schema.Resource {
 					},
 				},
 			},
+			"bypass_upgrade_insights": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"check_upgrade_insights": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
 			"kubernetes_network_config": {
 				Type:          schema.TypeList,
 				Optional:      true,
@@ -689,6 +699,51 @@ func updateVPCConfig(ctx context.Context, conn *eks.Client, name string, vpcConf
 	return nil
 }
 
+// clusterUpgradeInsightsCustomizeDiff blocks planning a version upgrade while upgrade readiness insights for the new version are in ERROR status.
+func clusterUpgradeInsightsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange(names.AttrVersion) || !d.NewValueKnown(names.AttrVersion) {
+		return nil
+	}
+
+	if !d.Get("check_upgrade_insights").(bool) || d.Get("bypass_upgrade_insights").(bool) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+	version := d.Get(names.AttrVersion).(string)
+
+	if err := checkClusterUpgradeInsights(ctx, conn, d.Id(), version); err != nil {
+		return fmt.Errorf("upgrading EKS Cluster (%s) version to %s: %w. Set bypass_upgrade_insights to override", d.Id(), version, err)
+	}
+
+	return nil
+}
+
+// checkClusterUpgradeInsights returns an error if any upgrade readiness insight for the specified Kubernetes version is in ERROR status.
+func checkClusterUpgradeInsights(ctx context.Context, conn *eks.Client, name, version string) error {
+	input := &eks.ListInsightsInput{
+		ClusterName: aws.String(name),
+		Filter: &types.InsightsFilter{
+			Categories:         []types.Category{types.CategoryUpgradeReadiness},
+			KubernetesVersions: []string{version},
+			Statuses:           []types.InsightStatusValue{types.InsightStatusValueError},
+		},
+	}
+
+	insights, err := findInsights(ctx, conn, input)
+
+	if err != nil {
+		return fmt.Errorf("reading upgrade insights: %w", err)
+	}
+
+	var errs []error
+	for _, insight := range insights {
+		errs = append(errs, fmt.Errorf("blocking upgrade insight %s (%s)", aws.ToString(insight.Name), aws.ToString(insight.Id)))
+	}
+
+	return errors.Join(errs...)
+}
+
 func findClusterUpdateByTwoPartKey(ctx context.Context, conn *eks.Client, name, id string) (*types.Update, error) {
 	input := &eks.DescribeUpdateInput{
 		Name:     aws.String(name),
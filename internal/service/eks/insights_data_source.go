@@ -0,0 +1,197 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	"github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_eks_insights", name="Insights")
+func dataSourceInsights() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceInsightsRead,
+
+		Schema: map[string]*schema.Schema{
+			"categories": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[types.Category](),
+				},
+			},
+			names.AttrClusterName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validClusterName,
+			},
+			"insights": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"category": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"kubernetes_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_refresh_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_transition_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatusReason: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"kubernetes_versions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"statuses": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[types.InsightStatusValue](),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceInsightsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	clusterName := d.Get(names.AttrClusterName).(string)
+	input := &eks.ListInsightsInput{
+		ClusterName: aws.String(clusterName),
+		Filter:      &types.InsightsFilter{},
+	}
+
+	if v, ok := d.GetOk("categories"); ok && v.(*schema.Set).Len() > 0 {
+		input.Filter.Categories = flex.ExpandStringyValueSet[types.Category](v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("kubernetes_versions"); ok && v.(*schema.Set).Len() > 0 {
+		input.Filter.KubernetesVersions = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("statuses"); ok && v.(*schema.Set).Len() > 0 {
+		input.Filter.Statuses = flex.ExpandStringyValueSet[types.InsightStatusValue](v.(*schema.Set))
+	}
+
+	insights, err := findInsights(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Cluster (%s) insights: %s", clusterName, err)
+	}
+
+	d.SetId(clusterName)
+	if err := d.Set("insights", flattenInsightSummaries(insights)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting insights: %s", err)
+	}
+
+	return diags
+}
+
+func findInsights(ctx context.Context, conn *eks.Client, input *eks.ListInsightsInput) ([]types.InsightSummary, error) {
+	var output []types.InsightSummary
+
+	pages := eks.NewListInsightsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Insights...)
+	}
+
+	return output, nil
+}
+
+func flattenInsightSummaries(apiObjects []types.InsightSummary) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"category":            string(apiObject.Category),
+			names.AttrDescription: aws.ToString(apiObject.Description),
+			names.AttrID:          aws.ToString(apiObject.Id),
+			"kubernetes_version":  aws.ToString(apiObject.KubernetesVersion),
+			names.AttrName:        aws.ToString(apiObject.Name),
+		}
+
+		if v := apiObject.InsightStatus; v != nil {
+			tfMap[names.AttrStatus] = string(v.Status)
+			tfMap[names.AttrStatusReason] = aws.ToString(v.Reason)
+		}
+
+		if v := apiObject.LastRefreshTime; v != nil {
+			tfMap["last_refresh_time"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		if v := apiObject.LastTransitionTime; v != nil {
+			tfMap["last_transition_time"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -4,9 +4,13 @@
 package eks
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/YakDriver/regexache"
+	"github.com/xeipuuv/gojsonschema"
+	"gopkg.in/yaml.v2"
 )
 
 func validClusterName(v interface{}, k string) (ws []string, errors []error) {
@@ -26,3 +30,76 @@ func validClusterName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+// validateAddonConfigurationValues validates JSON or YAML configuration values against an add-on's JSON schema.
+func validateAddonConfigurationValues(configurationSchema, configurationValues string) error {
+	var document gojsonschema.JSONLoader
+	if json.Valid([]byte(configurationValues)) {
+		document = gojsonschema.NewStringLoader(configurationValues)
+	} else {
+		var v interface{}
+		if err := yaml.Unmarshal([]byte(configurationValues), &v); err != nil {
+			return fmt.Errorf("parsing configuration values: %w", err)
+		}
+
+		v, err := yamlToJSONCompatible(v)
+		if err != nil {
+			return fmt.Errorf("parsing configuration values: %w", err)
+		}
+
+		document = gojsonschema.NewGoLoader(v)
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(configurationSchema), document)
+
+	if err != nil {
+		return err
+	}
+
+	if result.Valid() {
+		return nil
+	}
+
+	var errs []error
+	for _, v := range result.Errors() {
+		errs = append(errs, errors.New(v.String()))
+	}
+
+	return errors.Join(errs...)
+}
+
+// yamlToJSONCompatible converts the map[interface{}]interface{} values produced by the YAML decoder to map[string]interface{}.
+func yamlToJSONCompatible(v interface{}) (interface{}, error) {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, v := range v {
+			key, ok := k.(string)
+			if !ok {
+				return nil, fmt.Errorf("unsupported map key type %T", k)
+			}
+
+			v, err := yamlToJSONCompatible(v)
+			if err != nil {
+				return nil, err
+			}
+
+			m[key] = v
+		}
+
+		return m, nil
+	case []interface{}:
+		for i, e := range v {
+			e, err := yamlToJSONCompatible(e)
+			if err != nil {
+				return nil, err
+			}
+
+			v[i] = e
+		}
+
+		return v, nil
+	default:
+		return v, nil
+	}
+}
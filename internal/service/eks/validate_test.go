@@ -67,3 +67,76 @@ func TestValidClusterName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateAddonConfigurationValues(t *testing.T) {
+	t.Parallel()
+
+	configurationSchema := `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "object",
+  "additionalProperties": false,
+  "properties": {
+    "replicaCount": {"type": "integer"},
+    "env": {
+      "type": "object",
+      "additionalProperties": false,
+      "properties": {
+        "WARM_ENI_TARGET": {"type": "string"}
+      }
+    }
+  }
+}`
+
+	cases := []struct {
+		Value     string
+		ExpectErr bool
+	}{
+		{
+			Value: `{}`,
+		},
+		{
+			Value: `{"replicaCount": 2, "env": {"WARM_ENI_TARGET": "2"}}`,
+		},
+		{
+			Value: "replicaCount: 2\nenv:\n  WARM_ENI_TARGET: \"2\"\n",
+		},
+		{
+			Value:     `{"replicaCount": "two"}`,
+			ExpectErr: true,
+		},
+		{
+			Value:     `{"env": {"WARM_ENI_TARGTE": "2"}}`,
+			ExpectErr: true,
+		},
+		{
+			Value:     "replicas: 2\n",
+			ExpectErr: true,
+		},
+		{
+			Value:     "replicaCount: [\n",
+			ExpectErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateAddonConfigurationValues(configurationSchema, tc.Value)
+
+		if got, want := err != nil, tc.ExpectErr; got != want {
+			t.Errorf("validateAddonConfigurationValues(%q) error = %v, expected error %t", tc.Value, err, want)
+		}
+	}
+}
+
+func TestYAMLToJSONCompatible(t *testing.T) {
+	t.Parallel()
+
+	_, err := yamlToJSONCompatible(map[interface{}]interface{}{1: "one"})
+
+	if err == nil {
+		t.Fatal("expected error for non-string map key")
+	}
+
+	if got, want := err.Error(), "unsupported map key type int"; got != want {
+		t.Errorf("error = %q, want %q", got, want)
+	}
+}
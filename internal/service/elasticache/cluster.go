@@ -972,7 +972,7 @@ func setFromCacheCluster(d *schema.ResourceData, c *elasticache.CacheCluster) er
 	d.Set("node_type", c.CacheNodeType)
 
 	d.Set(names.AttrEngine, c.Engine)
-	if aws.StringValue(c.Engine) == engineRedis {
+	if engine := aws.StringValue(c.Engine); engine == engineRedis || engine == engineValkey {
 		if err := setEngineVersionRedis(d, c.EngineVersion); err != nil {
 			return err // nosemgrep:ci.bare-error-returns
 		}
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"math"
 	"regexp"
+	"strings"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go/aws"
@@ -56,6 +57,22 @@ func validRedisVersionString(v any, k string) (ws []string, errors []error) {
 	return
 }
 
+const (
+	valkeyVersionRegexpPattern = `^(7\.([2-9]|[1-9][[:digit:]]+)|([8-9]|[1-9][[:digit:]]+)\.[[:digit:]]+)$`
+)
+
+var valkeyVersionRegexp = regexache.MustCompile(valkeyVersionRegexpPattern)
+
+func validValkeyVersionString(v any, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if !valkeyVersionRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf("%s: %s is invalid. For Valkey, use <major>.<minor> with a minimum version of 7.2.", k, value))
+	}
+
+	return
+}
+
 // customizeDiffValidateClusterEngineVersion validates the correct format for `engine_version`, based on `engine`
 func customizeDiffValidateClusterEngineVersion(_ context.Context, diff *schema.ResourceDiff, _ any) error {
 	engineVersion, ok := diff.GetOk(names.AttrEngineVersion)
@@ -70,10 +87,14 @@ func customizeDiffValidateClusterEngineVersion(_ context.Context, diff *schema.R
 func validateClusterEngineVersion(engine, engineVersion string) error {
 	// Memcached: Versions in format <major>.<minor>.<patch>
 	// Redis: Starting with version 6, must match <major>.<minor>, prior to version 6, <major>.<minor>.<patch>
+	// Valkey: Versions in format <major>.<minor>, starting with version 7.2
 	var validator schema.SchemaValidateFunc
-	if engine == "" || engine == engineMemcached {
+	switch strings.ToLower(engine) {
+	case "", engineMemcached:
 		validator = validMemcachedVersionString
-	} else {
+	case engineValkey:
+		validator = validValkeyVersionString
+	default:
 		validator = validRedisVersionString
 	}
 
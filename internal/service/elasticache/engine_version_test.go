@@ -210,6 +210,81 @@ func TestValidRedisVersionString(t *testing.T) {
 	}
 }
 
+func TestValidValkeyVersionString(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		version string
+		valid   bool
+	}{
+		{
+			version: "6.2",
+			valid:   false,
+		},
+		{
+			version: "7.0",
+			valid:   false,
+		},
+		{
+			version: "7.1",
+			valid:   false,
+		},
+		{
+			version: "7.2",
+			valid:   true,
+		},
+		{
+			version: "7.2.4",
+			valid:   false,
+		},
+		{
+			version: "7.x",
+			valid:   false,
+		},
+		{
+			version: "8.0",
+			valid:   true,
+		},
+		{
+			version: "8.1",
+			valid:   true,
+		},
+		{
+			version: "8",
+			valid:   false,
+		},
+		{
+			version: "10.0",
+			valid:   true,
+		},
+	}
+
+	for _, testcase := range testcases {
+		testcase := testcase
+		t.Run(testcase.version, func(t *testing.T) {
+			t.Parallel()
+
+			warnings, errors := tfelasticache.ValidValkeyVersionString(testcase.version, names.AttrKey)
+
+			if l := len(warnings); l != 0 {
+				t.Errorf("expected no warnings, got %d", l)
+			}
+
+			if testcase.valid {
+				if l := len(errors); l != 0 {
+					t.Errorf("expected no errors, got %d: %v", l, errors)
+				}
+			} else {
+				if l := len(errors); l == 0 {
+					t.Error("expected one error, got none")
+				} else if l > 1 {
+					t.Errorf("expected one error, got %d: %v", l, errors)
+				}
+			}
+		})
+	}
+}
+
 func TestValidateClusterEngineVersion(t *testing.T) {
 	t.Parallel()
 
@@ -281,6 +356,54 @@ func TestValidateClusterEngineVersion(t *testing.T) {
 			version: "7.0",
 			valid:   true,
 		},
+
+		{
+			engine:  tfelasticache.EngineValkey,
+			version: "1.2.3",
+			valid:   false,
+		},
+		{
+			engine:  tfelasticache.EngineValkey,
+			version: "6.x",
+			valid:   false,
+		},
+		{
+			engine:  tfelasticache.EngineValkey,
+			version: "7.0",
+			valid:   false,
+		},
+		{
+			engine:  tfelasticache.EngineValkey,
+			version: "7.2",
+			valid:   true,
+		},
+		{
+			engine:  tfelasticache.EngineValkey,
+			version: "8.0",
+			valid:   true,
+		},
+
+		// Engine names are case-insensitive
+		{
+			engine:  "Valkey",
+			version: "6.x",
+			valid:   false,
+		},
+		{
+			engine:  "VALKEY",
+			version: "7.2",
+			valid:   true,
+		},
+		{
+			engine:  "Redis",
+			version: "6.x",
+			valid:   true,
+		},
+		{
+			engine:  "Memcached",
+			version: "6.x",
+			valid:   false,
+		},
 	}
 
 	for _, testcase := range testcases {
@@ -33,6 +33,7 @@ var (
 	DiffVersion                               = diffVersion
 	EngineMemcached                           = engineMemcached
 	EngineRedis                               = engineRedis
+	EngineValkey                              = engineValkey
 	EngineVersionForceNewOnDowngrade          = engineVersionForceNewOnDowngrade
 	EngineVersionIsDowngrade                  = engineVersionIsDowngrade
 	NormalizeEngineVersion                    = normalizeEngineVersion
@@ -40,4 +41,5 @@ var (
 	ValidateClusterEngineVersion              = validateClusterEngineVersion
 	ValidMemcachedVersionString               = validMemcachedVersionString
 	ValidRedisVersionString                   = validRedisVersionString
+	ValidValkeyVersionString                  = validValkeyVersionString
 )
@@ -109,11 +109,13 @@ func resourceReplicationGroup() *schema.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 			names.AttrEngine: {
-				Type:         schema.TypeString,
-				Optional:     true,
+				Type:     schema.TypeString,
+				Optional: true,
+				// The ElastiCache API supports converting a replication group from Redis to Valkey,
+				// but the AWS SDK version in use does not expose Engine on ModifyReplicationGroup.
 				ForceNew:     true,
 				Default:      engineRedis,
-				ValidateFunc: validation.StringInSlice([]string{engineRedis}, true),
+				ValidateFunc: validation.StringInSlice([]string{engineRedis, engineValkey}, true),
 			},
 			names.AttrEngineVersion: {
 				Type:         schema.TypeString,
@@ -380,6 +382,7 @@ func resourceReplicationGroup() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			customizeDiffValidateReplicationGroupAutomaticFailover,
+			customizeDiffValidateClusterEngineVersion,
 			customizeDiffEngineVersionForceNewOnDowngrade,
 			customdiff.ComputedIf("member_clusters", func(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				return diff.HasChange("num_cache_clusters") ||
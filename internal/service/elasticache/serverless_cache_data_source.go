@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Serverless Cache")
+func newServerlessCacheDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &serverlessCacheDataSource{}, nil
+}
+
+type serverlessCacheDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*serverlessCacheDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_elasticache_serverless_cache"
+}
+
+func (d *serverlessCacheDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"cache_usage_limits": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[cacheUsageLimitsModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[cacheUsageLimitsModel](ctx),
+				Computed:    true,
+			},
+			names.AttrCreateTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"daily_snapshot_time": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrEndpoint: schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[endpointModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[endpointModel](ctx),
+				Computed:    true,
+			},
+			names.AttrEngine: schema.StringAttribute{
+				Computed: true,
+			},
+			"full_engine_version": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Computed: true,
+			},
+			"major_engine_version": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"reader_endpoint": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[endpointModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[endpointModel](ctx),
+				Computed:    true,
+			},
+			names.AttrSecurityGroupIDs: schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"snapshot_retention_limit": schema.Int64Attribute{
+				Computed: true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrSubnetIDs: schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+			"user_group_id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *serverlessCacheDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data serverlessCacheDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ElastiCacheClient(ctx)
+
+	name := data.ServerlessCacheName.ValueString()
+	output, err := findServerlessCacheByID(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ElastiCache Serverless Cache (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.ServerlessCacheName)
+
+	tags, err := listTags(ctx, d.Meta().ElastiCacheConn(ctx), data.ARN.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing tags for ElastiCache Serverless Cache (%s)", name), err.Error())
+
+		return
+	}
+
+	data.Tags = fwflex.FlattenFrameworkStringValueMap(ctx, tags.IgnoreAWS().IgnoreConfig(d.Meta().IgnoreTagsConfig).Map())
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type serverlessCacheDataSourceModel struct {
+	ARN                    types.String                                           `tfsdk:"arn"`
+	CacheUsageLimits       fwtypes.ListNestedObjectValueOf[cacheUsageLimitsModel] `tfsdk:"cache_usage_limits"`
+	CreateTime             timetypes.RFC3339                                      `tfsdk:"create_time"`
+	DailySnapshotTime      types.String                                           `tfsdk:"daily_snapshot_time"`
+	Description            types.String                                           `tfsdk:"description"`
+	Endpoint               fwtypes.ListNestedObjectValueOf[endpointModel]         `tfsdk:"endpoint"`
+	Engine                 types.String                                           `tfsdk:"engine"`
+	FullEngineVersion      types.String                                           `tfsdk:"full_engine_version"`
+	ID                     types.String                                           `tfsdk:"id"`
+	KmsKeyID               types.String                                           `tfsdk:"kms_key_id"`
+	MajorEngineVersion     types.String                                           `tfsdk:"major_engine_version"`
+	ReaderEndpoint         fwtypes.ListNestedObjectValueOf[endpointModel]         `tfsdk:"reader_endpoint"`
+	SecurityGroupIDs       fwtypes.SetValueOf[types.String]                       `tfsdk:"security_group_ids"`
+	ServerlessCacheName    types.String                                           `tfsdk:"name"`
+	SnapshotRetentionLimit types.Int64                                            `tfsdk:"snapshot_retention_limit"`
+	Status                 types.String                                           `tfsdk:"status"`
+	SubnetIDs              fwtypes.SetValueOf[types.String]                       `tfsdk:"subnet_ids"`
+	Tags                   types.Map                                              `tfsdk:"tags"`
+	UserGroupID            types.String                                           `tfsdk:"user_group_id"`
+}
@@ -62,6 +62,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_lb_trust_store",
 			Name:     "Trust Store",
 		},
+		{
+			Factory:  DataSourceTrustStoreAssociations,
+			TypeName: "aws_lb_trust_store_associations",
+			Name:     "Trust Store Associations",
+		},
 		{
 			Factory:  DataSourceLoadBalancers,
 			TypeName: "aws_lbs",
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/elbv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKDataSource("aws_lb_trust_store_associations", name="Trust Store Associations")
+func DataSourceTrustStoreAssociations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTrustStoreAssociationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"resource_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"trust_store_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceTrustStoreAssociationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ELBV2Conn(ctx)
+
+	trustStoreARN := d.Get("trust_store_arn").(string)
+	input := &elbv2.DescribeTrustStoreAssociationsInput{
+		TrustStoreArn: aws.String(trustStoreARN),
+	}
+
+	associations, err := findTrustStoreAssociations(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ELBv2 Trust Store (%s) associations: %s", trustStoreARN, err)
+	}
+
+	d.SetId(trustStoreARN)
+	d.Set("resource_arns", tfslices.ApplyToAll(associations, func(v *elbv2.TrustStoreAssociation) string {
+		return aws.StringValue(v.ResourceArn)
+	}))
+
+	return diags
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elbv2_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccELBV2TrustStoreAssociationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+	certificate := acctest.TLSRSAX509SelfSignedCertificatePEM(t, key, "example.com")
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lb_trust_store_associations.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ELBV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustStoreAssociationsDataSourceConfig_basic(rName, key, certificate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "trust_store_arn", "aws_lb_trust_store.test", names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_arns.0", "aws_lb.test", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
+func testAccTrustStoreAssociationsDataSourceConfig_basic(rName, key, certificate string) string {
+	return acctest.ConfigCompose(testAccListenerConfig_mutualAuthentication(rName, key, certificate), `
+data "aws_lb_trust_store_associations" "test" {
+  trust_store_arn = aws_lb_trust_store.test.arn
+
+  depends_on = [aws_lb_listener.test]
+}
+`)
+}
@@ -35,6 +35,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Experiment Template",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  ResourceTargetAccountConfiguration,
+			TypeName: "aws_fis_target_account_configuration",
+			Name:     "Target Account Configuration",
+		},
 	}
 }
 
@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fis
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fis"
+	"github.com/aws/aws-sdk-go-v2/service/fis/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameTargetAccountConfiguration = "Target Account Configuration"
+
+	targetAccountConfigurationResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_fis_target_account_configuration", name="Target Account Configuration")
+func ResourceTargetAccountConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTargetAccountConfigurationCreate,
+		ReadWithoutTimeout:   resourceTargetAccountConfigurationRead,
+		UpdateWithoutTimeout: resourceTargetAccountConfigurationUpdate,
+		DeleteWithoutTimeout: resourceTargetAccountConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrAccountID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidAccountID,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 512),
+			},
+			"experiment_template_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceTargetAccountConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).FISClient(ctx)
+
+	templateID, accountID := d.Get("experiment_template_id").(string), d.Get(names.AttrAccountID).(string)
+	resourceID, err := flex.FlattenResourceId([]string{templateID, accountID}, targetAccountConfigurationResourceIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.FIS, create.ErrActionCreating, ResNameTargetAccountConfiguration, accountID, err)
+	}
+
+	input := &fis.CreateTargetAccountConfigurationInput{
+		AccountId:            aws.String(accountID),
+		ClientToken:          aws.String(id.UniqueId()),
+		ExperimentTemplateId: aws.String(templateID),
+		RoleArn:              aws.String(d.Get(names.AttrRoleARN).(string)),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	_, err = conn.CreateTargetAccountConfiguration(ctx, input)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.FIS, create.ErrActionCreating, ResNameTargetAccountConfiguration, resourceID, err)
+	}
+
+	d.SetId(resourceID)
+
+	return append(diags, resourceTargetAccountConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceTargetAccountConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).FISClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), targetAccountConfigurationResourceIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.FIS, create.ErrActionReading, ResNameTargetAccountConfiguration, d.Id(), err)
+	}
+
+	templateID, accountID := parts[0], parts[1]
+	output, err := FindTargetAccountConfigurationByTwoPartKey(ctx, conn, templateID, accountID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		create.LogNotFoundRemoveState(names.FIS, create.ErrActionReading, ResNameTargetAccountConfiguration, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.FIS, create.ErrActionReading, ResNameTargetAccountConfiguration, d.Id(), err)
+	}
+
+	d.Set(names.AttrAccountID, output.AccountId)
+	d.Set(names.AttrDescription, output.Description)
+	d.Set("experiment_template_id", templateID)
+	d.Set(names.AttrRoleARN, output.RoleArn)
+
+	return diags
+}
+
+func resourceTargetAccountConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).FISClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), targetAccountConfigurationResourceIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.FIS, create.ErrActionUpdating, ResNameTargetAccountConfiguration, d.Id(), err)
+	}
+
+	input := &fis.UpdateTargetAccountConfigurationInput{
+		AccountId:            aws.String(parts[1]),
+		Description:          aws.String(d.Get(names.AttrDescription).(string)),
+		ExperimentTemplateId: aws.String(parts[0]),
+		RoleArn:              aws.String(d.Get(names.AttrRoleARN).(string)),
+	}
+
+	_, err = conn.UpdateTargetAccountConfiguration(ctx, input)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.FIS, create.ErrActionUpdating, ResNameTargetAccountConfiguration, d.Id(), err)
+	}
+
+	return append(diags, resourceTargetAccountConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceTargetAccountConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).FISClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), targetAccountConfigurationResourceIDPartCount, false)
+	if err != nil {
+		return create.AppendDiagError(diags, names.FIS, create.ErrActionDeleting, ResNameTargetAccountConfiguration, d.Id(), err)
+	}
+
+	log.Printf("[DEBUG] Deleting FIS Target Account Configuration: %s", d.Id())
+	_, err = conn.DeleteTargetAccountConfiguration(ctx, &fis.DeleteTargetAccountConfigurationInput{
+		AccountId:            aws.String(parts[1]),
+		ExperimentTemplateId: aws.String(parts[0]),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) || tfawserr.ErrStatusCodeEquals(err, ErrCodeNotFound) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.FIS, create.ErrActionDeleting, ResNameTargetAccountConfiguration, d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindTargetAccountConfigurationByTwoPartKey(ctx context.Context, conn *fis.Client, templateID, accountID string) (*types.TargetAccountConfiguration, error) {
+	input := &fis.GetTargetAccountConfigurationInput{
+		AccountId:            aws.String(accountID),
+		ExperimentTemplateId: aws.String(templateID),
+	}
+
+	output, err := conn.GetTargetAccountConfiguration(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) || tfawserr.ErrStatusCodeEquals(err, ErrCodeNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.TargetAccountConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.TargetAccountConfiguration, nil
+}
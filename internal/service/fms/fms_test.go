@@ -23,7 +23,9 @@ func TestAccFMS_serial(t *testing.T) {
 			"cloudfrontDistribution": testAccPolicy_cloudFrontDistribution,
 			acctest.CtDisappears:     testAccPolicy_disappears,
 			"includeMap":             testAccPolicy_includeMap,
+			"networkACLCommon":       testAccPolicy_networkACLCommon,
 			"policyOption":           testAccPolicy_policyOption,
+			"resourceSetIDs":         testAccPolicy_resourceSetIDs,
 			"resourceTags":           testAccPolicy_resourceTags,
 			"securityGroup":          testAccPolicy_securityGroup,
 			"tags":                   testAccPolicy_tags,
@@ -33,6 +35,7 @@ func TestAccFMS_serial(t *testing.T) {
 			acctest.CtBasic:      testAccFMSResourceSet_basic,
 			acctest.CtDisappears: testAccFMSResourceSet_disappears,
 			"tags":               testAccFMSResourceSet_tags,
+			"update":             testAccFMSResourceSet_update,
 		},
 	}
 
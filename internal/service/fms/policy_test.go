@@ -227,6 +227,79 @@ func testAccPolicy_policyOption(t *testing.T) {
 	})
 }
 
+func testAccPolicy_networkACLCommon(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationsEnabled(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_networkACLCommon(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.type", "NETWORK_ACL_COMMON"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.force_remediate_for_first_entries", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.force_remediate_for_last_entries", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.first_entry.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.first_entry.0.cidr_block", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.first_entry.0.port_range.0.from", "443"),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.last_entry.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "security_service_policy_data.0.policy_option.0.network_acl_common_policy.0.network_acl_entry_set.0.last_entry.0.rule_action", "deny"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy_update_token", "delete_all_policy_resources"},
+			},
+		},
+	})
+}
+
+func testAccPolicy_resourceSetIDs(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fms_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, names.USEast1RegionID)
+			acctest.PreCheckOrganizationsEnabled(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyConfig_resourceSetIDs(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "resource_set_ids.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resource_set_ids.*", "aws_fms_resource_set.test", names.AttrID),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"policy_update_token", "delete_all_policy_resources"},
+			},
+		},
+	})
+}
+
 func testAccPolicy_resourceTags(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -471,6 +544,97 @@ resource "aws_wafregional_rule_group" "test" {
 `, policyName, ruleGroupName))
 }
 
+func testAccPolicyConfig_networkACLCommon(rName string) string {
+	return acctest.ConfigCompose(testAccAdminAccountConfig_basic, fmt.Sprintf(`
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  remediation_enabled   = false
+  resource_type         = "AWS::EC2::Subnet"
+
+  security_service_policy_data {
+    type = "NETWORK_ACL_COMMON"
+
+    policy_option {
+      network_acl_common_policy {
+        network_acl_entry_set {
+          force_remediate_for_first_entries = true
+          force_remediate_for_last_entries  = false
+
+          first_entry {
+            egress      = false
+            protocol    = "6"
+            rule_action = "allow"
+            cidr_block  = "10.0.0.0/8"
+
+            port_range {
+              from = 443
+              to   = 443
+            }
+          }
+
+          last_entry {
+            egress      = true
+            protocol    = "-1"
+            rule_action = "deny"
+            cidr_block  = "0.0.0.0/0"
+          }
+        }
+      }
+    }
+  }
+
+  depends_on = [aws_fms_admin_account.test]
+}
+`, rName))
+}
+
+func testAccPolicyConfig_resourceSetIDs(rName string) string {
+	return acctest.ConfigCompose(testAccAdminAccountConfig_basic, fmt.Sprintf(`
+resource "aws_fms_resource_set" "test" {
+  resource_set {
+    name               = %[1]q
+    resource_type_list = ["AWS::EC2::Subnet"]
+  }
+
+  depends_on = [aws_fms_admin_account.test]
+}
+
+resource "aws_fms_policy" "test" {
+  exclude_resource_tags = false
+  name                  = %[1]q
+  remediation_enabled   = false
+  resource_type         = "AWS::EC2::Subnet"
+  resource_set_ids      = [aws_fms_resource_set.test.id]
+
+  security_service_policy_data {
+    type = "NETWORK_ACL_COMMON"
+
+    policy_option {
+      network_acl_common_policy {
+        network_acl_entry_set {
+          force_remediate_for_first_entries = false
+          force_remediate_for_last_entries  = false
+
+          first_entry {
+            egress      = false
+            protocol    = "6"
+            rule_action = "allow"
+            cidr_block  = "10.0.0.0/8"
+
+            port_range {
+              from = 443
+              to   = 443
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
+
 func testAccPolicyConfig_cloudFrontDistribution(rName string) string {
 	return acctest.ConfigCompose(testAccAdminAccountConfig_basic, fmt.Sprintf(`
 data "aws_partition" "current" {}
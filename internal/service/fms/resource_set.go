@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/fms"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/fms/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -33,6 +34,7 @@ import (
 
 // @FrameworkResource(name="Resource Set")
 // @Tags(identifierAttribute="arn")
+// @ARNIdentity
 func newResourceResourceSet(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceResourceSet{}
 
@@ -71,23 +73,17 @@ func (r *resourceResourceSet) Schema(ctx context.Context, req resource.SchemaReq
 				"last_update_time": schema.StringAttribute{
 					Computed:   true,
 					CustomType: timetypes.RFC3339Type{},
-					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.UseStateForUnknown(),
-					},
 				},
 				"update_token": schema.StringAttribute{
 					Optional: true,
 					Computed: true,
-					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.RequiresReplace(),
-					},
 				},
 				"resource_set_status": schema.StringAttribute{
 					Optional:   true,
 					Computed:   true,
 					CustomType: fwtypes.StringEnumType[awstypes.ResourceSetStatus](),
 					PlanModifiers: []planmodifier.String{
-						stringplanmodifier.RequiresReplace(),
+						stringplanmodifier.UseStateForUnknown(),
 					},
 				},
 				"resource_type_list": schema.ListAttribute{
@@ -212,6 +208,19 @@ func (r *resourceResourceSet) Update(ctx context.Context, req resource.UpdateReq
 	if !plan.ResourceSet.Equal(state.ResourceSet) {
 		in := &fms.PutResourceSetInput{}
 		resp.Diagnostics.Append(flex.Expand(ctx, plan, in)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// The update token changes on every update, so the current value is taken from state.
+		stateResourceSet, diags := state.ResourceSet.ToPtr(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if in.ResourceSet != nil && stateResourceSet != nil {
+			in.ResourceSet.UpdateToken = stateResourceSet.UpdateToken.ValueStringPointer()
+		}
 
 		out, err := conn.PutResourceSet(ctx, in)
 		if err != nil {
@@ -288,6 +297,16 @@ func (r *resourceResourceSet) ImportState(ctx context.Context, req resource.Impo
 	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
 }
 
+func (r *resourceResourceSet) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "fms", "resource-set/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return parts[0], nil
+}
+
 func (r *resourceResourceSet) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, req, resp)
 }
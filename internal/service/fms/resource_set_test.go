@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/fms/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -53,6 +54,48 @@ func testAccFMSResourceSet_basic(t *testing.T) {
 	})
 }
 
+func testAccFMSResourceSet_update(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var resourceset fms.GetResourceSetOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_fms_resource_set.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckRegion(t, names.USEast1RegionID)
+			acctest.PreCheckOrganizationsEnabled(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.FMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckResourceSetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceSetConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceSetExists(ctx, resourceName, &resourceset),
+					resource.TestCheckResourceAttr(resourceName, "resource_set.0.resource_type_list.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccResourceSetConfig_updated(rName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceSetExists(ctx, resourceName, &resourceset),
+					resource.TestCheckResourceAttr(resourceName, "resource_set.0.description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "resource_set.0.resource_type_list.#", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
 func testAccFMSResourceSet_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -202,6 +245,25 @@ resource "aws_fms_resource_set" "test" {
 `, rName)
 }
 
+func testAccResourceSetConfig_updated(rName string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+resource "aws_fms_admin_account" "test" {
+  account_id = data.aws_caller_identity.current.account_id
+}
+
+resource "aws_fms_resource_set" "test" {
+  depends_on = [aws_fms_admin_account.test]
+  resource_set {
+    name               = %[1]q
+    description        = "updated"
+    resource_type_list = ["AWS::NetworkFirewall::Firewall", "AWS::EC2::Subnet"]
+  }
+}
+`, rName)
+}
+
 func testAccResourceSetConfig_tags1(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
@@ -0,0 +1,330 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glacier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/glacier"
+	"github.com/aws/aws-sdk-go-v2/service/glacier/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	jobTypeInventoryRetrieval = "inventory-retrieval"
+	inventoryFormatJSON       = "JSON"
+)
+
+// @SDKDataSource("aws_glacier_vault_inventory", name="Vault Inventory")
+func dataSourceVaultInventory() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceVaultInventoryRead,
+
+		Schema: map[string]*schema.Schema{
+			"archives": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"archive_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrCreationDate: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sha256_tree_hash": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"initiate_job": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"inventory_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"sns_topic": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrStatusCode: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatusMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vault_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceVaultInventoryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GlacierClient(ctx)
+
+	vaultName := d.Get("vault_name").(string)
+
+	var job *types.GlacierJobDescription
+	var err error
+
+	if v, ok := d.GetOk("job_id"); ok {
+		job, err = findJobByTwoPartKey(ctx, conn, vaultName, v.(string))
+	} else {
+		// Prefer the most recent successful inventory, then any inventory retrieval still in progress.
+		job, err = findLatestInventoryRetrievalJob(ctx, conn, vaultName, types.StatusCodeSucceeded)
+
+		if tfresource.NotFound(err) {
+			job, err = findLatestInventoryRetrievalJob(ctx, conn, vaultName, types.StatusCodeInProgress)
+		}
+
+		if tfresource.NotFound(err) && d.Get("initiate_job").(bool) {
+			job, err = initiateInventoryRetrievalJob(ctx, conn, vaultName, d.Get("sns_topic").(string))
+		}
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glacier Vault (%s) inventory retrieval job: %s", vaultName, err)
+	}
+
+	jobID := aws.ToString(job.JobId)
+	d.SetId(jobID)
+	d.Set("job_id", jobID)
+	d.Set(names.AttrStatusCode, job.StatusCode)
+	d.Set(names.AttrStatusMessage, job.StatusMessage)
+
+	// The inventory is only available once the job, which typically takes several hours, has succeeded.
+	if job.StatusCode != types.StatusCodeSucceeded {
+		d.Set("archives", nil)
+		d.Set("inventory_date", nil)
+
+		return diags
+	}
+
+	inventory, err := findVaultInventoryByTwoPartKey(ctx, conn, vaultName, jobID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Glacier Vault (%s) inventory (%s): %s", vaultName, jobID, err)
+	}
+
+	if err := d.Set("archives", flattenVaultInventoryArchives(inventory.ArchiveList)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting archives: %s", err)
+	}
+	d.Set("inventory_date", inventory.InventoryDate)
+
+	return diags
+}
+
+func initiateInventoryRetrievalJob(ctx context.Context, conn *glacier.Client, vaultName, snsTopic string) (*types.GlacierJobDescription, error) {
+	input := &glacier.InitiateJobInput{
+		JobParameters: &types.JobParameters{
+			Format: aws.String(inventoryFormatJSON),
+			Type:   aws.String(jobTypeInventoryRetrieval),
+		},
+		VaultName: aws.String(vaultName),
+	}
+
+	if snsTopic != "" {
+		input.JobParameters.SNSTopic = aws.String(snsTopic)
+	}
+
+	output, err := conn.InitiateJob(ctx, input)
+
+	if err != nil {
+		return nil, fmt.Errorf("initiating: %w", err)
+	}
+
+	return findJobByTwoPartKey(ctx, conn, vaultName, aws.ToString(output.JobId))
+}
+
+func findJobByTwoPartKey(ctx context.Context, conn *glacier.Client, vaultName, jobID string) (*types.GlacierJobDescription, error) {
+	input := &glacier.DescribeJobInput{
+		JobId:     aws.String(jobID),
+		VaultName: aws.String(vaultName),
+	}
+
+	output, err := conn.DescribeJob(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return &types.GlacierJobDescription{
+		Action:        output.Action,
+		Completed:     output.Completed,
+		CreationDate:  output.CreationDate,
+		JobId:         output.JobId,
+		StatusCode:    output.StatusCode,
+		StatusMessage: output.StatusMessage,
+		VaultARN:      output.VaultARN,
+	}, nil
+}
+
+func findLatestInventoryRetrievalJob(ctx context.Context, conn *glacier.Client, vaultName string, statusCode types.StatusCode) (*types.GlacierJobDescription, error) {
+	input := &glacier.ListJobsInput{
+		Statuscode: aws.String(string(statusCode)),
+		VaultName:  aws.String(vaultName),
+	}
+
+	jobs, err := findJobs(ctx, conn, input, func(v *types.GlacierJobDescription) bool {
+		return v.Action == types.ActionCodeInventoryRetrieval
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(jobs) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	// Creation dates are ISO 8601 timestamps, which sort lexically.
+	job := slices.MaxFunc(jobs, func(a, b types.GlacierJobDescription) int {
+		return strings.Compare(aws.ToString(a.CreationDate), aws.ToString(b.CreationDate))
+	})
+
+	return &job, nil
+}
+
+func findJobs(ctx context.Context, conn *glacier.Client, input *glacier.ListJobsInput, filter tfslices.Predicate[*types.GlacierJobDescription]) ([]types.GlacierJobDescription, error) {
+	var output []types.GlacierJobDescription
+
+	pages := glacier.NewListJobsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.JobList {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// vaultInventory is the JSON document returned as the output of an inventory retrieval job.
+type vaultInventory struct {
+	ArchiveList   []vaultInventoryArchive `json:"ArchiveList"`
+	InventoryDate string                  `json:"InventoryDate"`
+	VaultARN      string                  `json:"VaultARN"`
+}
+
+type vaultInventoryArchive struct {
+	ArchiveDescription string `json:"ArchiveDescription"`
+	ArchiveID          string `json:"ArchiveId"`
+	CreationDate       string `json:"CreationDate"`
+	SHA256TreeHash     string `json:"SHA256TreeHash"`
+	Size               int64  `json:"Size"`
+}
+
+func findVaultInventoryByTwoPartKey(ctx context.Context, conn *glacier.Client, vaultName, jobID string) (*vaultInventory, error) {
+	input := &glacier.GetJobOutputInput{
+		JobId:     aws.String(jobID),
+		VaultName: aws.String(vaultName),
+	}
+
+	output, err := conn.GetJobOutput(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Body == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	defer output.Body.Close()
+
+	var inventory vaultInventory
+	if err := json.NewDecoder(output.Body).Decode(&inventory); err != nil {
+		return nil, fmt.Errorf("decoding inventory: %w", err)
+	}
+
+	return &inventory, nil
+}
+
+func flattenVaultInventoryArchives(apiObjects []vaultInventoryArchive) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"archive_id":           apiObject.ArchiveID,
+			names.AttrCreationDate: apiObject.CreationDate,
+			names.AttrDescription:  apiObject.ArchiveDescription,
+			"sha256_tree_hash":     apiObject.SHA256TreeHash,
+			"size":                 int(apiObject.Size),
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glacier_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGlacierVaultInventoryDataSource_initiateJob(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_glacier_vault_inventory.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlacierServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckVaultDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVaultInventoryDataSourceConfig_initiateJob(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					// Inventory retrieval takes several hours, so only the job is checked.
+					resource.TestCheckResourceAttr(dataSourceName, "archives.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(dataSourceName, "inventory_date", ""),
+					resource.TestMatchResourceAttr(dataSourceName, "job_id", regexache.MustCompile(`.+`)),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatusCode, "InProgress"),
+				),
+			},
+		},
+	})
+}
+
+func testAccVaultInventoryDataSourceConfig_initiateJob(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_glacier_vault" "test" {
+  name = %[1]q
+}
+
+data "aws_glacier_vault_inventory" "test" {
+  vault_name   = aws_glacier_vault.test.name
+  initiate_job = true
+}
+`, rName)
+}
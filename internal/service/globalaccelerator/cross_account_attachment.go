@@ -32,6 +32,7 @@ import (
 
 // @FrameworkResource(name="Cross-account Attachment")
 // @Tags(identifierAttribute="id")
+// @ARNIdentity
 func newCrossAccountAttachmentResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &crossAccountAttachmentResource{}
 
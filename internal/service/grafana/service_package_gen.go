@@ -60,6 +60,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceWorkspaceSAMLConfiguration,
 			TypeName: "aws_grafana_workspace_saml_configuration",
 		},
+		{
+			Factory:  ResourceWorkspaceServiceAccount,
+			TypeName: "aws_grafana_workspace_service_account",
+			Name:     "Workspace Service Account",
+		},
+		{
+			Factory:  ResourceWorkspaceServiceAccountToken,
+			TypeName: "aws_grafana_workspace_service_account_token",
+			Name:     "Workspace Service Account Token",
+		},
 	}
 }
 
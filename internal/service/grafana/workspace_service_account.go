@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package grafana
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_grafana_workspace_service_account", name="Workspace Service Account")
+func ResourceWorkspaceServiceAccount() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWorkspaceServiceAccountCreate,
+		ReadWithoutTimeout:   resourceWorkspaceServiceAccountRead,
+		DeleteWithoutTimeout: resourceWorkspaceServiceAccountDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"grafana_role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(managedgrafana.Role_Values(), false),
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"service_account_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const (
+	workspaceServiceAccountResourceIDPartCount = 2
+)
+
+func resourceWorkspaceServiceAccountCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GrafanaConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	workspaceID := d.Get("workspace_id").(string)
+	input := &managedgrafana.CreateWorkspaceServiceAccountInput{
+		GrafanaRole: aws.String(d.Get("grafana_role").(string)),
+		Name:        aws.String(name),
+		WorkspaceId: aws.String(workspaceID),
+	}
+
+	output, err := conn.CreateWorkspaceServiceAccountWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Grafana Workspace Service Account (%s): %s", name, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{workspaceID, aws.StringValue(output.Id)}, workspaceServiceAccountResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	return append(diags, resourceWorkspaceServiceAccountRead(ctx, d, meta)...)
+}
+
+func resourceWorkspaceServiceAccountRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GrafanaConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), workspaceServiceAccountResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	workspaceID, serviceAccountID := parts[0], parts[1]
+	output, err := FindWorkspaceServiceAccountByTwoPartKey(ctx, conn, workspaceID, serviceAccountID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Grafana Workspace Service Account (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Grafana Workspace Service Account (%s): %s", d.Id(), err)
+	}
+
+	d.Set("grafana_role", output.GrafanaRole)
+	d.Set(names.AttrName, output.Name)
+	d.Set("service_account_id", output.Id)
+	d.Set("workspace_id", workspaceID)
+
+	return diags
+}
+
+func resourceWorkspaceServiceAccountDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GrafanaConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), workspaceServiceAccountResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Grafana Workspace Service Account: %s", d.Id())
+	_, err = conn.DeleteWorkspaceServiceAccountWithContext(ctx, &managedgrafana.DeleteWorkspaceServiceAccountInput{
+		ServiceAccountId: aws.String(parts[1]),
+		WorkspaceId:      aws.String(parts[0]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Grafana Workspace Service Account (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindWorkspaceServiceAccountByTwoPartKey(ctx context.Context, conn *managedgrafana.ManagedGrafana, workspaceID, serviceAccountID string) (*managedgrafana.ServiceAccountSummary, error) {
+	input := &managedgrafana.ListWorkspaceServiceAccountsInput{
+		WorkspaceId: aws.String(workspaceID),
+	}
+
+	return findWorkspaceServiceAccount(ctx, conn, input, func(v *managedgrafana.ServiceAccountSummary) bool {
+		return aws.StringValue(v.Id) == serviceAccountID
+	})
+}
+
+func findWorkspaceServiceAccount(ctx context.Context, conn *managedgrafana.ManagedGrafana, input *managedgrafana.ListWorkspaceServiceAccountsInput, filter tfslices.Predicate[*managedgrafana.ServiceAccountSummary]) (*managedgrafana.ServiceAccountSummary, error) {
+	output, err := findWorkspaceServiceAccounts(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findWorkspaceServiceAccounts(ctx context.Context, conn *managedgrafana.ManagedGrafana, input *managedgrafana.ListWorkspaceServiceAccountsInput, filter tfslices.Predicate[*managedgrafana.ServiceAccountSummary]) ([]*managedgrafana.ServiceAccountSummary, error) {
+	var output []*managedgrafana.ServiceAccountSummary
+
+	err := conn.ListWorkspaceServiceAccountsPagesWithContext(ctx, input, func(page *managedgrafana.ListWorkspaceServiceAccountsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ServiceAccounts {
+			if v != nil && filter(v) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
@@ -0,0 +1,223 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package grafana
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_grafana_workspace_service_account_token", name="Workspace Service Account Token")
+func ResourceWorkspaceServiceAccountToken() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceWorkspaceServiceAccountTokenCreate,
+		ReadWithoutTimeout:   resourceWorkspaceServiceAccountTokenRead,
+		DeleteWithoutTimeout: resourceWorkspaceServiceAccountTokenDelete,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrCreatedAt: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrKey: {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 128),
+			},
+			"seconds_to_live": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 2592000),
+			},
+			"service_account_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"service_account_token_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+const (
+	workspaceServiceAccountTokenResourceIDPartCount = 3
+)
+
+func resourceWorkspaceServiceAccountTokenCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GrafanaConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	serviceAccountID := d.Get("service_account_id").(string)
+	workspaceID := d.Get("workspace_id").(string)
+	input := &managedgrafana.CreateWorkspaceServiceAccountTokenInput{
+		Name:             aws.String(name),
+		SecondsToLive:    aws.Int64(int64(d.Get("seconds_to_live").(int))),
+		ServiceAccountId: aws.String(serviceAccountID),
+		WorkspaceId:      aws.String(workspaceID),
+	}
+
+	output, err := conn.CreateWorkspaceServiceAccountTokenWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Grafana Workspace Service Account Token (%s): %s", name, err)
+	}
+
+	id, err := flex.FlattenResourceId([]string{workspaceID, serviceAccountID, aws.StringValue(output.ServiceAccountToken.Id)}, workspaceServiceAccountTokenResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+	// The key is only returned on creation.
+	d.Set(names.AttrKey, output.ServiceAccountToken.Key)
+
+	return append(diags, resourceWorkspaceServiceAccountTokenRead(ctx, d, meta)...)
+}
+
+func resourceWorkspaceServiceAccountTokenRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GrafanaConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), workspaceServiceAccountTokenResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	workspaceID, serviceAccountID, tokenID := parts[0], parts[1], parts[2]
+	output, err := FindWorkspaceServiceAccountTokenByThreePartKey(ctx, conn, workspaceID, serviceAccountID, tokenID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Grafana Workspace Service Account Token (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Grafana Workspace Service Account Token (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrCreatedAt, aws.TimeValue(output.CreatedAt).Format(time.RFC3339))
+	d.Set("expires_at", aws.TimeValue(output.ExpiresAt).Format(time.RFC3339))
+	d.Set(names.AttrName, output.Name)
+	d.Set("service_account_id", serviceAccountID)
+	d.Set("service_account_token_id", output.Id)
+	d.Set("workspace_id", workspaceID)
+
+	return diags
+}
+
+func resourceWorkspaceServiceAccountTokenDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).GrafanaConn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), workspaceServiceAccountTokenResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Grafana Workspace Service Account Token: %s", d.Id())
+	_, err = conn.DeleteWorkspaceServiceAccountTokenWithContext(ctx, &managedgrafana.DeleteWorkspaceServiceAccountTokenInput{
+		ServiceAccountId: aws.String(parts[1]),
+		TokenId:          aws.String(parts[2]),
+		WorkspaceId:      aws.String(parts[0]),
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Grafana Workspace Service Account Token (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindWorkspaceServiceAccountTokenByThreePartKey(ctx context.Context, conn *managedgrafana.ManagedGrafana, workspaceID, serviceAccountID, tokenID string) (*managedgrafana.ServiceAccountTokenSummary, error) {
+	input := &managedgrafana.ListWorkspaceServiceAccountTokensInput{
+		ServiceAccountId: aws.String(serviceAccountID),
+		WorkspaceId:      aws.String(workspaceID),
+	}
+
+	return findWorkspaceServiceAccountToken(ctx, conn, input, func(v *managedgrafana.ServiceAccountTokenSummary) bool {
+		return aws.StringValue(v.Id) == tokenID
+	})
+}
+
+func findWorkspaceServiceAccountToken(ctx context.Context, conn *managedgrafana.ManagedGrafana, input *managedgrafana.ListWorkspaceServiceAccountTokensInput, filter tfslices.Predicate[*managedgrafana.ServiceAccountTokenSummary]) (*managedgrafana.ServiceAccountTokenSummary, error) {
+	output, err := findWorkspaceServiceAccountTokens(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSinglePtrResult(output)
+}
+
+func findWorkspaceServiceAccountTokens(ctx context.Context, conn *managedgrafana.ManagedGrafana, input *managedgrafana.ListWorkspaceServiceAccountTokensInput, filter tfslices.Predicate[*managedgrafana.ServiceAccountTokenSummary]) ([]*managedgrafana.ServiceAccountTokenSummary, error) {
+	var output []*managedgrafana.ServiceAccountTokenSummary
+
+	err := conn.ListWorkspaceServiceAccountTokensPagesWithContext(ctx, input, func(page *managedgrafana.ListWorkspaceServiceAccountTokensOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ServiceAccountTokens {
+			if v != nil && filter(v) {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, managedgrafana.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
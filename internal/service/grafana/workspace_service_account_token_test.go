@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package grafana_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgrafana "github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccWorkspaceServiceAccountToken_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v managedgrafana.ServiceAccountTokenSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_grafana_workspace_service_account_token.test"
+	serviceAccountResourceName := "aws_grafana_workspace_service_account.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, managedgrafana.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GrafanaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkspaceServiceAccountTokenDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceServiceAccountTokenConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWorkspaceServiceAccountTokenExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRFC3339(resourceName, names.AttrCreatedAt),
+					acctest.CheckResourceAttrRFC3339(resourceName, "expires_at"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrKey),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "seconds_to_live", "3600"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_account_id", serviceAccountResourceName, "service_account_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_account_token_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "workspace_id", serviceAccountResourceName, "workspace_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWorkspaceServiceAccountToken_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v managedgrafana.ServiceAccountTokenSummary
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_grafana_workspace_service_account_token.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, managedgrafana.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GrafanaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkspaceServiceAccountTokenDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceServiceAccountTokenConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkspaceServiceAccountTokenExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfgrafana.ResourceWorkspaceServiceAccountToken(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckWorkspaceServiceAccountTokenExists(ctx context.Context, n string, v *managedgrafana.ServiceAccountTokenSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GrafanaConn(ctx)
+
+		output, err := tfgrafana.FindWorkspaceServiceAccountTokenByThreePartKey(ctx, conn, rs.Primary.Attributes["workspace_id"], rs.Primary.Attributes["service_account_id"], rs.Primary.Attributes["service_account_token_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckWorkspaceServiceAccountTokenDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GrafanaConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_grafana_workspace_service_account_token" {
+				continue
+			}
+
+			_, err := tfgrafana.FindWorkspaceServiceAccountTokenByThreePartKey(ctx, conn, rs.Primary.Attributes["workspace_id"], rs.Primary.Attributes["service_account_id"], rs.Primary.Attributes["service_account_token_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Grafana Workspace Service Account Token %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccWorkspaceServiceAccountTokenConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkspaceServiceAccountConfig_basic(rName), fmt.Sprintf(`
+resource "aws_grafana_workspace_service_account_token" "test" {
+  name               = %[1]q
+  seconds_to_live    = 3600
+  service_account_id = aws_grafana_workspace_service_account.test.service_account_id
+  workspace_id       = aws_grafana_workspace.test.id
+}
+`, rName))
+}
@@ -0,0 +1,812 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/groundstation/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Config")
+// @Tags(identifierAttribute="arn")
+// @ARNIdentity
+func newConfigResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &configResource{}, nil
+}
+
+type configResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *configResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_groundstation_config"
+}
+
+func (r *configResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	// The config type can't be changed, so switching between config data blocks forces a new resource.
+	configDataPlanModifiers := []planmodifier.List{
+		listplanmodifier.RequiresReplaceIf(configDataReplaceIf, "Replace when the config type changes", "Replace when the config type changes"),
+	}
+	configDataValidators := func(validators ...validator.List) []validator.List {
+		return append([]validator.List{
+			listvalidator.SizeAtMost(1),
+			listvalidator.ExactlyOneOf(
+				path.MatchRelative().AtParent().AtName("antenna_downlink_config"),
+				path.MatchRelative().AtParent().AtName("antenna_downlink_demod_decode_config"),
+				path.MatchRelative().AtParent().AtName("antenna_uplink_config"),
+				path.MatchRelative().AtParent().AtName("dataflow_endpoint_config"),
+				path.MatchRelative().AtParent().AtName("s3_recording_config"),
+				path.MatchRelative().AtParent().AtName("tracking_config"),
+				path.MatchRelative().AtParent().AtName("uplink_echo_config"),
+			),
+		}, validators...)
+	}
+	unvalidatedJSONBlock := func() schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[unvalidatedJSONModel](ctx),
+			Validators: []validator.List{
+				listvalidator.IsRequired(),
+				listvalidator.SizeAtLeast(1),
+				listvalidator.SizeAtMost(1),
+			},
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					"unvalidated_json": schema.StringAttribute{
+						Required: true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"config_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"config_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ConfigCapabilityType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"config_data": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[configDataModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"antenna_downlink_config": schema.ListNestedBlock{
+							CustomType:    fwtypes.NewListNestedObjectTypeOf[antennaDownlinkConfigModel](ctx),
+							PlanModifiers: configDataPlanModifiers,
+							Validators:    configDataValidators(),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"spectrum_config": spectrumConfigBlock(ctx),
+								},
+							},
+						},
+						"antenna_downlink_demod_decode_config": schema.ListNestedBlock{
+							CustomType:    fwtypes.NewListNestedObjectTypeOf[antennaDownlinkDemodDecodeConfigModel](ctx),
+							PlanModifiers: configDataPlanModifiers,
+							Validators:    configDataValidators(),
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"decode_config":       unvalidatedJSONBlock(),
+									"demodulation_config": unvalidatedJSONBlock(),
+									"spectrum_config":     spectrumConfigBlock(ctx),
+								},
+							},
+						},
+						"antenna_uplink_config": schema.ListNestedBlock{
+							CustomType:    fwtypes.NewListNestedObjectTypeOf[antennaUplinkConfigModel](ctx),
+							PlanModifiers: configDataPlanModifiers,
+							Validators:    configDataValidators(),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"transmit_disabled": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"spectrum_config": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[uplinkSpectrumConfigModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"polarization": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.Polarization](),
+													Optional:   true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"center_frequency": frequencyBlock[frequencyModel](ctx, enumValuesFrequencyUnits()),
+											},
+										},
+									},
+									"target_eirp": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[eirpModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"units": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.EirpUnits](),
+													Required:   true,
+												},
+												names.AttrValue: schema.Float64Attribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"dataflow_endpoint_config": schema.ListNestedBlock{
+							CustomType:    fwtypes.NewListNestedObjectTypeOf[dataflowEndpointConfigModel](ctx),
+							PlanModifiers: configDataPlanModifiers,
+							Validators:    configDataValidators(),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"dataflow_endpoint_name": schema.StringAttribute{
+										Required: true,
+									},
+									"dataflow_endpoint_region": schema.StringAttribute{
+										Optional: true,
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+							},
+						},
+						"s3_recording_config": schema.ListNestedBlock{
+							CustomType:    fwtypes.NewListNestedObjectTypeOf[s3RecordingConfigModel](ctx),
+							PlanModifiers: configDataPlanModifiers,
+							Validators:    configDataValidators(),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"bucket_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									names.AttrPrefix: schema.StringAttribute{
+										Optional: true,
+									},
+									names.AttrRoleARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+						"tracking_config": schema.ListNestedBlock{
+							CustomType:    fwtypes.NewListNestedObjectTypeOf[trackingConfigModel](ctx),
+							PlanModifiers: configDataPlanModifiers,
+							Validators:    configDataValidators(),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"autotrack": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Criticality](),
+										Required:   true,
+									},
+								},
+							},
+						},
+						"uplink_echo_config": schema.ListNestedBlock{
+							CustomType:    fwtypes.NewListNestedObjectTypeOf[uplinkEchoConfigModel](ctx),
+							PlanModifiers: configDataPlanModifiers,
+							Validators:    configDataValidators(),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"antenna_uplink_config_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									names.AttrEnabled: schema.BoolAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *configResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data configResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	configData, diags := expandConfigTypeData(ctx, data.ConfigData)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	input := &groundstation.CreateConfigInput{
+		ConfigData: configData,
+		Name:       aws.String(name),
+		Tags:       getTagsIn(ctx),
+	}
+
+	output, err := conn.CreateConfig(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Ground Station Config (%s)", name), err.Error())
+
+		return
+	}
+
+	data.ConfigID = fwflex.StringToFramework(ctx, output.ConfigId)
+	data.ConfigType = fwtypes.StringEnumValue(output.ConfigType)
+	data.setID()
+
+	config, err := findConfigByTwoPartKey(ctx, conn, data.ConfigID.ValueString(), data.ConfigType.ValueEnum())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *configResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data configResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	output, err := findConfigByTwoPartKey(ctx, conn, data.ConfigID.ValueString(), data.ConfigType.ValueEnum())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Ground Station Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *configResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new configResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	if !new.ConfigData.Equal(old.ConfigData) || !new.Name.Equal(old.Name) {
+		configData, diags := expandConfigTypeData(ctx, new.ConfigData)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input := &groundstation.UpdateConfigInput{
+			ConfigData: configData,
+			ConfigId:   aws.String(old.ConfigID.ValueString()),
+			ConfigType: old.ConfigType.ValueEnum(),
+			Name:       aws.String(new.Name.ValueString()),
+		}
+
+		_, err := conn.UpdateConfig(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Ground Station Config (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	new.ConfigType = old.ConfigType
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *configResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data configResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().GroundStationClient(ctx)
+
+	_, err := conn.DeleteConfig(ctx, &groundstation.DeleteConfigInput{
+		ConfigId:   aws.String(data.ConfigID.ValueString()),
+		ConfigType: data.ConfigType.ValueEnum(),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Ground Station Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *configResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func configDataReplaceIf(_ context.Context, request planmodifier.ListRequest, response *listplanmodifier.RequiresReplaceIfFuncResponse) {
+	if request.State.Raw.IsNull() || request.Plan.Raw.IsNull() {
+		return
+	}
+
+	response.RequiresReplace = request.StateValue.IsNull() != request.PlanValue.IsNull()
+}
+
+func findConfigByTwoPartKey(ctx context.Context, conn *groundstation.Client, configID string, configType awstypes.ConfigCapabilityType) (*groundstation.GetConfigOutput, error) {
+	input := &groundstation.GetConfigInput{
+		ConfigId:   aws.String(configID),
+		ConfigType: configType,
+	}
+
+	output, err := conn.GetConfig(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func (r *configResource) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "groundstation", "config/*/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return flex.FlattenResourceId([]string{parts[1], parts[0]}, configResourceIDPartCount, false)
+}
+
+type configResourceModel struct {
+	ARN        types.String                                      `tfsdk:"arn"`
+	ConfigData fwtypes.ListNestedObjectValueOf[configDataModel]  `tfsdk:"config_data"`
+	ConfigID   types.String                                      `tfsdk:"config_id"`
+	ConfigType fwtypes.StringEnum[awstypes.ConfigCapabilityType] `tfsdk:"config_type"`
+	ID         types.String                                      `tfsdk:"id"`
+	Name       types.String                                      `tfsdk:"name"`
+	Tags       types.Map                                         `tfsdk:"tags"`
+	TagsAll    types.Map                                         `tfsdk:"tags_all"`
+}
+
+const (
+	configResourceIDPartCount = 2
+)
+
+func (data *configResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), configResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ConfigID = types.StringValue(parts[0])
+	data.ConfigType = fwtypes.StringEnumValue(awstypes.ConfigCapabilityType(parts[1]))
+
+	return nil
+}
+
+func (data *configResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.ConfigID.ValueString(), string(data.ConfigType.ValueEnum())}, configResourceIDPartCount, false)))
+}
+
+func (data *configResourceModel) flatten(ctx context.Context, apiObject *groundstation.GetConfigOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.ARN = fwflex.StringToFramework(ctx, apiObject.ConfigArn)
+	data.ConfigID = fwflex.StringToFramework(ctx, apiObject.ConfigId)
+	data.ConfigType = fwtypes.StringEnumValue(apiObject.ConfigType)
+	data.Name = fwflex.StringToFramework(ctx, apiObject.Name)
+	data.setID()
+
+	configData, d := flattenConfigTypeData(ctx, apiObject.ConfigData)
+	diags.Append(d...)
+	data.ConfigData = configData
+
+	return diags
+}
+
+func expandConfigTypeData(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[configDataModel]) (awstypes.ConfigTypeData, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfObject, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || tfObject == nil {
+		return nil, diags
+	}
+
+	switch {
+	case !tfObject.AntennaDownlinkConfig.IsNull():
+		data, d := tfObject.AntennaDownlinkConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.ConfigTypeDataMemberAntennaDownlinkConfig
+		diags.Append(fwflex.Expand(ctx, data, &apiObject.Value)...)
+
+		return &apiObject, diags
+
+	case !tfObject.AntennaDownlinkDemodDecodeConfig.IsNull():
+		data, d := tfObject.AntennaDownlinkDemodDecodeConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.ConfigTypeDataMemberAntennaDownlinkDemodDecodeConfig
+		diags.Append(fwflex.Expand(ctx, data, &apiObject.Value)...)
+
+		return &apiObject, diags
+
+	case !tfObject.AntennaUplinkConfig.IsNull():
+		data, d := tfObject.AntennaUplinkConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.ConfigTypeDataMemberAntennaUplinkConfig
+		diags.Append(fwflex.Expand(ctx, data, &apiObject.Value)...)
+
+		return &apiObject, diags
+
+	case !tfObject.DataflowEndpointConfig.IsNull():
+		data, d := tfObject.DataflowEndpointConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.ConfigTypeDataMemberDataflowEndpointConfig
+		diags.Append(fwflex.Expand(ctx, data, &apiObject.Value)...)
+
+		return &apiObject, diags
+
+	case !tfObject.S3RecordingConfig.IsNull():
+		data, d := tfObject.S3RecordingConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.ConfigTypeDataMemberS3RecordingConfig
+		diags.Append(fwflex.Expand(ctx, data, &apiObject.Value)...)
+
+		return &apiObject, diags
+
+	case !tfObject.TrackingConfig.IsNull():
+		data, d := tfObject.TrackingConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.ConfigTypeDataMemberTrackingConfig
+		diags.Append(fwflex.Expand(ctx, data, &apiObject.Value)...)
+
+		return &apiObject, diags
+
+	case !tfObject.UplinkEchoConfig.IsNull():
+		data, d := tfObject.UplinkEchoConfig.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.ConfigTypeDataMemberUplinkEchoConfig
+		diags.Append(fwflex.Expand(ctx, data, &apiObject.Value)...)
+
+		return &apiObject, diags
+	}
+
+	return nil, diags
+}
+
+func flattenConfigTypeData(ctx context.Context, apiObject awstypes.ConfigTypeData) (fwtypes.ListNestedObjectValueOf[configDataModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfObject := configDataModel{
+		AntennaDownlinkConfig:            fwtypes.NewListNestedObjectValueOfNull[antennaDownlinkConfigModel](ctx),
+		AntennaDownlinkDemodDecodeConfig: fwtypes.NewListNestedObjectValueOfNull[antennaDownlinkDemodDecodeConfigModel](ctx),
+		AntennaUplinkConfig:              fwtypes.NewListNestedObjectValueOfNull[antennaUplinkConfigModel](ctx),
+		DataflowEndpointConfig:           fwtypes.NewListNestedObjectValueOfNull[dataflowEndpointConfigModel](ctx),
+		S3RecordingConfig:                fwtypes.NewListNestedObjectValueOfNull[s3RecordingConfigModel](ctx),
+		TrackingConfig:                   fwtypes.NewListNestedObjectValueOfNull[trackingConfigModel](ctx),
+		UplinkEchoConfig:                 fwtypes.NewListNestedObjectValueOfNull[uplinkEchoConfigModel](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.ConfigTypeDataMemberAntennaDownlinkConfig:
+		var data antennaDownlinkConfigModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &data)...)
+		tfObject.AntennaDownlinkConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case *awstypes.ConfigTypeDataMemberAntennaDownlinkDemodDecodeConfig:
+		var data antennaDownlinkDemodDecodeConfigModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &data)...)
+		tfObject.AntennaDownlinkDemodDecodeConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case *awstypes.ConfigTypeDataMemberAntennaUplinkConfig:
+		var data antennaUplinkConfigModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &data)...)
+		tfObject.AntennaUplinkConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case *awstypes.ConfigTypeDataMemberDataflowEndpointConfig:
+		var data dataflowEndpointConfigModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &data)...)
+		tfObject.DataflowEndpointConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case *awstypes.ConfigTypeDataMemberS3RecordingConfig:
+		var data s3RecordingConfigModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &data)...)
+		tfObject.S3RecordingConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case *awstypes.ConfigTypeDataMemberTrackingConfig:
+		var data trackingConfigModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &data)...)
+		tfObject.TrackingConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	case *awstypes.ConfigTypeDataMemberUplinkEchoConfig:
+		var data uplinkEchoConfigModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &data)...)
+		tfObject.UplinkEchoConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data)
+
+	default:
+		return fwtypes.NewListNestedObjectValueOfNull[configDataModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &tfObject), diags
+}
+
+func spectrumConfigBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[spectrumConfigModel](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"polarization": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.Polarization](),
+					Optional:   true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"bandwidth":        frequencyBlock[frequencyBandwidthModel](ctx, enumValuesBandwidthUnits()),
+				"center_frequency": frequencyBlock[frequencyModel](ctx, enumValuesFrequencyUnits()),
+			},
+		},
+	}
+}
+
+func frequencyBlock[T any](ctx context.Context, units []string) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[T](ctx),
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"units": schema.StringAttribute{
+					Required: true,
+					Validators: []validator.String{
+						stringvalidator.OneOf(units...),
+					},
+				},
+				names.AttrValue: schema.Float64Attribute{
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+// The SDK's frequency and bandwidth unit values are mixed case (e.g. "MHz"), which the generic enum types don't support.
+func enumValuesBandwidthUnits() []string {
+	return enum.Values[awstypes.BandwidthUnits]()
+}
+
+func enumValuesFrequencyUnits() []string {
+	return enum.Values[awstypes.FrequencyUnits]()
+}
+
+type configDataModel struct {
+	AntennaDownlinkConfig            fwtypes.ListNestedObjectValueOf[antennaDownlinkConfigModel]            `tfsdk:"antenna_downlink_config"`
+	AntennaDownlinkDemodDecodeConfig fwtypes.ListNestedObjectValueOf[antennaDownlinkDemodDecodeConfigModel] `tfsdk:"antenna_downlink_demod_decode_config"`
+	AntennaUplinkConfig              fwtypes.ListNestedObjectValueOf[antennaUplinkConfigModel]              `tfsdk:"antenna_uplink_config"`
+	DataflowEndpointConfig           fwtypes.ListNestedObjectValueOf[dataflowEndpointConfigModel]           `tfsdk:"dataflow_endpoint_config"`
+	S3RecordingConfig                fwtypes.ListNestedObjectValueOf[s3RecordingConfigModel]                `tfsdk:"s3_recording_config"`
+	TrackingConfig                   fwtypes.ListNestedObjectValueOf[trackingConfigModel]                   `tfsdk:"tracking_config"`
+	UplinkEchoConfig                 fwtypes.ListNestedObjectValueOf[uplinkEchoConfigModel]                 `tfsdk:"uplink_echo_config"`
+}
+
+type antennaDownlinkConfigModel struct {
+	SpectrumConfig fwtypes.ListNestedObjectValueOf[spectrumConfigModel] `tfsdk:"spectrum_config"`
+}
+
+type antennaDownlinkDemodDecodeConfigModel struct {
+	DecodeConfig       fwtypes.ListNestedObjectValueOf[unvalidatedJSONModel] `tfsdk:"decode_config"`
+	DemodulationConfig fwtypes.ListNestedObjectValueOf[unvalidatedJSONModel] `tfsdk:"demodulation_config"`
+	SpectrumConfig     fwtypes.ListNestedObjectValueOf[spectrumConfigModel]  `tfsdk:"spectrum_config"`
+}
+
+type antennaUplinkConfigModel struct {
+	SpectrumConfig   fwtypes.ListNestedObjectValueOf[uplinkSpectrumConfigModel] `tfsdk:"spectrum_config"`
+	TargetEirp       fwtypes.ListNestedObjectValueOf[eirpModel]                 `tfsdk:"target_eirp"`
+	TransmitDisabled types.Bool                                                 `tfsdk:"transmit_disabled"`
+}
+
+type dataflowEndpointConfigModel struct {
+	DataflowEndpointName   types.String `tfsdk:"dataflow_endpoint_name"`
+	DataflowEndpointRegion types.String `tfsdk:"dataflow_endpoint_region"`
+}
+
+type s3RecordingConfigModel struct {
+	BucketARN fwtypes.ARN  `tfsdk:"bucket_arn"`
+	Prefix    types.String `tfsdk:"prefix"`
+	RoleARN   fwtypes.ARN  `tfsdk:"role_arn"`
+}
+
+type trackingConfigModel struct {
+	Autotrack fwtypes.StringEnum[awstypes.Criticality] `tfsdk:"autotrack"`
+}
+
+type uplinkEchoConfigModel struct {
+	AntennaUplinkConfigARN fwtypes.ARN `tfsdk:"antenna_uplink_config_arn"`
+	Enabled                types.Bool  `tfsdk:"enabled"`
+}
+
+type spectrumConfigModel struct {
+	Bandwidth       fwtypes.ListNestedObjectValueOf[frequencyBandwidthModel] `tfsdk:"bandwidth"`
+	CenterFrequency fwtypes.ListNestedObjectValueOf[frequencyModel]          `tfsdk:"center_frequency"`
+	Polarization    fwtypes.StringEnum[awstypes.Polarization]                `tfsdk:"polarization"`
+}
+
+type uplinkSpectrumConfigModel struct {
+	CenterFrequency fwtypes.ListNestedObjectValueOf[frequencyModel] `tfsdk:"center_frequency"`
+	Polarization    fwtypes.StringEnum[awstypes.Polarization]       `tfsdk:"polarization"`
+}
+
+type frequencyModel struct {
+	Units types.String  `tfsdk:"units"`
+	Value types.Float64 `tfsdk:"value"`
+}
+
+type frequencyBandwidthModel struct {
+	Units types.String  `tfsdk:"units"`
+	Value types.Float64 `tfsdk:"value"`
+}
+
+type eirpModel struct {
+	Units fwtypes.StringEnum[awstypes.EirpUnits] `tfsdk:"units"`
+	Value types.Float64                          `tfsdk:"value"`
+}
+
+type unvalidatedJSONModel struct {
+	UnvalidatedJSON types.String `tfsdk:"unvalidated_json"`
+}
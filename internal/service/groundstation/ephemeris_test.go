@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgroundstation "github.com/hashicorp/terraform-provider-aws/internal/service/groundstation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Ephemerides can only be provided for satellites onboarded to the account,
+// so the tests require an existing satellite ID.
+const envVarSatelliteID = "GROUNDSTATION_SATELLITE_ID"
+
+func TestAccGroundStationEphemeris_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	satelliteID := acctest.SkipIfEnvVarNotSet(t, envVarSatelliteID)
+	var ephemeris groundstation.DescribeEphemerisOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_ephemeris.test"
+	startTime := time.Now().UTC().Truncate(time.Hour)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEphemerisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemerisConfig_basic(rName, satelliteID, startTime, true, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEphemerisExists(ctx, resourceName, &ephemeris),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "groundstation", regexache.MustCompile(`ephemeris/.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "ephemeris.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ephemeris.0.tle.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "ephemeris.0.tle.0.tle_data.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "satellite_id", satelliteID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ephemeris", names.AttrTimeouts},
+			},
+			{
+				Config: testAccEphemerisConfig_basic(rNameUpdated, satelliteID, startTime, false, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEphemerisExists(ctx, resourceName, &ephemeris),
+					resource.TestCheckResourceAttr(resourceName, names.AttrEnabled, acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DISABLED"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroundStationEphemeris_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	satelliteID := acctest.SkipIfEnvVarNotSet(t, envVarSatelliteID)
+	var ephemeris groundstation.DescribeEphemerisOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_ephemeris.test"
+	startTime := time.Now().UTC().Truncate(time.Hour)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEphemerisDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEphemerisConfig_basic(rName, satelliteID, startTime, true, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckEphemerisExists(ctx, resourceName, &ephemeris),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfgroundstation.ResourceEphemeris, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEphemerisDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_groundstation_ephemeris" {
+				continue
+			}
+
+			_, err := tfgroundstation.FindEphemerisByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Ground Station Ephemeris %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEphemerisExists(ctx context.Context, n string, v *groundstation.DescribeEphemerisOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		output, err := tfgroundstation.FindEphemerisByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEphemerisConfig_basic(rName, satelliteID string, startTime time.Time, enabled bool, priority int) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_ephemeris" "test" {
+  name         = %[1]q
+  satellite_id = %[2]q
+  enabled      = %[5]t
+  priority     = %[6]d
+
+  ephemeris {
+    tle {
+      tle_data {
+        tle_line1 = "1 25544U 98067A   24001.50000000  .00016717  00000-0  10270-3 0  9005"
+        tle_line2 = "2 25544  51.6416 247.4627 0006703 130.5360 325.0288 15.50377579 25401"
+
+        valid_time_range {
+          start_time = %[3]q
+          end_time   = %[4]q
+        }
+      }
+    }
+  }
+}
+`, rName, satelliteID, startTime.Format(time.RFC3339), startTime.Add(72*time.Hour).Format(time.RFC3339), enabled, priority)
+}
@@ -0,0 +1,357 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package groundstation_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/groundstation"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgroundstation "github.com/hashicorp/terraform-provider-aws/internal/service/groundstation"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGroundStationMissionProfile_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var missionProfile groundstation.GetMissionProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_mission_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMissionProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMissionProfileConfig_basic(rName, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMissionProfileExists(ctx, resourceName, &missionProfile),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "groundstation", regexache.MustCompile(`mission-profile/.+`)),
+					resource.TestCheckResourceAttrSet(resourceName, "contact_post_pass_duration_seconds"),
+					resource.TestCheckResourceAttrSet(resourceName, "contact_pre_pass_duration_seconds"),
+					resource.TestCheckResourceAttr(resourceName, "dataflow_edge.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "dataflow_edge.0.destination", "aws_groundstation_config.destination", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "dataflow_edge.0.source", "aws_groundstation_config.source", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "minimum_viable_contact_duration_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "streams_kms_key.#", acctest.Ct0),
+					resource.TestCheckNoResourceAttr(resourceName, "streams_kms_role"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+					resource.TestCheckResourceAttrPair(resourceName, "tracking_config_arn", "aws_groundstation_config.tracking", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMissionProfileConfig_basic(rName, 120),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMissionProfileExists(ctx, resourceName, &missionProfile),
+					resource.TestCheckResourceAttr(resourceName, "minimum_viable_contact_duration_seconds", "120"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroundStationMissionProfile_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var missionProfile groundstation.GetMissionProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_mission_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMissionProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMissionProfileConfig_basic(rName, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMissionProfileExists(ctx, resourceName, &missionProfile),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfgroundstation.ResourceMissionProfile, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGroundStationMissionProfile_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var missionProfile groundstation.GetMissionProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_mission_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMissionProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMissionProfileConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMissionProfileExists(ctx, resourceName, &missionProfile),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMissionProfileConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMissionProfileExists(ctx, resourceName, &missionProfile),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccMissionProfileConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMissionProfileExists(ctx, resourceName, &missionProfile),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGroundStationMissionProfile_streamsKMSKey(t *testing.T) {
+	ctx := acctest.Context(t)
+	var missionProfile groundstation.GetMissionProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_groundstation_mission_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GroundStationServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMissionProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMissionProfileConfig_streamsKMSKey(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMissionProfileExists(ctx, resourceName, &missionProfile),
+					resource.TestCheckResourceAttr(resourceName, "streams_kms_key.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "streams_kms_key.0.kms_key_arn", "aws_kms_key.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "streams_kms_role", "aws_iam_role.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMissionProfileDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_groundstation_mission_profile" {
+				continue
+			}
+
+			_, err := tfgroundstation.FindMissionProfileByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Ground Station Mission Profile %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMissionProfileExists(ctx context.Context, n string, v *groundstation.GetMissionProfileOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GroundStationClient(ctx)
+
+		output, err := tfgroundstation.FindMissionProfileByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccMissionProfileConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_groundstation_config" "tracking" {
+  name = "%[1]s-tracking"
+
+  config_data {
+    tracking_config {
+      autotrack = "PREFERRED"
+    }
+  }
+}
+
+resource "aws_groundstation_config" "source" {
+  name = "%[1]s-downlink"
+
+  config_data {
+    antenna_downlink_config {
+      spectrum_config {
+        polarization = "RIGHT_HAND"
+
+        bandwidth {
+          units = "MHz"
+          value = 30
+        }
+
+        center_frequency {
+          units = "MHz"
+          value = 7812
+        }
+      }
+    }
+  }
+}
+
+resource "aws_groundstation_config" "destination" {
+  name = "%[1]s-dataflow"
+
+  config_data {
+    dataflow_endpoint_config {
+      dataflow_endpoint_name = "%[1]s-endpoint"
+    }
+  }
+}
+`, rName)
+}
+
+func testAccMissionProfileConfig_basic(rName string, minimumViableContactDuration int) string {
+	return acctest.ConfigCompose(testAccMissionProfileConfig_base(rName), fmt.Sprintf(`
+resource "aws_groundstation_mission_profile" "test" {
+  name                                    = %[1]q
+  minimum_viable_contact_duration_seconds = %[2]d
+  tracking_config_arn                     = aws_groundstation_config.tracking.arn
+
+  dataflow_edge {
+    source      = aws_groundstation_config.source.arn
+    destination = aws_groundstation_config.destination.arn
+  }
+}
+`, rName, minimumViableContactDuration))
+}
+
+func testAccMissionProfileConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccMissionProfileConfig_base(rName), fmt.Sprintf(`
+resource "aws_groundstation_mission_profile" "test" {
+  name                                    = %[1]q
+  minimum_viable_contact_duration_seconds = 60
+  tracking_config_arn                     = aws_groundstation_config.tracking.arn
+
+  dataflow_edge {
+    source      = aws_groundstation_config.source.arn
+    destination = aws_groundstation_config.destination.arn
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccMissionProfileConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccMissionProfileConfig_base(rName), fmt.Sprintf(`
+resource "aws_groundstation_mission_profile" "test" {
+  name                                    = %[1]q
+  minimum_viable_contact_duration_seconds = 60
+  tracking_config_arn                     = aws_groundstation_config.tracking.arn
+
+  dataflow_edge {
+    source      = aws_groundstation_config.source.arn
+    destination = aws_groundstation_config.destination.arn
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
+
+func testAccMissionProfileConfig_streamsKMSKey(rName string) string {
+	return acctest.ConfigCompose(testAccMissionProfileConfig_base(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "groundstation.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_groundstation_mission_profile" "test" {
+  name                                    = %[1]q
+  minimum_viable_contact_duration_seconds = 60
+  tracking_config_arn                     = aws_groundstation_config.tracking.arn
+  streams_kms_role                        = aws_iam_role.test.arn
+
+  dataflow_edge {
+    source      = aws_groundstation_config.source.arn
+    destination = aws_groundstation_config.destination.arn
+  }
+
+  streams_kms_key {
+    kms_key_arn = aws_kms_key.test.arn
+  }
+}
+`, rName))
+}
@@ -8,6 +8,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/guardduty"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/guardduty/types"
 	"github.com/aws/aws-sdk-go/aws"
@@ -36,6 +37,7 @@ const iamPropagationTimeout = 2 * time.Minute
 
 // @FrameworkResource(name="Malware Protection Plan")
 // @Tags(identifierAttribute="arn")
+// @ARNIdentity
 func newResourceMalwareProtectionPlan(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceMalwareProtectionPlan{}
 
@@ -316,6 +318,16 @@ func (r *resourceMalwareProtectionPlan) ImportState(ctx context.Context, req res
 	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
 }
 
+func (r *resourceMalwareProtectionPlan) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "guardduty", "malware-protection-plan/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return parts[0], nil
+}
+
 func (r *resourceMalwareProtectionPlan) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, req, resp)
 }
@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+// Exports for use in tests only.
+var (
+	ResourceFHIRDatastore = newFHIRDatastoreResource
+	ResourceFHIRExportJob = newFHIRExportJobResource
+	ResourceFHIRImportJob = newFHIRImportJobResource
+
+	FindFHIRDatastoreByID         = findFHIRDatastoreByID
+	FindFHIRExportJobByTwoPartKey = findFHIRExportJobByTwoPartKey
+	FindFHIRImportJobByTwoPartKey = findFHIRImportJobByTwoPartKey
+)
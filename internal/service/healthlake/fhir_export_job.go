@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="FHIR Export Job")
+func newFHIRExportJobResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &fhirExportJobResource{}
+
+	r.SetDefaultCreateTimeout(120 * time.Minute)
+
+	return r, nil
+}
+
+type fhirExportJobResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[fhirExportJobResourceModel]
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (r *fhirExportJobResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_healthlake_fhir_export_job"
+}
+
+func (r *fhirExportJobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data_access_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"datastore_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"job_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"job_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.JobStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrMessage: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"submit_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"output_data_config": outputDataConfigBlock(ctx),
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *fhirExportJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data fhirExportJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	outputDataConfig, diags := expandOutputDataConfig(ctx, data.OutputDataConfig)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &healthlake.StartFHIRExportJobInput{
+		ClientToken:       aws.String(id.UniqueId()),
+		DataAccessRoleArn: fwflex.StringFromFramework(ctx, data.DataAccessRoleARN),
+		DatastoreId:       fwflex.StringFromFramework(ctx, data.DatastoreID),
+		JobName:           fwflex.StringFromFramework(ctx, data.JobName),
+		OutputDataConfig:  outputDataConfig,
+	}
+
+	output, err := conn.StartFHIRExportJob(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("starting HealthLake FHIR Export Job (%s)", data.DatastoreID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.JobID = fwflex.StringToFramework(ctx, output.JobId)
+	data.setID()
+
+	job, err := waitFHIRExportJobCompleted(ctx, conn, data.DatastoreID.ValueString(), data.JobID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for HealthLake FHIR Export Job (%s) complete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, job)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *fhirExportJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data fhirExportJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	output, err := findFHIRExportJobByTwoPartKey(ctx, conn, data.DatastoreID.ValueString(), data.JobID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading HealthLake FHIR Export Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findFHIRExportJobByTwoPartKey(ctx context.Context, conn *healthlake.Client, datastoreID, jobID string) (*awstypes.ExportJobProperties, error) {
+	input := &healthlake.DescribeFHIRExportJobInput{
+		DatastoreId: aws.String(datastoreID),
+		JobId:       aws.String(jobID),
+	}
+
+	output, err := conn.DescribeFHIRExportJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ExportJobProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ExportJobProperties, nil
+}
+
+func statusFHIRExportJob(ctx context.Context, conn *healthlake.Client, datastoreID, jobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFHIRExportJobByTwoPartKey(ctx, conn, datastoreID, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		// Export jobs don't report progress beyond their status and message.
+		tflog.Info(ctx, "HealthLake FHIR Export Job progress", map[string]any{
+			"job_id":     jobID,
+			"job_status": output.JobStatus,
+			"message":    aws.ToString(output.Message),
+		})
+
+		return output, string(output.JobStatus), nil
+	}
+}
+
+func waitFHIRExportJobCompleted(ctx context.Context, conn *healthlake.Client, datastoreID, jobID string, timeout time.Duration) (*awstypes.ExportJobProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.JobStatusSubmitted, awstypes.JobStatusInProgress),
+		Target:  enum.Slice(awstypes.JobStatusCompleted, awstypes.JobStatusCompletedWithErrors),
+		Refresh: statusFHIRExportJob(ctx, conn, datastoreID, jobID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ExportJobProperties); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type fhirExportJobResourceModel struct {
+	DataAccessRoleARN fwtypes.ARN                                            `tfsdk:"data_access_role_arn"`
+	DatastoreID       types.String                                           `tfsdk:"datastore_id"`
+	EndTime           timetypes.RFC3339                                      `tfsdk:"end_time"`
+	ID                types.String                                           `tfsdk:"id"`
+	JobID             types.String                                           `tfsdk:"job_id"`
+	JobName           types.String                                           `tfsdk:"job_name"`
+	JobStatus         fwtypes.StringEnum[awstypes.JobStatus]                 `tfsdk:"job_status"`
+	Message           types.String                                           `tfsdk:"message"`
+	OutputDataConfig  fwtypes.ListNestedObjectValueOf[outputDataConfigModel] `tfsdk:"output_data_config"`
+	SubmitTime        timetypes.RFC3339                                      `tfsdk:"submit_time"`
+	Timeouts          timeouts.Value                                         `tfsdk:"timeouts"`
+}
+
+func (data *fhirExportJobResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), fhirJobResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.DatastoreID = types.StringValue(parts[0])
+	data.JobID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *fhirExportJobResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.DatastoreID.ValueString(), data.JobID.ValueString()}, fhirJobResourceIDPartCount, false)))
+}
+
+func (data *fhirExportJobResourceModel) flatten(ctx context.Context, apiObject *awstypes.ExportJobProperties) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.OutputDataConfig, diags = flattenOutputDataConfig(ctx, apiObject.OutputDataConfig)
+
+	return diags
+}
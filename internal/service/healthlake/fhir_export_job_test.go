@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfhealthlake "github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccHealthLakeFHIRExportJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var job awstypes.ExportJobProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_export_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFHIRDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRExportJobConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFHIRExportJobExists(ctx, resourceName, &job),
+					resource.TestCheckResourceAttrPair(resourceName, "data_access_role_arn", "aws_iam_role.job", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "datastore_id", "aws_healthlake_fhir_datastore.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, "end_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, "job_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "job_status", "COMPLETED"),
+					resource.TestCheckResourceAttr(resourceName, "output_data_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "output_data_config.0.s3_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "submit_time"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccCheckFHIRExportJobExists(ctx context.Context, n string, v *awstypes.ExportJobProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).HealthLakeClient(ctx)
+
+		output, err := tfhealthlake.FindFHIRExportJobByTwoPartKey(ctx, conn, rs.Primary.Attributes["datastore_id"], rs.Primary.Attributes["job_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccFHIRExportJobConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFHIRJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_healthlake_fhir_export_job" "test" {
+  datastore_id         = aws_healthlake_fhir_datastore.test.id
+  data_access_role_arn = aws_iam_role.job.arn
+  job_name             = %[1]q
+
+  output_data_config {
+    s3_configuration {
+      kms_key_id = aws_kms_key.test.arn
+      s3_uri     = "s3://${aws_s3_bucket.test.bucket}/export/"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.job]
+}
+`, rName))
+}
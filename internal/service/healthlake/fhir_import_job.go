@@ -0,0 +1,514 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/healthlake"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="FHIR Import Job")
+func newFHIRImportJobResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &fhirImportJobResource{}
+
+	r.SetDefaultCreateTimeout(120 * time.Minute)
+
+	return r, nil
+}
+
+type fhirImportJobResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[fhirImportJobResourceModel]
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (r *fhirImportJobResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_healthlake_fhir_import_job"
+}
+
+func (r *fhirImportJobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"data_access_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"datastore_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"job_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"job_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"job_progress_report": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[jobProgressReportModel](ctx),
+				Computed:   true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						"throughput": types.Float64Type,
+						"total_number_of_files_read_with_customer_error": types.Int64Type,
+						"total_number_of_imported_files":                 types.Int64Type,
+						"total_number_of_resources_imported":             types.Int64Type,
+						"total_number_of_resources_scanned":              types.Int64Type,
+						"total_number_of_resources_with_customer_error":  types.Int64Type,
+						"total_number_of_scanned_files":                  types.Int64Type,
+						"total_size_of_scanned_files_in_mb":              types.Float64Type,
+					},
+				},
+			},
+			"job_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.JobStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrMessage: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"submit_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"input_data_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[inputDataConfigModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"s3_uri": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+							Validators: []validator.String{
+								fwvalidators.S3URI(),
+							},
+						},
+					},
+				},
+			},
+			"job_output_data_config": outputDataConfigBlock(ctx),
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *fhirImportJobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data fhirImportJobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	inputDataConfigData, diags := data.InputDataConfig.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	jobOutputDataConfig, diags := expandOutputDataConfig(ctx, data.JobOutputDataConfig)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &healthlake.StartFHIRImportJobInput{
+		ClientToken:       aws.String(id.UniqueId()),
+		DataAccessRoleArn: fwflex.StringFromFramework(ctx, data.DataAccessRoleARN),
+		DatastoreId:       fwflex.StringFromFramework(ctx, data.DatastoreID),
+		InputDataConfig: &awstypes.InputDataConfigMemberS3Uri{
+			Value: inputDataConfigData.S3URI.ValueString(),
+		},
+		JobName:             fwflex.StringFromFramework(ctx, data.JobName),
+		JobOutputDataConfig: jobOutputDataConfig,
+	}
+
+	output, err := conn.StartFHIRImportJob(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("starting HealthLake FHIR Import Job (%s)", data.DatastoreID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.JobID = fwflex.StringToFramework(ctx, output.JobId)
+	data.setID()
+
+	job, err := waitFHIRImportJobCompleted(ctx, conn, data.DatastoreID.ValueString(), data.JobID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for HealthLake FHIR Import Job (%s) complete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, job)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *fhirImportJobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data fhirImportJobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().HealthLakeClient(ctx)
+
+	output, err := findFHIRImportJobByTwoPartKey(ctx, conn, data.DatastoreID.ValueString(), data.JobID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading HealthLake FHIR Import Job (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findFHIRImportJobByTwoPartKey(ctx context.Context, conn *healthlake.Client, datastoreID, jobID string) (*awstypes.ImportJobProperties, error) {
+	input := &healthlake.DescribeFHIRImportJobInput{
+		DatastoreId: aws.String(datastoreID),
+		JobId:       aws.String(jobID),
+	}
+
+	output, err := conn.DescribeFHIRImportJob(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ImportJobProperties == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ImportJobProperties, nil
+}
+
+func statusFHIRImportJob(ctx context.Context, conn *healthlake.Client, datastoreID, jobID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFHIRImportJobByTwoPartKey(ctx, conn, datastoreID, jobID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if v := output.JobProgressReport; v != nil {
+			tflog.Info(ctx, "HealthLake FHIR Import Job progress", map[string]any{
+				"job_id":                             jobID,
+				"total_number_of_imported_files":     aws.ToInt64(v.TotalNumberOfImportedFiles),
+				"total_number_of_resources_imported": aws.ToInt64(v.TotalNumberOfResourcesImported),
+				"total_number_of_scanned_files":      aws.ToInt64(v.TotalNumberOfScannedFiles),
+			})
+		}
+
+		return output, string(output.JobStatus), nil
+	}
+}
+
+func waitFHIRImportJobCompleted(ctx context.Context, conn *healthlake.Client, datastoreID, jobID string, timeout time.Duration) (*awstypes.ImportJobProperties, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.JobStatusSubmitted, awstypes.JobStatusInProgress),
+		Target:  enum.Slice(awstypes.JobStatusCompleted, awstypes.JobStatusCompletedWithErrors),
+		Refresh: statusFHIRImportJob(ctx, conn, datastoreID, jobID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ImportJobProperties); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type fhirImportJobResourceModel struct {
+	DataAccessRoleARN   fwtypes.ARN                                             `tfsdk:"data_access_role_arn"`
+	DatastoreID         types.String                                            `tfsdk:"datastore_id"`
+	ID                  types.String                                            `tfsdk:"id"`
+	InputDataConfig     fwtypes.ListNestedObjectValueOf[inputDataConfigModel]   `tfsdk:"input_data_config"`
+	JobID               types.String                                            `tfsdk:"job_id"`
+	JobName             types.String                                            `tfsdk:"job_name"`
+	JobOutputDataConfig fwtypes.ListNestedObjectValueOf[outputDataConfigModel]  `tfsdk:"job_output_data_config"`
+	JobProgressReport   fwtypes.ListNestedObjectValueOf[jobProgressReportModel] `tfsdk:"job_progress_report"`
+	JobStatus           fwtypes.StringEnum[awstypes.JobStatus]                  `tfsdk:"job_status"`
+	Message             types.String                                            `tfsdk:"message"`
+	SubmitTime          timetypes.RFC3339                                       `tfsdk:"submit_time"`
+	Timeouts            timeouts.Value                                          `tfsdk:"timeouts"`
+}
+
+const (
+	fhirJobResourceIDPartCount = 2
+)
+
+func (data *fhirImportJobResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), fhirJobResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.DatastoreID = types.StringValue(parts[0])
+	data.JobID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *fhirImportJobResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.DatastoreID.ValueString(), data.JobID.ValueString()}, fhirJobResourceIDPartCount, false)))
+}
+
+func (data *fhirImportJobResourceModel) flatten(ctx context.Context, apiObject *awstypes.ImportJobProperties) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v, ok := apiObject.InputDataConfig.(*awstypes.InputDataConfigMemberS3Uri); ok {
+		data.InputDataConfig = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &inputDataConfigModel{
+			S3URI: fwflex.StringValueToFramework(ctx, v.Value),
+		})
+	} else {
+		data.InputDataConfig = fwtypes.NewListNestedObjectValueOfNull[inputDataConfigModel](ctx)
+	}
+
+	data.JobOutputDataConfig, diags = flattenOutputDataConfig(ctx, apiObject.JobOutputDataConfig)
+
+	return diags
+}
+
+type inputDataConfigModel struct {
+	S3URI types.String `tfsdk:"s3_uri"`
+}
+
+type jobProgressReportModel struct {
+	Throughput                              types.Float64 `tfsdk:"throughput"`
+	TotalNumberOfFilesReadWithCustomerError types.Int64   `tfsdk:"total_number_of_files_read_with_customer_error"`
+	TotalNumberOfImportedFiles              types.Int64   `tfsdk:"total_number_of_imported_files"`
+	TotalNumberOfResourcesImported          types.Int64   `tfsdk:"total_number_of_resources_imported"`
+	TotalNumberOfResourcesScanned           types.Int64   `tfsdk:"total_number_of_resources_scanned"`
+	TotalNumberOfResourcesWithCustomerError types.Int64   `tfsdk:"total_number_of_resources_with_customer_error"`
+	TotalNumberOfScannedFiles               types.Int64   `tfsdk:"total_number_of_scanned_files"`
+	TotalSizeOfScannedFilesInMB             types.Float64 `tfsdk:"total_size_of_scanned_files_in_mb"`
+}
+
+// outputDataConfigBlock returns the schema for the S3 output configuration shared by import and export jobs.
+func outputDataConfigBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[outputDataConfigModel](ctx),
+		PlanModifiers: []planmodifier.List{
+			listplanmodifier.RequiresReplace(),
+		},
+		Validators: []validator.List{
+			listvalidator.IsRequired(),
+			listvalidator.SizeAtLeast(1),
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"s3_configuration": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[s3ConfigurationModel](ctx),
+					PlanModifiers: []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					},
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtLeast(1),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrKMSKeyID: schema.StringAttribute{
+								Required: true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.RequiresReplace(),
+								},
+							},
+							"s3_uri": schema.StringAttribute{
+								Required: true,
+								PlanModifiers: []planmodifier.String{
+									stringplanmodifier.RequiresReplace(),
+								},
+								Validators: []validator.String{
+									fwvalidators.S3URI(),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandOutputDataConfig(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[outputDataConfigModel]) (awstypes.OutputDataConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	tfObject, d := tfList.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || tfObject == nil {
+		return nil, diags
+	}
+
+	s3ConfigurationData, d := tfObject.S3Configuration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || s3ConfigurationData == nil {
+		return nil, diags
+	}
+
+	var s3Configuration awstypes.S3Configuration
+	diags.Append(fwflex.Expand(ctx, s3ConfigurationData, &s3Configuration)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &awstypes.OutputDataConfigMemberS3Configuration{
+		Value: s3Configuration,
+	}, diags
+}
+
+func flattenOutputDataConfig(ctx context.Context, apiObject awstypes.OutputDataConfig) (fwtypes.ListNestedObjectValueOf[outputDataConfigModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	v, ok := apiObject.(*awstypes.OutputDataConfigMemberS3Configuration)
+	if !ok {
+		return fwtypes.NewListNestedObjectValueOfNull[outputDataConfigModel](ctx), diags
+	}
+
+	var s3Configuration s3ConfigurationModel
+	diags.Append(fwflex.Flatten(ctx, v.Value, &s3Configuration)...)
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[outputDataConfigModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &outputDataConfigModel{
+		S3Configuration: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &s3Configuration),
+	}), diags
+}
+
+type outputDataConfigModel struct {
+	S3Configuration fwtypes.ListNestedObjectValueOf[s3ConfigurationModel] `tfsdk:"s3_configuration"`
+}
+
+type s3ConfigurationModel struct {
+	KmsKeyID types.String `tfsdk:"kms_key_id"`
+	S3URI    types.String `tfsdk:"s3_uri"`
+}
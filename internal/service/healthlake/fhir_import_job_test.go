@@ -0,0 +1,188 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfhealthlake "github.com/hashicorp/terraform-provider-aws/internal/service/healthlake"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccHealthLakeFHIRImportJob_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var job awstypes.ImportJobProperties
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_healthlake_fhir_import_job.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.HealthLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFHIRDatastoreDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFHIRImportJobConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFHIRImportJobExists(ctx, resourceName, &job),
+					resource.TestCheckResourceAttrPair(resourceName, "data_access_role_arn", "aws_iam_role.job", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "datastore_id", "aws_healthlake_fhir_datastore.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "input_data_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "job_id"),
+					resource.TestCheckResourceAttr(resourceName, "job_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "job_output_data_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "job_output_data_config.0.s3_configuration.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "job_progress_report.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "job_progress_report.0.total_number_of_resources_imported", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "job_status", "COMPLETED"),
+					resource.TestCheckResourceAttrSet(resourceName, "submit_time"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccCheckFHIRImportJobExists(ctx context.Context, n string, v *awstypes.ImportJobProperties) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).HealthLakeClient(ctx)
+
+		output, err := tfhealthlake.FindFHIRImportJobByTwoPartKey(ctx, conn, rs.Primary.Attributes["datastore_id"], rs.Primary.Attributes["job_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccFHIRJobConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccFHIRDatastoreConfig_basic(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "job" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "healthlake.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "job" {
+  name = %[1]q
+  role = aws_iam_role.job.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect = "Allow"
+        Action = [
+          "s3:ListBucket",
+          "s3:GetBucketPublicAccessBlock",
+          "s3:GetEncryptionConfiguration",
+        ]
+        Resource = aws_s3_bucket.test.arn
+      },
+      {
+        Effect = "Allow"
+        Action = [
+          "s3:GetObject",
+          "s3:PutObject",
+        ]
+        Resource = "${aws_s3_bucket.test.arn}/*"
+      },
+      {
+        Effect = "Allow"
+        Action = [
+          "kms:DescribeKey",
+          "kms:GenerateDataKey",
+          "kms:Decrypt",
+        ]
+        Resource = aws_kms_key.test.arn
+      },
+    ]
+  })
+}
+`, rName))
+}
+
+func testAccFHIRImportJobConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccFHIRJobConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "input/patient.ndjson"
+  content = jsonencode({
+    resourceType = "Patient"
+    id           = "example"
+    name = [{
+      family = "Doe"
+      given  = ["Jane"]
+    }]
+  })
+}
+
+resource "aws_healthlake_fhir_import_job" "test" {
+  datastore_id         = aws_healthlake_fhir_datastore.test.id
+  data_access_role_arn = aws_iam_role.job.arn
+  job_name             = %[1]q
+
+  input_data_config {
+    s3_uri = "s3://${aws_s3_bucket.test.bucket}/input/"
+  }
+
+  job_output_data_config {
+    s3_configuration {
+      kms_key_id = aws_kms_key.test.arn
+      s3_uri     = "s3://${aws_s3_bucket.test.bucket}/import-output/"
+    }
+  }
+
+  depends_on = [aws_iam_role_policy.job, aws_s3_object.test]
+}
+`, rName))
+}
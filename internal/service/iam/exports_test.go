@@ -10,26 +10,28 @@ var (
 	ResourceAccountPasswordPolicy = resourceAccountPasswordPolicy
 	ResourceGroup                 = resourceGroup
 	// ResourceGroupMembership       = resourceGroupMembership
-	ResourceGroupPolicy               = resourceGroupPolicy
-	ResourceGroupPolicyAttachment     = resourceGroupPolicyAttachment
-	ResourceInstanceProfile           = resourceInstanceProfile
-	ResourceOpenIDConnectProvider     = resourceOpenIDConnectProvider
-	ResourcePolicy                    = resourcePolicy
-	ResourcePolicyAttachment          = resourcePolicyAttachment
-	ResourceRolePolicy                = resourceRolePolicy
-	ResourceRolePolicyAttachment      = resourceRolePolicyAttachment
-	ResourceSAMLProvider              = resourceSAMLProvider
-	ResourceServerCertificate         = resourceServerCertificate
-	ResourceServiceLinkedRole         = resourceServiceLinkedRole
-	ResourceServiceSpecificCredential = resourceServiceSpecificCredential
-	ResourceSigningCertificate        = resourceSigningCertificate
-	ResourceUser                      = resourceUser
-	ResourceUserGroupMembership       = resourceUserGroupMembership
-	ResourceUserLoginProfile          = resourceUserLoginProfile
-	ResourceUserPolicy                = resourceUserPolicy
-	ResourceUserPolicyAttachment      = resourceUserPolicyAttachment
-	ResourceUserSSHKey                = resourceUserSSHKey
-	ResourceVirtualMFADevice          = resourceVirtualMFADevice
+	ResourceGroupPolicy                    = resourceGroupPolicy
+	ResourceGroupPolicyAttachment          = resourceGroupPolicyAttachment
+	ResourceInstanceProfile                = resourceInstanceProfile
+	ResourceOpenIDConnectProvider          = resourceOpenIDConnectProvider
+	ResourcePolicy                         = resourcePolicy
+	ResourcePolicyAttachment               = resourcePolicyAttachment
+	ResourceRolePolicies                   = resourceRolePolicies
+	ResourceRolePolicy                     = resourceRolePolicy
+	ResourceRolePolicyAttachment           = resourceRolePolicyAttachment
+	ResourceRolePolicyAttachmentsExclusive = resourceRolePolicyAttachmentsExclusive
+	ResourceSAMLProvider                   = resourceSAMLProvider
+	ResourceServerCertificate              = resourceServerCertificate
+	ResourceServiceLinkedRole              = resourceServiceLinkedRole
+	ResourceServiceSpecificCredential      = resourceServiceSpecificCredential
+	ResourceSigningCertificate             = resourceSigningCertificate
+	ResourceUser                           = resourceUser
+	ResourceUserGroupMembership            = resourceUserGroupMembership
+	ResourceUserLoginProfile               = resourceUserLoginProfile
+	ResourceUserPolicy                     = resourceUserPolicy
+	ResourceUserPolicyAttachment           = resourceUserPolicyAttachment
+	ResourceUserSSHKey                     = resourceUserSSHKey
+	ResourceVirtualMFADevice               = resourceVirtualMFADevice
 
 	FindAccessKeyByTwoPartKey           = findAccessKeyByTwoPartKey
 	FindAccountPasswordPolicy           = findAccountPasswordPolicy
@@ -44,6 +46,7 @@ var (
 	FindInstanceProfileByName           = findInstanceProfileByName
 	FindOpenIDConnectProviderByARN      = findOpenIDConnectProviderByARN
 	FindPolicyByARN                     = findPolicyByARN
+	FindRoleAttachedPolicies            = findRoleAttachedPolicies
 	FindSAMLProviderByARN               = findSAMLProviderByARN
 	FindServerCertificateByName         = findServerCertificateByName
 	FindSSHPublicKeyByThreePartKey      = findSSHPublicKeyByThreePartKey
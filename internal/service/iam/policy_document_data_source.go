@@ -22,6 +22,11 @@ import (
 
 var dataSourcePolicyDocumentVarReplacer = strings.NewReplacer("&{", "${")
 
+const (
+	// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_iam-quotas.html#reference_iam-quotas-entity-length.
+	policyDocumentManagedPolicyMaxLen = 6144
+)
+
 // @SDKDataSource("aws_iam_policy_document", name="Policy Document")
 func dataSourcePolicyDocument() *schema.Resource {
 	return &schema.Resource{
@@ -114,8 +119,9 @@ func dataSourcePolicyDocument() *schema.Resource {
 								Elem: &schema.Resource{
 									Schema: map[string]*schema.Schema{
 										"test": {
-											Type:     schema.TypeString,
-											Required: true,
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validPolicyConditionOperator,
 										},
 										names.AttrValues: {
 											Type:     schema.TypeList,
@@ -125,8 +131,9 @@ func dataSourcePolicyDocument() *schema.Resource {
 											},
 										},
 										"variable": {
-											Type:     schema.TypeString,
-											Required: true,
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validPolicyConditionKey,
 										},
 									},
 								},
@@ -323,6 +330,11 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 
 	d.Set("minified_json", jsonMinString)
 
+	// IAM doesn't count white space when calculating policy size.
+	if n := len(jsonMinString); n > policyDocumentManagedPolicyMaxLen {
+		diags = sdkdiag.AppendWarningf(diags, "IAM Policy Document is %d characters, which exceeds the %d character limit for managed policies", n, policyDocumentManagedPolicyMaxLen)
+	}
+
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
 	return diags
@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_iam_role_policies", name="Role Policies")
+func resourceRolePolicies() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRolePoliciesCreate,
+		ReadWithoutTimeout:   resourceRolePoliciesRead,
+		UpdateWithoutTimeout: resourceRolePoliciesUpdate,
+		DeleteWithoutTimeout: resourceRolePoliciesDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"policies": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				ValidateFunc:          validRolePoliciesPolicies,
+				DiffSuppressFunc:      verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressOnRefresh: true,
+			},
+			names.AttrRole: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validRolePolicyRole,
+			},
+		},
+	}
+}
+
+func resourceRolePoliciesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	roleName := d.Get(names.AttrRole).(string)
+	mutexKey := rolePoliciesMutexKey(roleName)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	// Any inline policies not in configuration are removed so that the role's
+	// inline policies are exclusively managed by this resource.
+	policyNames, err := findRolePolicyNames(ctx, conn, roleName)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role (%s) inline policies: %s", roleName, err)
+	}
+
+	policies := d.Get("policies").(map[string]interface{})
+	var del []string
+	for _, policyName := range policyNames {
+		if _, ok := policies[policyName]; !ok {
+			del = append(del, policyName)
+		}
+	}
+
+	if err := deleteRoleInlinePoliciesWithRetry(ctx, conn, roleName, del); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IAM Role Policies (%s): %s", roleName, err)
+	}
+
+	if err := putRoleInlinePolicies(ctx, conn, roleName, policies); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IAM Role Policies (%s): %s", roleName, err)
+	}
+
+	d.SetId(roleName)
+
+	_, err = tfresource.RetryWhenNotFound(ctx, propagationTimeout, func() (interface{}, error) {
+		return findRoleInlinePolicyDocuments(ctx, conn, roleName, tfmaps.Keys(policies))
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IAM Role Policies (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceRolePoliciesRead(ctx, d, meta)...)
+}
+
+func resourceRolePoliciesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	policyNames, err := findRolePolicyNames(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role Policies %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role Policies (%s): %s", d.Id(), err)
+	}
+
+	documents, err := findRoleInlinePolicyDocuments(ctx, conn, d.Id(), policyNames)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role Policies (%s): %s", d.Id(), err)
+	}
+
+	configured := d.Get("policies").(map[string]interface{})
+	policies := make(map[string]interface{}, len(documents))
+	for policyName, document := range documents {
+		existing, _ := configured[policyName].(string)
+		policyToSet, err := verify.LegacyPolicyToSet(existing, document)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		policies[policyName] = policyToSet
+	}
+
+	d.Set("policies", policies)
+	d.Set(names.AttrRole, d.Id())
+
+	return diags
+}
+
+func resourceRolePoliciesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	if d.HasChange("policies") {
+		mutexKey := rolePoliciesMutexKey(d.Id())
+		conns.GlobalMutexKV.Lock(mutexKey)
+		defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+		o, n := d.GetChange("policies")
+		om, nm := o.(map[string]interface{}), n.(map[string]interface{})
+
+		var del []string
+		for policyName := range om {
+			if _, ok := nm[policyName]; !ok {
+				del = append(del, policyName)
+			}
+		}
+
+		put := make(map[string]interface{})
+		for policyName, v := range nm {
+			if old, ok := om[policyName].(string); ok && verify.PolicyStringsEquivalent(old, v.(string)) {
+				continue
+			}
+
+			put[policyName] = v
+		}
+
+		if err := deleteRoleInlinePoliciesWithRetry(ctx, conn, d.Id(), del); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IAM Role Policies (%s): %s", d.Id(), err)
+		}
+
+		if err := putRoleInlinePolicies(ctx, conn, d.Id(), put); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IAM Role Policies (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRolePoliciesRead(ctx, d, meta)...)
+}
+
+func resourceRolePoliciesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	mutexKey := rolePoliciesMutexKey(d.Id())
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
+	log.Printf("[INFO] Deleting IAM Role Policies: %s", d.Id())
+	policyNames := tfmaps.Keys(d.Get("policies").(map[string]interface{}))
+	err := deleteRoleInlinePoliciesWithRetry(ctx, conn, d.Id(), policyNames)
+
+	if errs.IsA[*awstypes.NoSuchEntityException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting IAM Role Policies (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// rolePoliciesMutexKey returns the key used to serialize changes to a role's inline policies.
+func rolePoliciesMutexKey(roleName string) string {
+	return "iam_role_policies_" + roleName
+}
+
+func putRoleInlinePolicies(ctx context.Context, conn *iam.Client, roleName string, policies map[string]interface{}) error {
+	for policyName, v := range policies {
+		policy, err := verify.LegacyPolicyNormalize(v.(string))
+		if err != nil {
+			return fmt.Errorf("policy (%s) is invalid JSON: %w", policyName, err)
+		}
+
+		input := &iam.PutRolePolicyInput{
+			PolicyDocument: aws.String(policy),
+			PolicyName:     aws.String(policyName),
+			RoleName:       aws.String(roleName),
+		}
+
+		_, err = tfresource.RetryWhenIsA[*awstypes.ConcurrentModificationException](ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.PutRolePolicy(ctx, input)
+		})
+
+		if err != nil {
+			return fmt.Errorf("putting IAM Role (%s) Policy (%s): %w", roleName, policyName, err)
+		}
+	}
+
+	return nil
+}
+
+func deleteRoleInlinePoliciesWithRetry(ctx context.Context, conn *iam.Client, roleName string, policyNames []string) error {
+	for _, policyName := range policyNames {
+		input := &iam.DeleteRolePolicyInput{
+			PolicyName: aws.String(policyName),
+			RoleName:   aws.String(roleName),
+		}
+
+		_, err := tfresource.RetryWhenIsA[*awstypes.ConcurrentModificationException](ctx, propagationTimeout, func() (interface{}, error) {
+			return conn.DeleteRolePolicy(ctx, input)
+		})
+
+		if errs.IsA[*awstypes.NoSuchEntityException](err) {
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting IAM Role (%s) Policy (%s): %w", roleName, policyName, err)
+		}
+	}
+
+	return nil
+}
+
+// findRoleInlinePolicyDocuments returns the URL-decoded policy documents of the named inline policies, keyed by policy name.
+func findRoleInlinePolicyDocuments(ctx context.Context, conn *iam.Client, roleName string, policyNames []string) (map[string]string, error) {
+	documents := make(map[string]string, len(policyNames))
+
+	for _, policyName := range policyNames {
+		policyDocument, err := FindRolePolicyByTwoPartKey(ctx, conn, roleName, policyName)
+
+		if err != nil {
+			return nil, err
+		}
+
+		document, err := url.QueryUnescape(policyDocument)
+		if err != nil {
+			return nil, err
+		}
+
+		documents[policyName] = document
+	}
+
+	return documents, nil
+}
+
+func validRolePoliciesPolicies(v interface{}, k string) (ws []string, errors []error) {
+	for policyName, policy := range v.(map[string]interface{}) {
+		if _, es := validRolePolicyName(policyName, fmt.Sprintf("%s.%s", k, policyName)); len(es) > 0 {
+			errors = append(errors, es...)
+		}
+
+		if _, es := verify.ValidIAMPolicyJSON(policy, fmt.Sprintf("%s.%s", k, policyName)); len(es) > 0 {
+			errors = append(errors, es...)
+		}
+	}
+
+	return
+}
@@ -0,0 +1,342 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIAMRolePolicies_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRolePoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePoliciesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePoliciesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policies.%", acctest.Ct2),
+					resource.TestCheckResourceAttrSet(resourceName, "policies.read"),
+					resource.TestCheckResourceAttrSet(resourceName, "policies.write"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrRole, "aws_iam_role.test", names.AttrName),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePolicies_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRolePoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePoliciesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePoliciesExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiam.ResourceRolePolicies(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePolicies_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRolePoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePoliciesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePoliciesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policies.%", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccRolePoliciesConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePoliciesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policies.%", acctest.Ct2),
+					resource.TestCheckResourceAttrSet(resourceName, "policies.read"),
+					resource.TestCheckResourceAttrSet(resourceName, "policies.admin"),
+					resource.TestCheckNoResourceAttr(resourceName, "policies.write"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePolicies_policyOrder(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRolePoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePoliciesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePoliciesExists(ctx, resourceName),
+				),
+			},
+			{
+				Config:   testAccRolePoliciesConfig_newOrder(rName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePolicies_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRolePoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePoliciesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePoliciesExists(ctx, resourceName),
+					testAccCheckRolePoliciesPutOutOfBand(ctx, rName, "extra"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRolePoliciesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePoliciesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policies.%", acctest.Ct2),
+					resource.TestCheckNoResourceAttr(resourceName, "policies.extra"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRolePoliciesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iam_role_policies" {
+				continue
+			}
+
+			for _, policyName := range testAccRolePoliciesPolicyNames(rs) {
+				_, err := tfiam.FindRolePolicyByTwoPartKey(ctx, conn, rs.Primary.ID, policyName)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("IAM Role Policy %s:%s still exists", rs.Primary.ID, policyName)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRolePoliciesExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+
+		for _, policyName := range testAccRolePoliciesPolicyNames(rs) {
+			if _, err := tfiam.FindRolePolicyByTwoPartKey(ctx, conn, rs.Primary.ID, policyName); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRolePoliciesPutOutOfBand(ctx context.Context, roleName, policyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+
+		_, err := conn.PutRolePolicy(ctx, &iam.PutRolePolicyInput{
+			PolicyDocument: aws.String(`{"Version":"2012-10-17","Statement":{"Effect":"Deny","Action":"s3:*","Resource":"*"}}`),
+			PolicyName:     aws.String(policyName),
+			RoleName:       aws.String(roleName),
+		})
+
+		return err
+	}
+}
+
+func testAccRolePoliciesPolicyNames(rs *terraform.ResourceState) []string {
+	var policyNames []string
+
+	for k := range rs.Primary.Attributes {
+		if k, ok := strings.CutPrefix(k, "policies."); ok && k != "%" {
+			policyNames = append(policyNames, k)
+		}
+	}
+
+	return policyNames
+}
+
+func testAccRolePoliciesConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.amazonaws.com"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccRolePoliciesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccRolePoliciesConfig_base(rName), `
+resource "aws_iam_role_policies" "test" {
+  role = aws_iam_role.test.name
+
+  policies = {
+    read = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Effect   = "Allow"
+        Action   = ["s3:GetObject", "s3:ListBucket"]
+        Resource = "*"
+      }]
+    })
+    write = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Effect   = "Allow"
+        Action   = "s3:PutObject"
+        Resource = "*"
+      }]
+    })
+  }
+}
+`)
+}
+
+func testAccRolePoliciesConfig_newOrder(rName string) string {
+	return acctest.ConfigCompose(testAccRolePoliciesConfig_base(rName), `
+resource "aws_iam_role_policies" "test" {
+  role = aws_iam_role.test.name
+
+  policies = {
+    write = <<EOF
+{
+  "Statement": [
+    {
+      "Resource": "*",
+      "Action": "s3:PutObject",
+      "Effect": "Allow"
+    }
+  ],
+  "Version": "2012-10-17"
+}
+EOF
+    read  = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": {
+    "Action": ["s3:ListBucket", "s3:GetObject"],
+    "Resource": "*",
+    "Effect": "Allow"
+  }
+}
+EOF
+  }
+}
+`)
+}
+
+func testAccRolePoliciesConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccRolePoliciesConfig_base(rName), `
+resource "aws_iam_role_policies" "test" {
+  role = aws_iam_role.test.name
+
+  policies = {
+    read = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Effect   = "Allow"
+        Action   = ["s3:GetObject", "s3:ListBucket", "s3:GetObjectVersion"]
+        Resource = "*"
+      }]
+    })
+    admin = jsonencode({
+      Version = "2012-10-17"
+      Statement = [{
+        Effect   = "Allow"
+        Action   = "*"
+        Resource = "*"
+      }]
+    })
+  }
+}
+`)
+}
@@ -92,6 +92,10 @@ func resourceRolePolicyPut(ctx context.Context, d *schema.ResourceData, meta int
 
 	policyName := create.Name(d.Get(names.AttrName).(string), d.Get(names.AttrNamePrefix).(string))
 	roleName := d.Get(names.AttrRole).(string)
+	mutexKey := rolePoliciesMutexKey(roleName)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
 	input := &iam.PutRolePolicyInput{
 		PolicyDocument: aws.String(policy),
 		PolicyName:     aws.String(policyName),
@@ -167,6 +171,10 @@ func resourceRolePolicyDelete(ctx context.Context, d *schema.ResourceData, meta
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
+	mutexKey := rolePoliciesMutexKey(roleName)
+	conns.GlobalMutexKV.Lock(mutexKey)
+	defer conns.GlobalMutexKV.Unlock(mutexKey)
+
 	log.Printf("[INFO] Deleting IAM Role Policy: %s", d.Id())
 	_, err = conn.DeleteRolePolicy(ctx, &iam.DeleteRolePolicyInput{
 		PolicyName: aws.String(policyName),
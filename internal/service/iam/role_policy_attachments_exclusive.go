@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_iam_role_policy_attachments_exclusive", name="Role Policy Attachments Exclusive")
+func resourceRolePolicyAttachmentsExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceRolePolicyAttachmentsExclusiveCreate,
+		ReadWithoutTimeout:   resourceRolePolicyAttachmentsExclusiveRead,
+		UpdateWithoutTimeout: resourceRolePolicyAttachmentsExclusiveUpdate,
+		DeleteWithoutTimeout: resourceRolePolicyAttachmentsExclusiveDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: resourceRolePolicyAttachmentsExclusiveCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"audit_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"policy_arns": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			"removed_policy_arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"role_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validRolePolicyRole,
+			},
+			"unmanaged_policy_arns": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceRolePolicyAttachmentsExclusiveCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	roleName := d.Get("role_name").(string)
+
+	if err := syncRolePolicyAttachmentsExclusive(ctx, d, meta, roleName); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating IAM Role Policy Attachments Exclusive (%s): %s", roleName, err)
+	}
+
+	d.SetId(roleName)
+
+	return append(diags, resourceRolePolicyAttachmentsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceRolePolicyAttachmentsExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	attached, err := findRoleAttachedPolicies(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IAM Role Policy Attachments Exclusive %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Role Policy Attachments Exclusive (%s): %s", d.Id(), err)
+	}
+
+	managed := flex.ExpandStringValueSet(d.Get("policy_arns").(*schema.Set))
+	var unmanaged []string
+	for _, policyARN := range attached {
+		if !slices.Contains(managed, policyARN) {
+			unmanaged = append(unmanaged, policyARN)
+		}
+	}
+
+	policyARNs := attached
+	if d.Get("audit_only").(bool) {
+		// Out-of-band attachments are reported, not removed, so they don't cause a difference.
+		policyARNs = slices.DeleteFunc(slices.Clone(attached), func(v string) bool {
+			return slices.Contains(unmanaged, v)
+		})
+
+		if len(unmanaged) > 0 {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "IAM Role has unmanaged policy attachments",
+				Detail: fmt.Sprintf("IAM Role (%s) has policy attachments that are not present in configuration: %s. "+
+					"Set audit_only to false to remove them.", d.Id(), strings.Join(unmanaged, ", ")),
+			})
+		}
+	}
+
+	d.Set("policy_arns", policyARNs)
+	d.Set("role_name", d.Id())
+	d.Set("unmanaged_policy_arns", unmanaged)
+
+	return diags
+}
+
+func resourceRolePolicyAttachmentsExclusiveUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.HasChanges("audit_only", "policy_arns") {
+		if err := syncRolePolicyAttachmentsExclusive(ctx, d, meta, d.Id()); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IAM Role Policy Attachments Exclusive (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceRolePolicyAttachmentsExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceRolePolicyAttachmentsExclusiveDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	// An audit only resource never detached policies, so it doesn't detach them when destroyed.
+	if d.Get("audit_only").(bool) {
+		log.Printf("[DEBUG] IAM Role Policy Attachments Exclusive (%s) is audit only, removing from state", d.Id())
+		return diags
+	}
+
+	log.Printf("[INFO] Deleting IAM Role Policy Attachments Exclusive: %s", d.Id())
+	for _, policyARN := range flex.ExpandStringValueSet(d.Get("policy_arns").(*schema.Set)) {
+		if err := detachPolicyFromRole(ctx, conn, d.Id(), policyARN); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting IAM Role Policy Attachments Exclusive (%s): %s", d.Id(), err)
+		}
+	}
+
+	return diags
+}
+
+func resourceRolePolicyAttachmentsExclusiveCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChanges("audit_only", "policy_arns") {
+		return nil
+	}
+
+	if err := diff.SetNewComputed("removed_policy_arns"); err != nil {
+		return err
+	}
+
+	return diff.SetNewComputed("unmanaged_policy_arns")
+}
+
+// syncRolePolicyAttachmentsExclusive attaches the configured managed policies to the role.
+// Unless audit_only is set, attachments not in configuration are detached and recorded in removed_policy_arns.
+func syncRolePolicyAttachmentsExclusive(ctx context.Context, d *schema.ResourceData, meta interface{}, roleName string) error {
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	attached, err := findRoleAttachedPolicies(ctx, conn, roleName)
+
+	if err != nil {
+		return fmt.Errorf("reading attached policies: %w", err)
+	}
+
+	want := flex.ExpandStringValueSet(d.Get("policy_arns").(*schema.Set))
+	removed := []string{}
+
+	if !d.Get("audit_only").(bool) {
+		for _, policyARN := range attached {
+			if slices.Contains(want, policyARN) {
+				continue
+			}
+
+			if err := detachPolicyFromRole(ctx, conn, roleName, policyARN); err != nil {
+				return err
+			}
+
+			removed = append(removed, policyARN)
+		}
+	}
+
+	for _, policyARN := range want {
+		if slices.Contains(attached, policyARN) {
+			continue
+		}
+
+		if err := attachPolicyToRole(ctx, conn, roleName, policyARN); err != nil {
+			return err
+		}
+	}
+
+	d.Set("removed_policy_arns", removed)
+
+	return nil
+}
@@ -0,0 +1,294 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccIAMRolePolicyAttachmentsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRolePolicyAttachmentsExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveConfig_basic(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRolePolicyAttachmentsExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "audit_only", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "policy_arns.*", "aws_iam_policy.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "removed_policy_arns.#", acctest.Ct0),
+					resource.TestCheckResourceAttrPair(resourceName, "role_name", "aws_iam_role.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_policy_arns.#", acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"audit_only", "removed_policy_arns", "unmanaged_policy_arns"},
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePolicyAttachmentsExclusive_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRolePolicyAttachmentsExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePolicyAttachmentsExclusiveCount(ctx, resourceName, 1),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfiam.ResourceRolePolicyAttachmentsExclusive(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePolicyAttachmentsExclusive_outOfBandRemoval(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRolePolicyAttachmentsExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePolicyAttachmentsExclusiveCount(ctx, resourceName, 1),
+					testAccCheckRolePolicyAttachmentsExclusiveAttachOutOfBand(ctx, rName, "aws_iam_policy.extra"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveConfig_basic(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRolePolicyAttachmentsExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "removed_policy_arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "removed_policy_arns.*", "aws_iam_policy.extra", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_policy_arns.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePolicyAttachmentsExclusive_auditOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRolePolicyAttachmentsExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePolicyAttachmentsExclusiveCount(ctx, resourceName, 1),
+					testAccCheckRolePolicyAttachmentsExclusiveAttachOutOfBand(ctx, rName, "aws_iam_policy.extra"),
+				),
+			},
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveConfig_basic(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRolePolicyAttachmentsExclusiveCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "policy_arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "removed_policy_arns.#", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_policy_arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "unmanaged_policy_arns.*", "aws_iam_policy.extra", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveConfig_basic(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRolePolicyAttachmentsExclusiveCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "removed_policy_arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_policy_arns.#", acctest.Ct0),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMRolePolicyAttachmentsExclusive_auditOnlyDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role_policy_attachments_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRolePolicyAttachmentsExclusiveDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRolePolicyAttachmentsExclusiveConfig_basic(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePolicyAttachmentsExclusiveCount(ctx, resourceName, 1),
+				),
+			},
+			{
+				// Destroy the audit only resource, keeping the role and policies.
+				Config: testAccRolePolicyAttachmentsExclusiveConfig_base(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePolicyAttachmentCount(ctx, rName, 1),
+				),
+			},
+			{
+				// Detach the policies so that the role can be destroyed.
+				Config: testAccRolePolicyAttachmentsExclusiveConfig_basic(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRolePolicyAttachmentsExclusiveCount(ctx, resourceName, 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRolePolicyAttachmentsExclusiveDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_iam_role_policy_attachments_exclusive" {
+				continue
+			}
+
+			output, err := tfiam.FindRoleAttachedPolicies(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if n := len(output); n > 0 {
+				return fmt.Errorf("IAM Role %s still has %d attached policies", rs.Primary.ID, n)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRolePolicyAttachmentsExclusiveCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+
+		output, err := tfiam.FindRoleAttachedPolicies(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("IAM Role %s has %d attached policies, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRolePolicyAttachmentsExclusiveAttachOutOfBand(ctx context.Context, roleName, policyResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[policyResourceName]
+		if !ok {
+			return fmt.Errorf("Not Found: %s", policyResourceName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IAMClient(ctx)
+
+		_, err := conn.AttachRolePolicy(ctx, &iam.AttachRolePolicyInput{
+			PolicyArn: aws.String(rs.Primary.Attributes[names.AttrARN]),
+			RoleName:  aws.String(roleName),
+		})
+
+		return err
+	}
+}
+
+func testAccRolePolicyAttachmentsExclusiveConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccRolePoliciesConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  name = %[1]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:GetObject"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_iam_policy" "extra" {
+  name = "%[1]s-extra"
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:PutObject"
+      Resource = "*"
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccRolePolicyAttachmentsExclusiveConfig_basic(rName string, auditOnly bool) string {
+	return acctest.ConfigCompose(testAccRolePolicyAttachmentsExclusiveConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_role_policy_attachments_exclusive" "test" {
+  role_name   = aws_iam_role.test.name
+  policy_arns = [aws_iam_policy.test.arn]
+  audit_only  = %[1]t
+}
+`, auditOnly))
+}
@@ -195,6 +195,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				ResourceType:        "Role",
 			},
 		},
+		{
+			Factory:  resourceRolePolicies,
+			TypeName: "aws_iam_role_policies",
+			Name:     "Role Policies",
+		},
 		{
 			Factory:  resourceRolePolicy,
 			TypeName: "aws_iam_role_policy",
@@ -205,6 +210,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iam_role_policy_attachment",
 			Name:     "Role Policy Attachment",
 		},
+		{
+			Factory:  resourceRolePolicyAttachmentsExclusive,
+			TypeName: "aws_iam_role_policy_attachments_exclusive",
+			Name:     "Role Policy Attachments Exclusive",
+		},
 		{
 			Factory:  resourceSAMLProvider,
 			TypeName: "aws_iam_saml_provider",
@@ -64,3 +64,168 @@ var validRolePolicyRole = validation.All(
 		return
 	},
 )
+
+// policyConditionOperators are the base IAM policy condition operators.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_elements_condition_operators.html.
+var policyConditionOperators = []string{
+	"ArnEquals",
+	"ArnLike",
+	"ArnNotEquals",
+	"ArnNotLike",
+	"BinaryEquals",
+	"Bool",
+	"DateEquals",
+	"DateGreaterThan",
+	"DateGreaterThanEquals",
+	"DateLessThan",
+	"DateLessThanEquals",
+	"DateNotEquals",
+	"IpAddress",
+	"NotIpAddress",
+	"Null",
+	"NumericEquals",
+	"NumericGreaterThan",
+	"NumericGreaterThanEquals",
+	"NumericLessThan",
+	"NumericLessThanEquals",
+	"NumericNotEquals",
+	"StringEquals",
+	"StringEqualsIgnoreCase",
+	"StringLike",
+	"StringNotEquals",
+	"StringNotEqualsIgnoreCase",
+	"StringNotLike",
+}
+
+// validPolicyConditionOperator validates an IAM policy condition operator, including
+// any set operator prefix ("ForAllValues:" or "ForAnyValue:") and "IfExists" suffix.
+// Unknown operators only produce warnings, as new operators may be added to IAM.
+func validPolicyConditionOperator(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+
+	if value == "" {
+		es = append(es, fmt.Errorf("%q must not be empty", k))
+		return
+	}
+
+	operator := value
+	if before, after, ok := strings.Cut(operator, ":"); ok {
+		if before != "ForAllValues" && before != "ForAnyValue" {
+			es = append(es, fmt.Errorf("%q (%s) has an invalid set operator prefix, must be one of ForAllValues or ForAnyValue", k, value))
+			return
+		}
+		operator = after
+	}
+
+	if o, ok := strings.CutSuffix(operator, "IfExists"); ok {
+		if o == "Null" {
+			es = append(es, fmt.Errorf("%q (%s) is not a valid condition operator, Null does not support IfExists", k, value))
+			return
+		}
+		operator = o
+	}
+
+	for _, o := range policyConditionOperators {
+		if operator == o {
+			return
+		}
+
+		if strings.EqualFold(operator, o) {
+			ws = append(ws, fmt.Sprintf("%q (%s) is not a known condition operator, did you mean %s?", k, value, o))
+			return
+		}
+	}
+
+	ws = append(ws, fmt.Sprintf("%q (%s) is not a known condition operator", k, value))
+	return
+}
+
+// policyGlobalConditionKeys are the AWS global condition context keys.
+// Keys ending in "/" take a tag key suffix.
+// See https://docs.aws.amazon.com/IAM/latest/UserGuide/reference_policies_condition-keys.html.
+var policyGlobalConditionKeys = []string{
+	"aws:AssumedRoot",
+	"aws:CalledVia",
+	"aws:CalledViaFirst",
+	"aws:CalledViaLast",
+	"aws:ChatbotSourceArn",
+	"aws:CurrentTime",
+	"aws:Ec2InstanceSourcePrivateIPv4",
+	"aws:Ec2InstanceSourceVpc",
+	"aws:EpochTime",
+	"aws:FederatedProvider",
+	"aws:MultiFactorAuthAge",
+	"aws:MultiFactorAuthPresent",
+	"aws:PrincipalAccount",
+	"aws:PrincipalArn",
+	"aws:PrincipalIsAWSService",
+	"aws:PrincipalOrgID",
+	"aws:PrincipalOrgPaths",
+	"aws:PrincipalServiceName",
+	"aws:PrincipalServiceNamesList",
+	"aws:PrincipalTag/",
+	"aws:PrincipalType",
+	"aws:referer",
+	"aws:RequestedRegion",
+	"aws:RequestTag/",
+	"aws:ResourceAccount",
+	"aws:ResourceOrgID",
+	"aws:ResourceOrgPaths",
+	"aws:ResourceTag/",
+	"aws:SecureTransport",
+	"aws:SourceAccount",
+	"aws:SourceArn",
+	"aws:SourceIdentity",
+	"aws:SourceIp",
+	"aws:SourceOrgID",
+	"aws:SourceOrgPaths",
+	"aws:SourceVpc",
+	"aws:SourceVpce",
+	"aws:TagKeys",
+	"aws:TokenIssueTime",
+	"aws:UserAgent",
+	"aws:userid",
+	"aws:username",
+	"aws:ViaAWSService",
+	"aws:VpcSourceIp",
+}
+
+// validPolicyConditionKey warns about AWS global condition keys ("aws:" prefix) that are
+// unknown or that do not use the documented casing. Service-specific keys are not checked.
+func validPolicyConditionKey(v interface{}, k string) (ws []string, es []error) {
+	value := v.(string)
+
+	if !strings.HasPrefix(strings.ToLower(value), "aws:") {
+		return
+	}
+
+	for _, key := range policyGlobalConditionKeys {
+		if tagKey, ok := strings.CutSuffix(key, "/"); ok {
+			if len(value) > len(key) && strings.EqualFold(value[:len(key)], key) {
+				if value[:len(key)] != key {
+					ws = append(ws, fmt.Sprintf("%q (%s) does not match the documented casing of global condition key %s%s", k, value, key, value[len(key):]))
+				}
+				return
+			}
+
+			if strings.EqualFold(value, tagKey) {
+				ws = append(ws, fmt.Sprintf("%q (%s) requires a tag key, for example %stag-key", k, value, key))
+				return
+			}
+
+			continue
+		}
+
+		if value == key {
+			return
+		}
+
+		if strings.EqualFold(value, key) {
+			ws = append(ws, fmt.Sprintf("%q (%s) does not match the documented casing of global condition key %s", k, value, key))
+			return
+		}
+	}
+
+	ws = append(ws, fmt.Sprintf("%q (%s) is not a known AWS global condition key", k, value))
+	return
+}
@@ -131,3 +131,85 @@ func TestValidRolePolicyRoleName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidPolicyConditionOperator(t *testing.T) {
+	t.Parallel()
+
+	validOperators := []string{
+		"StringEquals",
+		"StringLikeIfExists",
+		"ForAllValues:StringEquals",
+		"ForAnyValue:ArnLikeIfExists",
+		"Null",
+		"Bool",
+	}
+
+	for _, s := range validOperators {
+		warnings, errors := validPolicyConditionOperator(s, "test")
+		if len(errors) > 0 || len(warnings) > 0 {
+			t.Fatalf("%q should be a valid condition operator: %v %v", s, warnings, errors)
+		}
+	}
+
+	unknownOperators := []string{
+		"StringEqual",
+		"stringequals",
+	}
+
+	for _, s := range unknownOperators {
+		warnings, errors := validPolicyConditionOperator(s, "test")
+		if len(errors) > 0 {
+			t.Fatalf("%q should not produce errors: %v", s, errors)
+		}
+		if len(warnings) == 0 {
+			t.Fatalf("%q should produce a warning", s)
+		}
+	}
+
+	invalidOperators := []string{
+		"ForSomeValues:StringEquals",
+		"NullIfExists",
+		"",
+	}
+
+	for _, s := range invalidOperators {
+		_, errors := validPolicyConditionOperator(s, "test")
+		if len(errors) == 0 {
+			t.Fatalf("%q should not be a valid condition operator", s)
+		}
+	}
+}
+
+func TestValidPolicyConditionKey(t *testing.T) {
+	t.Parallel()
+
+	noWarningKeys := []string{
+		"aws:SourceVpc",
+		"aws:PrincipalTag/Department",
+		"aws:username",
+		"s3:prefix",
+		"ec2:ResourceTag/Name",
+	}
+
+	for _, s := range noWarningKeys {
+		warnings, _ := validPolicyConditionKey(s, "variable")
+		if len(warnings) > 0 {
+			t.Fatalf("%q should not produce warnings: %v", s, warnings)
+		}
+	}
+
+	warningKeys := []string{
+		"aws:SourceVPC",
+		"AWS:SourceVpc",
+		"aws:principaltag/Department",
+		"aws:ResourceTag",
+		"aws:SourceVpcId",
+	}
+
+	for _, s := range warningKeys {
+		warnings, _ := validPolicyConditionKey(s, "variable")
+		if len(warnings) == 0 {
+			t.Fatalf("%q should produce a warning", s)
+		}
+	}
+}
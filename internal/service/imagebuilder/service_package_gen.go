@@ -143,6 +143,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  ResourceLifecyclePolicy,
+			TypeName: "aws_imagebuilder_lifecycle_policy",
+			Name:     "Lifecycle Policy",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
 		{
 			Factory:  ResourceWorkflow,
 			TypeName: "aws_imagebuilder_workflow",
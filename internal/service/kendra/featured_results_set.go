@@ -0,0 +1,311 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kendra
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/kendra"
+	"github.com/aws/aws-sdk-go-v2/service/kendra/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_kendra_featured_results_set", name="Featured Results Set")
+// @Tags(identifierAttribute="arn")
+func ResourceFeaturedResultsSet() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceFeaturedResultsSetCreate,
+		ReadWithoutTimeout:   resourceFeaturedResultsSetRead,
+		UpdateWithoutTimeout: resourceFeaturedResultsSetUpdate,
+		DeleteWithoutTimeout: resourceFeaturedResultsSetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrCreatedAt: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"featured_documents": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 4,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrID: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 2048),
+						},
+					},
+				},
+			},
+			"featured_results_set_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"index_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 100),
+			},
+			"query_texts": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				MaxItems: 49,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(1, 1000),
+				},
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          string(types.FeaturedResultsSetStatusActive),
+				ValidateDiagFunc: enum.Validate[types.FeaturedResultsSetStatus](),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"updated_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceFeaturedResultsSetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	in := &kendra.CreateFeaturedResultsSetInput{
+		ClientToken:            aws.String(id.UniqueId()),
+		FeaturedResultsSetName: aws.String(name),
+		IndexId:                aws.String(d.Get("index_id").(string)),
+		Status:                 types.FeaturedResultsSetStatus(d.Get(names.AttrStatus).(string)),
+		Tags:                   getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		in.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("featured_documents"); ok {
+		in.FeaturedDocuments = expandFeaturedDocuments(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("query_texts"); ok && v.(*schema.Set).Len() > 0 {
+		in.QueryTexts = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	out, err := conn.CreateFeaturedResultsSet(ctx, in)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Amazon Kendra Featured Results Set (%s): %s", name, err)
+	}
+
+	if out == nil || out.FeaturedResultsSet == nil {
+		return sdkdiag.AppendErrorf(diags, "creating Amazon Kendra Featured Results Set (%s): empty output", name)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", aws.ToString(out.FeaturedResultsSet.FeaturedResultsSetId), d.Get("index_id").(string)))
+
+	return append(diags, resourceFeaturedResultsSetRead(ctx, d, meta)...)
+}
+
+func resourceFeaturedResultsSetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	id, indexId, err := FeaturedResultsSetParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	out, err := FindFeaturedResultsSetByID(ctx, conn, id, indexId)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kendra Featured Results Set (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Kendra Featured Results Set (%s): %s", d.Id(), err)
+	}
+
+	arn := arn.ARN{
+		Partition: meta.(*conns.AWSClient).Partition,
+		Region:    meta.(*conns.AWSClient).Region,
+		Service:   "kendra",
+		AccountID: meta.(*conns.AWSClient).AccountID,
+		Resource:  fmt.Sprintf("index/%s/featured-results-set/%s", indexId, id),
+	}.String()
+
+	d.Set(names.AttrARN, arn)
+	d.Set(names.AttrCreatedAt, time.UnixMilli(aws.ToInt64(out.CreationTimestamp)).UTC().Format(time.RFC3339))
+	d.Set(names.AttrDescription, out.Description)
+	d.Set("featured_results_set_id", out.FeaturedResultsSetId)
+	d.Set("index_id", indexId)
+	d.Set(names.AttrName, out.FeaturedResultsSetName)
+	d.Set("query_texts", out.QueryTexts)
+	d.Set(names.AttrStatus, out.Status)
+	d.Set("updated_at", time.UnixMilli(aws.ToInt64(out.LastUpdatedTimestamp)).UTC().Format(time.RFC3339))
+
+	if err := d.Set("featured_documents", flattenFeaturedDocumentsWithMetadata(out.FeaturedDocumentsWithMetadata, out.FeaturedDocumentsMissing)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting featured_documents: %s", err)
+	}
+
+	return diags
+}
+
+func resourceFeaturedResultsSetUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		id, indexId, err := FeaturedResultsSetParseResourceID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		// UpdateFeaturedResultsSet replaces the whole set, so send every field.
+		input := &kendra.UpdateFeaturedResultsSetInput{
+			Description:            aws.String(d.Get(names.AttrDescription).(string)),
+			FeaturedDocuments:      expandFeaturedDocuments(d.Get("featured_documents").([]interface{})),
+			FeaturedResultsSetId:   aws.String(id),
+			FeaturedResultsSetName: aws.String(d.Get(names.AttrName).(string)),
+			IndexId:                aws.String(indexId),
+			QueryTexts:             flex.ExpandStringValueSet(d.Get("query_texts").(*schema.Set)),
+			Status:                 types.FeaturedResultsSetStatus(d.Get(names.AttrStatus).(string)),
+		}
+
+		_, err = conn.UpdateFeaturedResultsSet(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Kendra Featured Results Set (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceFeaturedResultsSetRead(ctx, d, meta)...)
+}
+
+func resourceFeaturedResultsSetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).KendraClient(ctx)
+
+	id, indexId, err := FeaturedResultsSetParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[INFO] Deleting Kendra Featured Results Set %s", d.Id())
+	out, err := conn.BatchDeleteFeaturedResultsSet(ctx, &kendra.BatchDeleteFeaturedResultsSetInput{
+		FeaturedResultsSetIds: []string{id},
+		IndexId:               aws.String(indexId),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Kendra Featured Results Set (%s): %s", d.Id(), err)
+	}
+
+	if out != nil && len(out.Errors) > 0 {
+		// The batch API reports a missing set as a per-item error rather than an exception.
+		if _, err := FindFeaturedResultsSetByID(ctx, conn, id, indexId); tfresource.NotFound(err) {
+			return diags
+		}
+
+		return sdkdiag.AppendErrorf(diags, "deleting Kendra Featured Results Set (%s): %s: %s", d.Id(), out.Errors[0].ErrorCode, aws.ToString(out.Errors[0].ErrorMessage))
+	}
+
+	return diags
+}
+
+func expandFeaturedDocuments(tfList []interface{}) []types.FeaturedDocument {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []types.FeaturedDocument
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, types.FeaturedDocument{
+			Id: aws.String(tfMap[names.AttrID].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenFeaturedDocumentsWithMetadata(apiObjects []types.FeaturedDocumentWithMetadata, missing []types.FeaturedDocumentMissing) []interface{} {
+	if len(apiObjects) == 0 && len(missing) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrID: aws.ToString(apiObject.Id),
+		})
+	}
+
+	// Documents that are no longer in the index are still part of the set.
+	for _, apiObject := range missing {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrID: aws.ToString(apiObject.Id),
+		})
+	}
+
+	return tfList
+}
@@ -41,6 +41,33 @@ func FindDataSourceByID(ctx context.Context, conn *kendra.Client, id, indexId st
 	return out, nil
 }
 
+func FindFeaturedResultsSetByID(ctx context.Context, conn *kendra.Client, id, indexId string) (*kendra.DescribeFeaturedResultsSetOutput, error) {
+	in := &kendra.DescribeFeaturedResultsSetInput{
+		FeaturedResultsSetId: aws.String(id),
+		IndexId:              aws.String(indexId),
+	}
+
+	out, err := conn.DescribeFeaturedResultsSet(ctx, in)
+
+	var resourceNotFoundException *types.ResourceNotFoundException
+	if errors.As(err, &resourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
 func FindFaqByID(ctx context.Context, conn *kendra.Client, id, indexId string) (*kendra.DescribeFaqOutput, error) {
 	in := &kendra.DescribeFaqInput{
 		Id:      aws.String(id),
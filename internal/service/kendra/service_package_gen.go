@@ -70,6 +70,14 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory:  ResourceFeaturedResultsSet,
+			TypeName: "aws_kendra_featured_results_set",
+			Name:     "Featured Results Set",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			},
+		},
 		{
 			Factory:  ResourceIndex,
 			TypeName: "aws_kendra_index",
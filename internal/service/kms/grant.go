@@ -38,6 +38,7 @@ func resourceGrant() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGrantCreate,
 		ReadWithoutTimeout:   resourceGrantRead,
+		UpdateWithoutTimeout: resourceGrantUpdate,
 		DeleteWithoutTimeout: resourceGrantDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -55,24 +56,29 @@ func resourceGrant() *schema.Resource {
 			},
 		},
 
+		CustomizeDiff: resourceGrantCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
+			"allow_constraints_update": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			// Changes to constraints force a new resource unless allow_constraints_update is set, see resourceGrantCustomizeDiff.
 			"constraints": {
 				Type:     schema.TypeSet,
 				Optional: true,
-				ForceNew: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"encryption_context_equals": {
 							Type:     schema.TypeMap,
 							Optional: true,
-							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 							// ConflictsWith encryption_context_subset handled in Create, see grantConstraintsIsValid
 						},
 						"encryption_context_subset": {
 							Type:     schema.TypeMap,
 							Optional: true,
-							ForceNew: true,
 							Elem:     &schema.Schema{Type: schema.TypeString},
 							// ConflictsWith encryption_context_equals handled in Create, see grantConstraintsIsValid
 						},
@@ -148,44 +154,12 @@ func resourceGrantCreate(ctx context.Context, d *schema.ResourceData, meta inter
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
 
 	keyID := d.Get(names.AttrKeyID).(string)
-	input := &kms.CreateGrantInput{
-		GranteePrincipal: aws.String(d.Get("grantee_principal").(string)),
-		KeyId:            aws.String(keyID),
-		Operations:       flex.ExpandStringyValueSet[awstypes.GrantOperation](d.Get("operations").(*schema.Set)),
-	}
-
-	if v, ok := d.GetOk("constraints"); ok && v.(*schema.Set).Len() > 0 {
-		if !grantConstraintsIsValid(v.(*schema.Set)) {
-			return sdkdiag.AppendErrorf(diags, "A grant constraint can't have both encryption_context_equals and encryption_context_subset set")
-		}
-
-		input.Constraints = expandGrantConstraints(v.(*schema.Set))
-	}
-
-	if v, ok := d.GetOk("grant_creation_tokens"); ok && v.(*schema.Set).Len() > 0 {
-		input.GrantTokens = flex.ExpandStringValueSet(v.(*schema.Set))
-	}
-
-	if v, ok := d.GetOk(names.AttrName); ok {
-		input.Name = aws.String(v.(string))
-	}
-
-	if v, ok := d.GetOk("retiring_principal"); ok {
-		input.RetiringPrincipal = aws.String(v.(string))
-	}
-
-	// Error Codes: https://docs.aws.amazon.com/sdk-for-go/api/service/kms/#KMS.CreateGrant
-	// Under some circumstances a newly created IAM Role doesn't show up and causes
-	// an InvalidArnException to be thrown.
-	outputRaw, err := tfresource.RetryWhenIsOneOf3[*awstypes.DependencyTimeoutException, *awstypes.KMSInternalException, *awstypes.InvalidArnException](ctx, propagationTimeout, func() (interface{}, error) {
-		return conn.CreateGrant(ctx, input)
-	})
+	output, err := createGrant(ctx, conn, d)
 
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating KMS Grant for Key (%s): %s", keyID, err)
 	}
 
-	output := outputRaw.(*kms.CreateGrantOutput)
 	grantID := aws.ToString(output.GrantId)
 	d.SetId(grantCreateResourceID(keyID, grantID))
 	d.Set("grant_token", output.GrantToken)
@@ -235,6 +209,36 @@ func resourceGrantRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	return diags
 }
 
+func resourceGrantUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	// Grants can't be modified. Constraints are updated by creating a replacement grant
+	// before revoking (or retiring) the existing grant, so that the grantee's permissions are never interrupted.
+	if d.HasChange("constraints") {
+		keyID, grantID, err := grantParseResourceID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		output, err := createGrant(ctx, conn, d)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating KMS Grant (%s): creating replacement grant: %s", d.Id(), err)
+		}
+
+		d.SetId(grantCreateResourceID(keyID, aws.ToString(output.GrantId)))
+		d.Set("grant_token", output.GrantToken)
+
+		// The replaced grant may carry broader constraints than the new one, so failing to remove it must not go unnoticed.
+		if err := deleteGrant(ctx, conn, keyID, grantID, d.Get("retire_on_delete").(bool)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating KMS Grant (%s): replaced grant (%s) on KMS Key (%s) is still active and is no longer managed by Terraform, revoke it manually: %s", d.Id(), grantID, keyID, err)
+		}
+	}
+
+	return append(diags, resourceGrantRead(ctx, d, meta)...)
+}
+
 func resourceGrantDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).KMSClient(ctx)
@@ -244,26 +248,94 @@ func resourceGrantDelete(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendFromErr(diags, err)
 	}
 
-	if d.Get("retire_on_delete").(bool) {
-		log.Printf("[DEBUG] Retiring KMS Grant: %s", d.Id())
-		_, err = conn.RetireGrant(ctx, &kms.RetireGrantInput{
-			GrantId: aws.String(grantID),
-			KeyId:   aws.String(keyID),
-		})
-	} else {
-		log.Printf("[DEBUG] Revoking KMS Grant: %s", d.Id())
-		_, err = conn.RevokeGrant(ctx, &kms.RevokeGrantInput{
+	if err := deleteGrant(ctx, conn, keyID, grantID, d.Get("retire_on_delete").(bool)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting KMS Grant (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceGrantCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("constraints") {
+		return nil
+	}
+
+	if !diff.Get("allow_constraints_update").(bool) {
+		return diff.ForceNew("constraints")
+	}
+
+	if err := diff.SetNewComputed("grant_id"); err != nil {
+		return err
+	}
+
+	return diff.SetNewComputed("grant_token")
+}
+
+func createGrant(ctx context.Context, conn *kms.Client, d *schema.ResourceData) (*kms.CreateGrantOutput, error) {
+	input := &kms.CreateGrantInput{
+		GranteePrincipal: aws.String(d.Get("grantee_principal").(string)),
+		KeyId:            aws.String(d.Get(names.AttrKeyID).(string)),
+		Operations:       flex.ExpandStringyValueSet[awstypes.GrantOperation](d.Get("operations").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("constraints"); ok && v.(*schema.Set).Len() > 0 {
+		if !grantConstraintsIsValid(v.(*schema.Set)) {
+			return nil, fmt.Errorf("A grant constraint can't have both encryption_context_equals and encryption_context_subset set")
+		}
+
+		input.Constraints = expandGrantConstraints(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk("grant_creation_tokens"); ok && v.(*schema.Set).Len() > 0 {
+		input.GrantTokens = flex.ExpandStringValueSet(v.(*schema.Set))
+	}
+
+	if v, ok := d.GetOk(names.AttrName); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("retiring_principal"); ok {
+		input.RetiringPrincipal = aws.String(v.(string))
+	}
+
+	// Error Codes: https://docs.aws.amazon.com/sdk-for-go/api/service/kms/#KMS.CreateGrant
+	// Under some circumstances a newly created IAM Role doesn't show up and causes
+	// an InvalidArnException to be thrown.
+	outputRaw, err := tfresource.RetryWhenIsOneOf3[*awstypes.DependencyTimeoutException, *awstypes.KMSInternalException, *awstypes.InvalidArnException](ctx, propagationTimeout, func() (interface{}, error) {
+		return conn.CreateGrant(ctx, input)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*kms.CreateGrantOutput), nil
+}
+
+// deleteGrant retires or revokes the specified grant and waits for it to be deleted.
+func deleteGrant(ctx context.Context, conn *kms.Client, keyID, grantID string, retire bool) error {
+	_, err := tfresource.RetryWhenIsOneOf2[*awstypes.DependencyTimeoutException, *awstypes.KMSInternalException](ctx, propagationTimeout, func() (interface{}, error) {
+		if retire {
+			log.Printf("[DEBUG] Retiring KMS Grant: %s", grantCreateResourceID(keyID, grantID))
+			return conn.RetireGrant(ctx, &kms.RetireGrantInput{
+				GrantId: aws.String(grantID),
+				KeyId:   aws.String(keyID),
+			})
+		}
+
+		log.Printf("[DEBUG] Revoking KMS Grant: %s", grantCreateResourceID(keyID, grantID))
+		return conn.RevokeGrant(ctx, &kms.RevokeGrantInput{
 			GrantId: aws.String(grantID),
 			KeyId:   aws.String(keyID),
 		})
-	}
+	})
 
 	if errs.IsA[*awstypes.NotFoundException](err) {
-		return diags
+		return nil
 	}
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "deleting KMS Grant (%s): %s", d.Id(), err)
+		return err
 	}
 
 	_, err = tfresource.RetryUntilNotFound(ctx, propagationTimeout, func() (interface{}, error) {
@@ -271,10 +343,10 @@ func resourceGrantDelete(ctx context.Context, d *schema.ResourceData, meta inter
 	})
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "waiting for KMS Grant (%s) delete: %s", d.Id(), err)
+		return fmt.Errorf("waiting for delete: %w", err)
 	}
 
-	return diags
+	return nil
 }
 
 func findGrant(ctx context.Context, conn *kms.Client, input *kms.ListGrantsInput, filter tfslices.Predicate[*awstypes.GrantListEntry]) (*awstypes.GrantListEntry, error) {
@@ -10,6 +10,7 @@ import (
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -45,7 +46,7 @@ func TestAccKMSGrant_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"grant_token", "retire_on_delete"},
+				ImportStateVerifyIgnore: []string{"allow_constraints_update", "grant_token", "retire_on_delete"},
 			},
 		},
 	})
@@ -80,7 +81,7 @@ func TestAccKMSGrant_withConstraints(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"grant_token", "retire_on_delete"},
+				ImportStateVerifyIgnore: []string{"allow_constraints_update", "grant_token", "retire_on_delete"},
 			},
 			{
 				Config: testAccGrantConfig_constraints(rName, "encryption_context_subset", `foo = "bar"
@@ -100,6 +101,50 @@ func TestAccKMSGrant_withConstraints(t *testing.T) {
 	})
 }
 
+func TestAccKMSGrant_constraintsUpdate(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_kms_grant.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	var grantID string
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGrantConfig_constraintsUpdate(rName, `foo = "bar"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGrantExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "allow_constraints_update", acctest.CtTrue),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "constraints.*", map[string]string{
+						"encryption_context_subset.%":   acctest.Ct1,
+						"encryption_context_subset.foo": "bar",
+					}),
+					testAccCheckGrantID(resourceName, &grantID),
+				),
+			},
+			{
+				Config: testAccGrantConfig_constraintsUpdate(rName, `foo = "baz"`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGrantExists(ctx, resourceName),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "constraints.*", map[string]string{
+						"encryption_context_subset.%":   acctest.Ct1,
+						"encryption_context_subset.foo": "baz",
+					}),
+					testAccCheckGrantRecreated(ctx, resourceName, &grantID),
+				),
+			},
+		},
+	})
+}
+
 func TestAccKMSGrant_withRetiringPrincipal(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_kms_grant.test"
@@ -122,7 +167,7 @@ func TestAccKMSGrant_withRetiringPrincipal(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"grant_token", "retire_on_delete"},
+				ImportStateVerifyIgnore: []string{"allow_constraints_update", "grant_token", "retire_on_delete"},
 			},
 		},
 	})
@@ -152,7 +197,7 @@ func TestAccKMSGrant_bare(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"grant_token", "retire_on_delete"},
+				ImportStateVerifyIgnore: []string{"allow_constraints_update", "grant_token", "retire_on_delete"},
 			},
 		},
 	})
@@ -185,7 +230,7 @@ func TestAccKMSGrant_arn(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"grant_token", "retire_on_delete"},
+				ImportStateVerifyIgnore: []string{"allow_constraints_update", "grant_token", "retire_on_delete"},
 			},
 		},
 	})
@@ -212,7 +257,7 @@ func TestAccKMSGrant_asymmetricKey(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"grant_token", "retire_on_delete"},
+				ImportStateVerifyIgnore: []string{"allow_constraints_update", "grant_token", "retire_on_delete"},
 			},
 		},
 	})
@@ -271,7 +316,7 @@ func TestAccKMSGrant_crossAccountARN(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"grant_token", "retire_on_delete"},
+				ImportStateVerifyIgnore: []string{"allow_constraints_update", "grant_token", "retire_on_delete"},
 			},
 		},
 	})
@@ -306,7 +351,7 @@ func TestAccKMSGrant_service(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"grant_token", "retire_on_delete"},
+				ImportStateVerifyIgnore: []string{"allow_constraints_update", "grant_token", "retire_on_delete"},
 			},
 		},
 	})
@@ -363,6 +408,47 @@ func testAccCheckGrantExists(ctx context.Context, n string) resource.TestCheckFu
 	}
 }
 
+func testAccCheckGrantID(n string, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		*v = rs.Primary.Attributes["grant_id"]
+
+		return nil
+	}
+}
+
+// testAccCheckGrantRecreated checks that the grant has a new grant ID and that the previous grant has been deleted.
+func testAccCheckGrantRecreated(ctx context.Context, n string, v *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["grant_id"] == *v {
+			return fmt.Errorf("KMS Grant (%s) was not recreated", *v)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+
+		_, err := tfkms.FindGrantByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrKeyID], *v)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("KMS Grant (%s) still exists", *v)
+	}
+}
+
 func testAccGrantConfig_base(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_kms_key" "test" {
@@ -418,6 +504,24 @@ resource "aws_kms_grant" "test" {
 `, rName, constraintName, encryptionContext))
 }
 
+func testAccGrantConfig_constraintsUpdate(rName string, encryptionContext string) string {
+	return acctest.ConfigCompose(testAccGrantConfig_base(rName), fmt.Sprintf(`
+resource "aws_kms_grant" "test" {
+  name                     = %[1]q
+  key_id                   = aws_kms_key.test.key_id
+  grantee_principal        = aws_iam_role.test.arn
+  operations               = ["Decrypt", "DescribeKey"]
+  allow_constraints_update = true
+
+  constraints {
+    encryption_context_subset = {
+      %[2]s
+    }
+  }
+}
+`, rName, encryptionContext))
+}
+
 func testAccGrantConfig_retiringPrincipal(rName string) string {
 	return acctest.ConfigCompose(testAccGrantConfig_base(rName), fmt.Sprintf(`
 resource "aws_kms_grant" "test" {
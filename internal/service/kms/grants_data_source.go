@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_kms_grants", name="Grants")
+func dataSourceGrants() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceGrantsRead,
+
+		Schema: map[string]*schema.Schema{
+			"grant_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"grantee_principal": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"grants": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"constraints": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"encryption_context_equals": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"encryption_context_subset": {
+										Type:     schema.TypeMap,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						names.AttrCreationDate: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"grant_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"grantee_principal": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"issuing_account": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrKeyID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"operations": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"retiring_principal": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrKeyID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateKey,
+			},
+		},
+	}
+}
+
+func dataSourceGrantsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KMSClient(ctx)
+
+	keyID := d.Get(names.AttrKeyID).(string)
+	input := &kms.ListGrantsInput{
+		KeyId: aws.String(keyID),
+		Limit: aws.Int32(100),
+	}
+
+	if v, ok := d.GetOk("grant_id"); ok {
+		input.GrantId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("grantee_principal"); ok {
+		input.GranteePrincipal = aws.String(v.(string))
+	}
+
+	grants, err := findGrants(ctx, conn, input, tfslices.PredicateTrue[*awstypes.GrantListEntry]())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading KMS Key (%s) Grants: %s", keyID, err)
+	}
+
+	d.SetId(keyID)
+	if err := d.Set("grants", flattenGrantListEntries(grants)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting grants: %s", err)
+	}
+
+	return diags
+}
+
+func flattenGrantListEntries(apiObjects []awstypes.GrantListEntry) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"constraints":        []interface{}{},
+			"grant_id":           aws.ToString(apiObject.GrantId),
+			"grantee_principal":  aws.ToString(apiObject.GranteePrincipal),
+			"issuing_account":    aws.ToString(apiObject.IssuingAccount),
+			names.AttrKeyID:      aws.ToString(apiObject.KeyId),
+			names.AttrName:       aws.ToString(apiObject.Name),
+			"operations":         flex.FlattenStringyValueSet(apiObject.Operations),
+			"retiring_principal": aws.ToString(apiObject.RetiringPrincipal),
+		}
+
+		if v := apiObject.Constraints; v != nil {
+			tfMap["constraints"] = []interface{}{map[string]interface{}{
+				"encryption_context_equals": flex.FlattenStringValueMap(v.EncryptionContextEquals),
+				"encryption_context_subset": flex.FlattenStringValueMap(v.EncryptionContextSubset),
+			}}
+		}
+
+		if v := apiObject.CreationDate; v != nil {
+			tfMap[names.AttrCreationDate] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
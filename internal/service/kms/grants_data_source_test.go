@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKMSGrantsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_kms_grant.test"
+	dataSourceName := "data.aws_kms_grants.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGrantsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "grants.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "grants.0.constraints.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "grants.0.constraints.0.encryption_context_equals.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "grants.0.constraints.0.encryption_context_equals.foo", "bar"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "grants.0.creation_date"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "grants.0.grant_id", resourceName, "grant_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "grants.0.grantee_principal", resourceName, "grantee_principal"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "grants.0.issuing_account"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "grants.0.name", resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "grants.0.operations.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "grants.0.operations.*", "DescribeKey"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "grants.0.operations.*", "RetireGrant"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGrantsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGrantConfig_constraints(rName, "encryption_context_equals", `foo = "bar"`), `
+data "aws_kms_grants" "test" {
+  key_id = aws_kms_grant.test.key_id
+
+  depends_on = [aws_kms_grant.test]
+}
+`)
+}
@@ -40,6 +40,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_kms_custom_key_store",
 			Name:     "Custom Key Store",
 		},
+		{
+			Factory:  dataSourceGrants,
+			TypeName: "aws_kms_grants",
+			Name:     "Grants",
+		},
 		{
 			Factory:  dataSourceKey,
 			TypeName: "aws_kms_key",
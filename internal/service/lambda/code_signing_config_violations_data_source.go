@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	codeSigningViolationReasonUnsigned           = "UNSIGNED"
+	codeSigningViolationReasonUntrustedPublisher = "UNTRUSTED_PUBLISHER"
+)
+
+// @SDKDataSource("aws_lambda_code_signing_config_violations", name="Code Signing Config Violations")
+func dataSourceCodeSigningConfigViolations() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCodeSigningConfigViolationsRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"function_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"untrusted_artifact_on_deployment": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"violations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrFunctionARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"reason": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"signing_job_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"signing_profile_version_arn": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCodeSigningConfigViolationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	arn := d.Get(names.AttrARN).(string)
+	config, err := findCodeSigningConfigByARN(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Lambda Code Signing Config (%s): %s", arn, err)
+	}
+
+	functionARNs, err := findFunctionARNsByCodeSigningConfigARN(ctx, conn, arn)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Lambda Functions by Code Signing Config (%s): %s", arn, err)
+	}
+
+	var allowedProfileVersionARNs []string
+	if v := config.AllowedPublishers; v != nil {
+		allowedProfileVersionARNs = v.SigningProfileVersionArns
+	}
+
+	var violations []interface{}
+	for _, functionARN := range functionARNs {
+		output, err := findFunction(ctx, conn, &lambda.GetFunctionInput{
+			FunctionName: aws.String(functionARN),
+		})
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Lambda Function (%s): %s", functionARN, err)
+		}
+
+		if violation := codeSigningViolation(output.Configuration, allowedProfileVersionARNs); violation != nil {
+			violations = append(violations, violation)
+		}
+	}
+
+	d.SetId(aws.ToString(config.CodeSigningConfigArn))
+	d.Set("function_arns", functionARNs)
+	if v := config.CodeSigningPolicies; v != nil {
+		d.Set("untrusted_artifact_on_deployment", v.UntrustedArtifactOnDeployment)
+	}
+	if err := d.Set("violations", violations); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting violations: %s", err)
+	}
+
+	return diags
+}
+
+func findFunctionARNsByCodeSigningConfigARN(ctx context.Context, conn *lambda.Client, arn string) ([]string, error) {
+	input := &lambda.ListFunctionsByCodeSigningConfigInput{
+		CodeSigningConfigArn: aws.String(arn),
+	}
+	var output []string
+
+	pages := lambda.NewListFunctionsByCodeSigningConfigPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.FunctionArns...)
+	}
+
+	return output, nil
+}
+
+// codeSigningViolation returns the violation, if any, of a function's deployed code against a code signing config's allowed publishers.
+// Violations can only exist for functions deployed while the config's policy was Warn.
+func codeSigningViolation(apiObject *awstypes.FunctionConfiguration, allowedProfileVersionARNs []string) map[string]interface{} {
+	if apiObject == nil || apiObject.PackageType == awstypes.PackageTypeImage {
+		return nil
+	}
+
+	profileVersionARN := aws.ToString(apiObject.SigningProfileVersionArn)
+
+	var reason string
+	switch {
+	case profileVersionARN == "":
+		reason = codeSigningViolationReasonUnsigned
+	case !slices.Contains(allowedProfileVersionARNs, profileVersionARN):
+		reason = codeSigningViolationReasonUntrustedPublisher
+	default:
+		return nil
+	}
+
+	return map[string]interface{}{
+		names.AttrFunctionARN:         aws.ToString(apiObject.FunctionArn),
+		"reason":                      reason,
+		"signing_job_arn":             aws.ToString(apiObject.SigningJobArn),
+		"signing_profile_version_arn": profileVersionARN,
+	}
+}
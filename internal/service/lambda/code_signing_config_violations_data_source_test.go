@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaCodeSigningConfigViolationsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if curr := acctest.Region(); !tflambda.SignerServiceIsAvailable(curr) {
+		t.Skipf("Lambda code signing config is not supported in %s region", curr)
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_code_signing_config_violations.test"
+	cscResourceName := "aws_lambda_code_signing_config.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSignerSigningProfile(ctx, t, "AWSLambda-SHA384-ECDSA")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCodeSigningConfigViolationsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, cscResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "function_arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "function_arns.0", functionResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "untrusted_artifact_on_deployment", "Warn"),
+					resource.TestCheckResourceAttr(dataSourceName, "violations.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "violations.0.function_arn", functionResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "violations.0.reason", "UNSIGNED"),
+					resource.TestCheckResourceAttr(dataSourceName, "violations.0.signing_job_arn", ""),
+					resource.TestCheckResourceAttr(dataSourceName, "violations.0.signing_profile_version_arn", ""),
+				),
+			},
+		},
+	})
+}
+
+func testAccCodeSigningConfigViolationsDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  statement {
+    effect  = "Allow"
+    actions = ["sts:AssumeRole"]
+
+    principals {
+      identifiers = ["lambda.amazonaws.com"]
+      type        = "Service"
+    }
+  }
+}
+
+resource "aws_iam_role" "test" {
+  name               = %[1]q
+  assume_role_policy = data.aws_iam_policy_document.test.json
+}
+
+resource "aws_signer_signing_profile" "test" {
+  platform_id = "AWSLambda-SHA384-ECDSA"
+}
+
+resource "aws_lambda_code_signing_config" "test" {
+  allowed_publishers {
+    signing_profile_version_arns = [
+      aws_signer_signing_profile.test.version_arn
+    ]
+  }
+
+  policies {
+    untrusted_artifact_on_deployment = "Warn"
+  }
+}
+
+resource "aws_lambda_function" "test" {
+  filename                = "test-fixtures/lambdatest.zip"
+  function_name           = %[1]q
+  role                    = aws_iam_role.test.arn
+  handler                 = "exports.example"
+  runtime                 = "nodejs20.x"
+  code_signing_config_arn = aws_lambda_code_signing_config.test.arn
+}
+
+data "aws_lambda_code_signing_config_violations" "test" {
+  arn = aws_lambda_code_signing_config.test.arn
+
+  depends_on = [aws_lambda_function.test]
+}
+`, rName)
+}
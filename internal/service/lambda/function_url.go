@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"net/http"
 	"net/url"
 	"strings"
 	"time"
@@ -62,22 +63,38 @@ func resourceFunctionURL() *schema.Resource {
 						"allow_headers": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							MaxItems: 100,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(0, 1024),
+							},
 						},
 						"allow_methods": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							MaxItems: 6,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(functionURLCORSAllowMethods(), true),
+							},
 						},
 						"allow_origins": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							MaxItems: 100,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 253),
+							},
 						},
 						"expose_headers": {
 							Type:     schema.TypeSet,
 							Optional: true,
-							Elem:     &schema.Schema{Type: schema.TypeString},
+							MaxItems: 100,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(0, 1024),
+							},
 						},
 						"max_age": {
 							Type:         schema.TypeInt,
@@ -140,6 +157,13 @@ func resourceFunctionURLCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	if v, ok := d.GetOk("cors"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		input.Cors = expandCors(v.([]interface{})[0].(map[string]interface{}))
+		diags = append(diags, functionURLCORSWarnings(d)...)
+	}
+
+	if input.InvokeMode == awstypes.InvokeModeResponseStream {
+		if err := checkFunctionURLResponseStreamSupported(ctx, conn, name, qualifier); err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating Lambda Function URL (%s): %s", id, err)
+		}
 	}
 
 	_, err := conn.CreateFunctionUrlConfig(ctx, input)
@@ -253,10 +277,17 @@ func resourceFunctionURLUpdate(ctx context.Context, d *schema.ResourceData, meta
 		} else {
 			input.Cors = &awstypes.Cors{}
 		}
+		diags = append(diags, functionURLCORSWarnings(d)...)
 	}
 
 	if d.HasChange("invoke_mode") {
 		input.InvokeMode = awstypes.InvokeMode(d.Get("invoke_mode").(string))
+
+		if input.InvokeMode == awstypes.InvokeModeResponseStream {
+			if err := checkFunctionURLResponseStreamSupported(ctx, conn, name, qualifier); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating Lambda Function URL (%s): %s", d.Id(), err)
+			}
+		}
 	}
 
 	_, err = conn.UpdateFunctionUrlConfig(ctx, input)
@@ -357,6 +388,66 @@ func functionURLParseResourceID(id string) (string, string, error) {
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected FUNCTION-NAME%[2]sQUALIFIER or FUNCTION-NAME", id, functionURLResourceIDSeparator)
 }
 
+func functionURLCORSAllowMethods() []string {
+	return []string{
+		"*",
+		http.MethodDelete,
+		http.MethodGet,
+		http.MethodHead,
+		http.MethodPatch,
+		http.MethodPost,
+		http.MethodPut,
+	}
+}
+
+// functionURLCORSWarnings warns about CORS configurations that browsers will reject.
+func functionURLCORSWarnings(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if !d.Get("cors.0.allow_credentials").(bool) {
+		return diags
+	}
+
+	if v, ok := d.Get("cors.0.allow_origins").(*schema.Set); ok && v.Contains("*") {
+		diags = sdkdiag.AppendWarningf(diags, `cors.allow_credentials is true and cors.allow_origins contains "*"; browsers reject credentialed requests to wildcard origins`)
+	}
+
+	return diags
+}
+
+// checkFunctionURLResponseStreamSupported verifies that the function's runtime can stream responses.
+// Response streaming is supported natively by Node.js managed runtimes; other functions must use an OS-only runtime,
+// a container image or a layer that provides the streaming integration (e.g. the Lambda Web Adapter).
+// The function is read at apply time as its runtime isn't available when planning the function URL.
+func checkFunctionURLResponseStreamSupported(ctx context.Context, conn *lambda.Client, name, qualifier string) error {
+	input := &lambda.GetFunctionInput{
+		FunctionName: aws.String(name),
+	}
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := findFunction(ctx, conn, input)
+
+	if err != nil {
+		// Let the function URL API report a missing function.
+		log.Printf("[DEBUG] Unable to read Lambda Function (%s) configuration, skipping invoke_mode validation: %s", name, err)
+		return nil
+	}
+
+	configuration := output.Configuration
+
+	if configuration == nil || configuration.PackageType == awstypes.PackageTypeImage || len(configuration.Layers) > 0 {
+		return nil
+	}
+
+	if runtime := string(configuration.Runtime); runtime == "" || strings.HasPrefix(runtime, "nodejs") || strings.HasPrefix(runtime, "provided") {
+		return nil
+	}
+
+	return fmt.Errorf("invoke_mode %s is not supported by Lambda Function (%s) runtime %s; use a Node.js or OS-only runtime, a container image or a layer providing a response streaming integration", awstypes.InvokeModeResponseStream, name, configuration.Runtime)
+}
+
 func expandCors(tfMap map[string]interface{}) *awstypes.Cors {
 	if tfMap == nil {
 		return nil
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -91,7 +92,7 @@ func TestAccLambdaFunctionURL_Cors(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_methods.#", acctest.Ct1),
 					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_methods.*", "*"),
 					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_origins.#", acctest.Ct1),
-					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_origins.*", "*"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_origins.*", "https://www.example.com"),
 					resource.TestCheckResourceAttr(resourceName, "cors.0.expose_headers.#", acctest.Ct2),
 					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.expose_headers.*", "date"),
 					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.expose_headers.*", "keep-alive"),
@@ -135,6 +136,57 @@ func TestAccLambdaFunctionURL_Cors(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunctionURL_corsCredentialsWildcardOrigin(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetFunctionUrlConfigOutput
+	resourceName := "aws_lambda_function_url.test"
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_basic_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_basic_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_basic_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccFunctionURLPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionURLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLConfig_corsCredentialsWildcardOrigin(funcName, policyName, roleName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionURLExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "cors.0.allow_credentials", acctest.CtTrue),
+					resource.TestCheckTypeSetElemAttr(resourceName, "cors.0.allow_origins.*", "*"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunctionURL_invokeModeUnsupportedRuntime(t *testing.T) {
+	ctx := acctest.Context(t)
+	rString := sdkacctest.RandString(8)
+	funcName := fmt.Sprintf("tf_acc_lambda_func_basic_%s", rString)
+	policyName := fmt.Sprintf("tf_acc_policy_lambda_func_basic_%s", rString)
+	roleName := fmt.Sprintf("tf_acc_role_lambda_func_basic_%s", rString)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccFunctionURLPreCheck(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionURLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionURLConfig_invokeModeRuntime(funcName, policyName, roleName, "BUFFERED"),
+			},
+			{
+				Config:      testAccFunctionURLConfig_invokeModeRuntime(funcName, policyName, roleName, "RESPONSE_STREAM"),
+				ExpectError: regexache.MustCompile(`invoke_mode RESPONSE_STREAM is not supported by Lambda Function`),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunctionURL_Alias(t *testing.T) {
 	ctx := acctest.Context(t)
 	var conf lambda.GetFunctionUrlConfigOutput
@@ -435,7 +487,7 @@ resource "aws_lambda_function_url" "test" {
 
   cors {
     allow_credentials = true
-    allow_origins     = ["*"]
+    allow_origins     = ["https://www.example.com"]
     allow_methods     = ["*"]
     allow_headers     = ["date", "keep-alive"]
     expose_headers    = ["keep-alive", "date"]
@@ -496,7 +548,7 @@ resource "aws_lambda_function_url" "test" {
 
   cors {
     allow_credentials = true
-    allow_origins     = ["*"]
+    allow_origins     = ["https://www.example.com"]
     allow_methods     = ["*"]
     allow_headers     = ["date", "keep-alive"]
     expose_headers    = ["keep-alive", "date"]
@@ -524,6 +576,47 @@ resource "aws_lambda_function_url" "test" {
 `, funcName, invokeMode))
 }
 
+func testAccFunctionURLConfig_corsCredentialsWildcardOrigin(funcName, policyName, roleName string) string {
+	return acctest.ConfigCompose(testAccFunctionURLConfig_base(policyName, roleName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+}
+
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = "AWS_IAM"
+
+  cors {
+    allow_credentials = true
+    allow_origins     = ["*"]
+    allow_methods     = ["*"]
+  }
+}
+`, funcName))
+}
+
+func testAccFunctionURLConfig_invokeModeRuntime(funcName, policyName, roleName, invokeMode string) string {
+	return acctest.ConfigCompose(testAccFunctionURLConfig_base(policyName, roleName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "lambda_function.lambda_handler"
+  runtime       = "python3.12"
+}
+
+resource "aws_lambda_function_url" "test" {
+  function_name      = aws_lambda_function.test.function_name
+  authorization_type = "NONE"
+  invoke_mode        = %[2]q
+}
+`, funcName, invokeMode))
+}
+
 func testAccFunctionURLConfig_two(funcName, aliasName, policyName, roleName string) string {
 	return acctest.ConfigCompose(testAccFunctionURLConfig_base(policyName, roleName), fmt.Sprintf(`
 resource "aws_lambda_function" "test" {
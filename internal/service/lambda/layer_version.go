@@ -5,8 +5,11 @@ package lambda
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
 	"fmt"
 	"log"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -40,6 +43,8 @@ func resourceLayerVersion() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceLayerVersionCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -132,6 +137,11 @@ func resourceLayerVersion() *schema.Resource {
 				ForceNew: true,
 				Optional: true,
 			},
+			"skip_publish_if_unchanged": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
 			"source_code_hash": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -190,6 +200,28 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 		}
 	}
 
+	if d.Get("skip_publish_if_unchanged").(bool) {
+		codeSHA256 := d.Get("source_code_hash").(string)
+		if layerContent.ZipFile != nil {
+			codeSHA256 = layerContentSHA256(layerContent.ZipFile)
+		}
+
+		if codeSHA256 != "" {
+			output, err := findLatestLayerVersionByName(ctx, conn, layerName)
+
+			switch {
+			case tfresource.NotFound(err):
+			case err != nil:
+				return sdkdiag.AppendErrorf(diags, "reading Lambda Layer (%s) latest version: %s", layerName, err)
+			case output.Content != nil && aws.ToString(output.Content.CodeSha256) == codeSHA256 && layerVersionMatchesConfiguration(d, output):
+				log.Printf("[DEBUG] Lambda Layer (%s) content unchanged, reusing version %d", layerName, output.Version)
+				d.SetId(aws.ToString(output.LayerVersionArn))
+
+				return append(diags, resourceLayerVersionRead(ctx, d, meta)...)
+			}
+		}
+	}
+
 	input := &lambda.PublishLayerVersionInput{
 		Content:     layerContent,
 		Description: aws.String(d.Get(names.AttrDescription).(string)),
@@ -216,6 +248,15 @@ func resourceLayerVersionCreate(ctx context.Context, d *schema.ResourceData, met
 	return append(diags, resourceLayerVersionRead(ctx, d, meta)...)
 }
 
+func resourceLayerVersionCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	// A reused version may be held by the instance being replaced, whose destroy would delete it.
+	if diff.Get("skip_publish_if_unchanged").(bool) && !diff.Get(names.AttrSkipDestroy).(bool) {
+		return fmt.Errorf("`%s` must be `true` when `skip_publish_if_unchanged` is `true`", names.AttrSkipDestroy)
+	}
+
+	return nil
+}
+
 func resourceLayerVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
@@ -298,6 +339,33 @@ func layerVersionParseResourceID(id string) (layerName string, version int64, er
 	return
 }
 
+// findLatestLayerVersionByName returns the most recently published version of the specified layer.
+func findLatestLayerVersionByName(ctx context.Context, conn *lambda.Client, layerName string) (*lambda.GetLayerVersionOutput, error) {
+	input := &lambda.ListLayerVersionsInput{
+		LayerName: aws.String(layerName),
+		MaxItems:  aws.Int32(1),
+	}
+
+	output, err := conn.ListLayerVersions(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || len(output.LayerVersions) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return findLayerVersionByTwoPartKey(ctx, conn, layerName, output.LayerVersions[0].Version)
+}
+
 func findLayerVersionByTwoPartKey(ctx context.Context, conn *lambda.Client, layerName string, versionNumber int64) (*lambda.GetLayerVersionOutput, error) {
 	input := &lambda.GetLayerVersionInput{
 		LayerName:     aws.String(layerName),
@@ -327,3 +395,38 @@ func findLayerVersion(ctx context.Context, conn *lambda.Client, input *lambda.Ge
 
 	return output, nil
 }
+
+// layerContentSHA256 returns the base64-encoded SHA-256 hash of the layer archive, as reported by the Lambda API.
+func layerContentSHA256(zipFile []byte) string {
+	hash := sha256.Sum256(zipFile)
+
+	return base64.StdEncoding.EncodeToString(hash[:])
+}
+
+// layerVersionMatchesConfiguration returns whether the published layer version's metadata matches the configuration.
+func layerVersionMatchesConfiguration(d *schema.ResourceData, output *lambda.GetLayerVersionOutput) bool {
+	if aws.ToString(output.Description) != d.Get(names.AttrDescription).(string) {
+		return false
+	}
+
+	if aws.ToString(output.LicenseInfo) != d.Get("license_info").(string) {
+		return false
+	}
+
+	architectures := flex.ExpandStringValueSet(d.Get("compatible_architectures").(*schema.Set))
+	if !equalStringSets(architectures, enum.Slice(output.CompatibleArchitectures...)) {
+		return false
+	}
+
+	runtimes := flex.ExpandStringValueSet(d.Get("compatible_runtimes").(*schema.Set))
+
+	return equalStringSets(runtimes, enum.Slice(output.CompatibleRuntimes...))
+}
+
+func equalStringSets(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+
+	return slices.Equal(a, b)
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -150,6 +151,62 @@ func TestAccLambdaLayerVersion_sourceCodeHash(t *testing.T) {
 	})
 }
 
+func TestAccLambdaLayerVersion_skipPublishIfUnchanged(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_lambda_layer_version.test"
+	recreatedResourceName := "aws_lambda_layer_version.recreated"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop, // this purposely leaves dangling resources, since skip_destroy = true
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionConfig_skipPublishIfUnchanged(rName, "test", "test-fixtures/lambdatest.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, resourceName),
+					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "lambda", fmt.Sprintf("layer:%s:1", rName)),
+					resource.TestCheckResourceAttr(resourceName, "skip_publish_if_unchanged", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccLayerVersionConfig_skipPublishIfUnchanged(rName, "recreated", "test-fixtures/lambdatest.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, recreatedResourceName),
+					acctest.CheckResourceAttrRegionalARN(recreatedResourceName, names.AttrARN, "lambda", fmt.Sprintf("layer:%s:1", rName)),
+				),
+			},
+			{
+				Config: testAccLayerVersionConfig_skipPublishIfUnchanged(rName, "recreated", "test-fixtures/lambdatest_modified.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLayerVersionExists(ctx, recreatedResourceName),
+					acctest.CheckResourceAttrRegionalARN(recreatedResourceName, names.AttrARN, "lambda", fmt.Sprintf("layer:%s:2", rName)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaLayerVersion_skipPublishIfUnchangedRequiresSkipDestroy(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLayerVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLayerVersionConfig_skipPublishIfUnchangedNoSkipDestroy(rName),
+				ExpectError: regexache.MustCompile("`skip_destroy` must be `true` when `skip_publish_if_unchanged` is `true`"),
+			},
+		},
+	})
+}
+
 func TestAccLambdaLayerVersion_s3(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_lambda_layer_version.test"
@@ -453,6 +510,28 @@ resource "aws_lambda_layer_version" "test" {
 `, filename, rName)
 }
 
+func testAccLayerVersionConfig_skipPublishIfUnchanged(rName, resourceName, filename string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" %[2]q {
+  filename                  = %[3]q
+  layer_name                = %[1]q
+  skip_destroy              = true
+  skip_publish_if_unchanged = true
+  source_code_hash          = filebase64sha256(%[3]q)
+}
+`, rName, resourceName, filename)
+}
+
+func testAccLayerVersionConfig_skipPublishIfUnchangedNoSkipDestroy(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "test" {
+  filename                  = "test-fixtures/lambdatest.zip"
+  layer_name                = %[1]q
+  skip_publish_if_unchanged = true
+}
+`, rName)
+}
+
 func testAccLayerVersionConfig_compatibleRuntimes(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_lambda_layer_version" "test" {
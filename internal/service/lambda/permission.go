@@ -40,6 +40,8 @@ func resourcePermission() *schema.Resource {
 			StateContext: resourcePermissionImport,
 		},
 
+		CustomizeDiff: permissionFunctionURLAuthTypeCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrAction: {
 				Type:         schema.TypeString,
@@ -318,6 +320,20 @@ func resourcePermissionImport(ctx context.Context, d *schema.ResourceData, meta
 	return []*schema.ResourceData{d}, nil
 }
 
+// permissionFunctionURLAuthTypeCustomizeDiff ensures that function_url_auth_type is only set for actions that include lambda:InvokeFunctionUrl.
+func permissionFunctionURLAuthTypeCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := d.GetOk("function_url_auth_type"); !ok || v.(string) == "" || !d.NewValueKnown(names.AttrAction) {
+		return nil
+	}
+
+	switch action := d.Get(names.AttrAction).(string); action {
+	case "lambda:InvokeFunctionUrl", "lambda:*", "*":
+		return nil
+	default:
+		return fmt.Errorf("function_url_auth_type is only supported for the lambda:InvokeFunctionUrl action, got %s", action)
+	}
+}
+
 func findPolicy(ctx context.Context, conn *lambda.Client, input *lambda.GetPolicyInput) (*lambda.GetPolicyOutput, error) {
 	output, err := conn.GetPolicy(ctx, input)
 
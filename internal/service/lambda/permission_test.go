@@ -709,6 +709,24 @@ func TestAccLambdaPermission_FunctionURLs_none(t *testing.T) {
 	})
 }
 
+func TestAccLambdaPermission_FunctionURLs_invalidAction(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPermissionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPermissionConfig_functionURLsInvalidAction(rName),
+				ExpectError: regexache.MustCompile(`function_url_auth_type is only supported for the lambda:InvokeFunctionUrl action`),
+			},
+		},
+	})
+}
+
 func testAccCheckPermissionExists(ctx context.Context, n string, v *tflambda.PolicyStatement) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1077,3 +1095,15 @@ resource "aws_lambda_permission" "test" {
 }
 `)
 }
+
+func testAccPermissionConfig_functionURLsInvalidAction(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_base(rName), `
+resource "aws_lambda_permission" "test" {
+  statement_id           = "AllowExecutionWithIAM"
+  action                 = "lambda:InvokeFunction"
+  function_name          = aws_lambda_function.test.function_name
+  principal              = "*"
+  function_url_auth_type = "AWS_IAM"
+}
+`)
+}
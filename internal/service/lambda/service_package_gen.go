@@ -39,6 +39,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_lambda_code_signing_config",
 			Name:     "Code Signing Config",
 		},
+		{
+			Factory:  dataSourceCodeSigningConfigViolations,
+			TypeName: "aws_lambda_code_signing_config_violations",
+			Name:     "Code Signing Config Violations",
+		},
 		{
 			Factory:  dataSourceFunction,
 			TypeName: "aws_lambda_function",
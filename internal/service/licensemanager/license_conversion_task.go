@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package licensemanager
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_licensemanager_license_conversion_task", name="License Conversion Task")
+func ResourceLicenseConversionTask() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLicenseConversionTaskCreate,
+		ReadWithoutTimeout:   resourceLicenseConversionTaskRead,
+		DeleteWithoutTimeout: resourceLicenseConversionTaskDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"destination_usage_operation": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"end_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"license_conversion_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrResourceARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source_usage_operation": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrStartTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatusMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceLicenseConversionTaskCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LicenseManagerConn(ctx)
+
+	resourceARN := d.Get(names.AttrResourceARN).(string)
+	input := &licensemanager.CreateLicenseConversionTaskForResourceInput{
+		DestinationLicenseContext: &licensemanager.LicenseConversionContext{
+			UsageOperation: aws.String(d.Get("destination_usage_operation").(string)),
+		},
+		ResourceArn: aws.String(resourceARN),
+		SourceLicenseContext: &licensemanager.LicenseConversionContext{
+			UsageOperation: aws.String(d.Get("source_usage_operation").(string)),
+		},
+	}
+
+	output, err := conn.CreateLicenseConversionTaskForResourceWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating License Manager License Conversion Task (%s): %s", resourceARN, err)
+	}
+
+	d.SetId(aws.StringValue(output.LicenseConversionTaskId))
+
+	if _, err := waitLicenseConversionTaskSucceeded(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for License Manager License Conversion Task (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceLicenseConversionTaskRead(ctx, d, meta)...)
+}
+
+func resourceLicenseConversionTaskRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LicenseManagerConn(ctx)
+
+	output, err := FindLicenseConversionTaskByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] License Manager License Conversion Task %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading License Manager License Conversion Task (%s): %s", d.Id(), err)
+	}
+
+	if output.DestinationLicenseContext != nil {
+		d.Set("destination_usage_operation", output.DestinationLicenseContext.UsageOperation)
+	}
+	if output.EndTime != nil {
+		d.Set("end_time", aws.TimeValue(output.EndTime).Format(time.RFC3339))
+	} else {
+		d.Set("end_time", nil)
+	}
+	if output.LicenseConversionTime != nil {
+		d.Set("license_conversion_time", aws.TimeValue(output.LicenseConversionTime).Format(time.RFC3339))
+	} else {
+		d.Set("license_conversion_time", nil)
+	}
+	d.Set(names.AttrResourceARN, output.ResourceArn)
+	if output.SourceLicenseContext != nil {
+		d.Set("source_usage_operation", output.SourceLicenseContext.UsageOperation)
+	}
+	if output.StartTime != nil {
+		d.Set(names.AttrStartTime, aws.TimeValue(output.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrStartTime, nil)
+	}
+	d.Set(names.AttrStatus, output.Status)
+	d.Set(names.AttrStatusMessage, output.StatusMessage)
+
+	return diags
+}
+
+func resourceLicenseConversionTaskDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// License conversions cannot be undone by deleting the task.
+	// To convert back, create a new task with the usage operations swapped.
+	log.Printf("[WARN] License Manager License Conversion Task (%s) cannot be deleted, removing from state", d.Id())
+
+	return diags
+}
+
+func FindLicenseConversionTaskByID(ctx context.Context, conn *licensemanager.LicenseManager, id string) (*licensemanager.GetLicenseConversionTaskOutput, error) {
+	input := &licensemanager.GetLicenseConversionTaskInput{
+		LicenseConversionTaskId: aws.String(id),
+	}
+
+	output, err := conn.GetLicenseConversionTaskWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, licensemanager.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusLicenseConversionTask(ctx context.Context, conn *licensemanager.LicenseManager, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindLicenseConversionTaskByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func waitLicenseConversionTaskSucceeded(ctx context.Context, conn *licensemanager.LicenseManager, id string, timeout time.Duration) (*licensemanager.GetLicenseConversionTaskOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{licensemanager.LicenseConversionTaskStatusInProgress},
+		Target:  []string{licensemanager.LicenseConversionTaskStatusSucceeded},
+		Refresh: statusLicenseConversionTask(ctx, conn, id),
+		Timeout: timeout,
+		Delay:   10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*licensemanager.GetLicenseConversionTaskOutput); ok {
+		if status := aws.StringValue(output.Status); status == licensemanager.LicenseConversionTaskStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.StringValue(output.StatusMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
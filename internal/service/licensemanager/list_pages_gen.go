@@ -1,4 +1,4 @@
-// Code generated by "internal/generate/listpages/main.go -ListOps=ListLicenseConfigurations,ListLicenseSpecificationsForResource,ListReceivedLicenses,ListDistributedGrants"; DO NOT EDIT.
+// Code generated by "internal/generate/listpages/main.go -ListOps=ListLicenseConfigurations,ListLicenseSpecificationsForResource,ListReceivedLicenses,ListDistributedGrants,ListLicenseManagerReportGenerators"; DO NOT EDIT.
 
 package licensemanager
 
@@ -42,6 +42,22 @@ func listLicenseConfigurationsPages(ctx context.Context, conn licensemanagerifac
 	}
 	return nil
 }
+func listLicenseManagerReportGeneratorsPages(ctx context.Context, conn licensemanageriface.LicenseManagerAPI, input *licensemanager.ListLicenseManagerReportGeneratorsInput, fn func(*licensemanager.ListLicenseManagerReportGeneratorsOutput, bool) bool) error {
+	for {
+		output, err := conn.ListLicenseManagerReportGeneratorsWithContext(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.StringValue(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
 func listLicenseSpecificationsForResourcePages(ctx context.Context, conn licensemanageriface.LicenseManagerAPI, input *licensemanager.ListLicenseSpecificationsForResourceInput, fn func(*licensemanager.ListLicenseSpecificationsForResourceOutput, bool) bool) error {
 	for {
 		output, err := conn.ListLicenseSpecificationsForResourceWithContext(ctx, input)
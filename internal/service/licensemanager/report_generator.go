@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package licensemanager
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_licensemanager_report_generator", name="Report Generator")
+// @Tags(identifierAttribute="id")
+func ResourceReportGenerator() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReportGeneratorCreate,
+		ReadWithoutTimeout:   resourceReportGeneratorRead,
+		UpdateWithoutTimeout: resourceReportGeneratorUpdate,
+		DeleteWithoutTimeout: resourceReportGeneratorDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDescription: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"license_configuration_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"report_frequency": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"period": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(licensemanager.ReportFrequencyType_Values(), false),
+						},
+						names.AttrValue: {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"report_types": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(licensemanager.ReportType_Values(), false),
+				},
+			},
+			"s3_bucket_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"s3_key_prefix": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceReportGeneratorCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LicenseManagerConn(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &licensemanager.CreateLicenseManagerReportGeneratorInput{
+		ClientToken: aws.String(id.UniqueId()),
+		ReportContext: &licensemanager.ReportContext{
+			LicenseConfigurationArns: flex.ExpandStringSet(d.Get("license_configuration_arns").(*schema.Set)),
+		},
+		ReportFrequency:     expandReportFrequency(d.Get("report_frequency").([]interface{})),
+		ReportGeneratorName: aws.String(name),
+		Tags:                getTagsIn(ctx),
+		Type:                flex.ExpandStringSet(d.Get("report_types").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk(names.AttrDescription); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateLicenseManagerReportGeneratorWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating License Manager Report Generator (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.LicenseManagerReportGeneratorArn))
+
+	return append(diags, resourceReportGeneratorRead(ctx, d, meta)...)
+}
+
+func resourceReportGeneratorRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LicenseManagerConn(ctx)
+
+	output, err := FindReportGeneratorByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] License Manager Report Generator %s not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading License Manager Report Generator (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, output.LicenseManagerReportGeneratorArn)
+	d.Set(names.AttrDescription, output.Description)
+	if output.ReportContext != nil {
+		d.Set("license_configuration_arns", aws.StringValueSlice(output.ReportContext.LicenseConfigurationArns))
+	} else {
+		d.Set("license_configuration_arns", nil)
+	}
+	d.Set(names.AttrName, output.ReportGeneratorName)
+	if err := d.Set("report_frequency", flattenReportFrequency(output.ReportFrequency)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting report_frequency: %s", err)
+	}
+	d.Set("report_types", aws.StringValueSlice(output.ReportType))
+	if output.S3Location != nil {
+		d.Set("s3_bucket_name", output.S3Location.Bucket)
+		d.Set("s3_key_prefix", output.S3Location.KeyPrefix)
+	} else {
+		d.Set("s3_bucket_name", nil)
+		d.Set("s3_key_prefix", nil)
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	return diags
+}
+
+func resourceReportGeneratorUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LicenseManagerConn(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		// All arguments must be supplied on update.
+		input := &licensemanager.UpdateLicenseManagerReportGeneratorInput{
+			ClientToken:                      aws.String(id.UniqueId()),
+			Description:                      aws.String(d.Get(names.AttrDescription).(string)),
+			LicenseManagerReportGeneratorArn: aws.String(d.Id()),
+			ReportContext: &licensemanager.ReportContext{
+				LicenseConfigurationArns: flex.ExpandStringSet(d.Get("license_configuration_arns").(*schema.Set)),
+			},
+			ReportFrequency:     expandReportFrequency(d.Get("report_frequency").([]interface{})),
+			ReportGeneratorName: aws.String(d.Get(names.AttrName).(string)),
+			Type:                flex.ExpandStringSet(d.Get("report_types").(*schema.Set)),
+		}
+
+		_, err := conn.UpdateLicenseManagerReportGeneratorWithContext(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating License Manager Report Generator (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceReportGeneratorRead(ctx, d, meta)...)
+}
+
+func resourceReportGeneratorDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).LicenseManagerConn(ctx)
+
+	log.Printf("[DEBUG] Deleting License Manager Report Generator: %s", d.Id())
+	_, err := conn.DeleteLicenseManagerReportGeneratorWithContext(ctx, &licensemanager.DeleteLicenseManagerReportGeneratorInput{
+		LicenseManagerReportGeneratorArn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, licensemanager.ErrCodeResourceNotFoundException) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting License Manager Report Generator (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func FindReportGeneratorByARN(ctx context.Context, conn *licensemanager.LicenseManager, arn string) (*licensemanager.ReportGenerator, error) {
+	input := &licensemanager.GetLicenseManagerReportGeneratorInput{
+		LicenseManagerReportGeneratorArn: aws.String(arn),
+	}
+
+	output, err := conn.GetLicenseManagerReportGeneratorWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, licensemanager.ErrCodeResourceNotFoundException) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ReportGenerator == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ReportGenerator, nil
+}
+
+func expandReportFrequency(tfList []interface{}) *licensemanager.ReportFrequency {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &licensemanager.ReportFrequency{
+		Period: aws.String(tfMap["period"].(string)),
+		Value:  aws.Int64(int64(tfMap[names.AttrValue].(int))),
+	}
+}
+
+func flattenReportFrequency(apiObject *licensemanager.ReportFrequency) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"period":        aws.StringValue(apiObject.Period),
+		names.AttrValue: aws.Int64Value(apiObject.Value),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package licensemanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/licensemanager"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflicensemanager "github.com/hashicorp/terraform-provider-aws/internal/service/licensemanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLicenseManagerReportGenerator_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var reportGenerator licensemanager.ReportGenerator
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_licensemanager_report_generator.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LicenseManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReportGeneratorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReportGeneratorConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReportGeneratorExists(ctx, resourceName, &reportGenerator),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "license-manager", regexache.MustCompile(`report-generator:r-.+`)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, "license_configuration_arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "license_configuration_arns.*", "aws_licensemanager_license_configuration.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "report_frequency.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "report_frequency.0.period", "DAY"),
+					resource.TestCheckResourceAttr(resourceName, "report_frequency.0.value", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "report_types.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttr(resourceName, "report_types.*", "LicenseConfigurationSummaryReport"),
+					resource.TestCheckResourceAttrSet(resourceName, "s3_bucket_name"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct0),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLicenseManagerReportGenerator_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var reportGenerator licensemanager.ReportGenerator
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_licensemanager_report_generator.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LicenseManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReportGeneratorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReportGeneratorConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReportGeneratorExists(ctx, resourceName, &reportGenerator),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflicensemanager.ResourceReportGenerator(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccLicenseManagerReportGenerator_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var reportGenerator licensemanager.ReportGenerator
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_licensemanager_report_generator.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LicenseManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReportGeneratorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReportGeneratorConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReportGeneratorExists(ctx, resourceName, &reportGenerator),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
+					resource.TestCheckResourceAttr(resourceName, "report_frequency.0.period", "DAY"),
+					resource.TestCheckResourceAttr(resourceName, "report_types.#", acctest.Ct1),
+				),
+			},
+			{
+				Config: testAccReportGeneratorConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReportGeneratorExists(ctx, resourceName, &reportGenerator),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "test"),
+					resource.TestCheckResourceAttr(resourceName, "report_frequency.0.period", "WEEK"),
+					resource.TestCheckResourceAttr(resourceName, "report_frequency.0.value", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "report_types.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemAttr(resourceName, "report_types.*", "LicenseConfigurationSummaryReport"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "report_types.*", "LicenseConfigurationUsageReport"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLicenseManagerReportGenerator_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var reportGenerator licensemanager.ReportGenerator
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_licensemanager_report_generator.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LicenseManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckReportGeneratorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReportGeneratorConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReportGeneratorExists(ctx, resourceName, &reportGenerator),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccReportGeneratorConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReportGeneratorExists(ctx, resourceName, &reportGenerator),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccReportGeneratorConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReportGeneratorExists(ctx, resourceName, &reportGenerator),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckReportGeneratorExists(ctx context.Context, n string, v *licensemanager.ReportGenerator) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No License Manager Report Generator ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LicenseManagerConn(ctx)
+
+		output, err := tflicensemanager.FindReportGeneratorByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckReportGeneratorDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LicenseManagerConn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_licensemanager_report_generator" {
+				continue
+			}
+
+			_, err := tflicensemanager.FindReportGeneratorByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("License Manager Report Generator %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccReportGeneratorConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_licensemanager_license_configuration" "test" {
+  name                  = %[1]q
+  license_counting_type = "Instance"
+}
+`, rName)
+}
+
+func testAccReportGeneratorConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccReportGeneratorConfig_base(rName), fmt.Sprintf(`
+resource "aws_licensemanager_report_generator" "test" {
+  name                       = %[1]q
+  license_configuration_arns = [aws_licensemanager_license_configuration.test.arn]
+  report_types               = ["LicenseConfigurationSummaryReport"]
+
+  report_frequency {
+    period = "DAY"
+    value  = 1
+  }
+}
+`, rName))
+}
+
+func testAccReportGeneratorConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccReportGeneratorConfig_base(rName), fmt.Sprintf(`
+resource "aws_licensemanager_report_generator" "test" {
+  name                       = %[1]q
+  description                = "test"
+  license_configuration_arns = [aws_licensemanager_license_configuration.test.arn]
+  report_types               = ["LicenseConfigurationSummaryReport", "LicenseConfigurationUsageReport"]
+
+  report_frequency {
+    period = "WEEK"
+    value  = 2
+  }
+}
+`, rName))
+}
+
+func testAccReportGeneratorConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccReportGeneratorConfig_base(rName), fmt.Sprintf(`
+resource "aws_licensemanager_report_generator" "test" {
+  name                       = %[1]q
+  license_configuration_arns = [aws_licensemanager_license_configuration.test.arn]
+  report_types               = ["LicenseConfigurationSummaryReport"]
+
+  report_frequency {
+    period = "DAY"
+    value  = 1
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccReportGeneratorConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccReportGeneratorConfig_base(rName), fmt.Sprintf(`
+resource "aws_licensemanager_report_generator" "test" {
+  name                       = %[1]q
+  license_configuration_arns = [aws_licensemanager_license_configuration.test.arn]
+  report_types               = ["LicenseConfigurationSummaryReport"]
+
+  report_frequency {
+    period = "DAY"
+    value  = 1
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -64,6 +64,19 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrID,
 			},
 		},
+		{
+			Factory:  ResourceLicenseConversionTask,
+			TypeName: "aws_licensemanager_license_conversion_task",
+			Name:     "License Conversion Task",
+		},
+		{
+			Factory:  ResourceReportGenerator,
+			TypeName: "aws_licensemanager_report_generator",
+			Name:     "Report Generator",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+			},
+		},
 	}
 }
 
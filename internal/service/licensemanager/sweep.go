@@ -19,6 +19,11 @@ func RegisterSweepers() {
 		Name: "aws_licensemanager_license_configuration",
 		F:    sweepLicenseConfigurations,
 	})
+
+	resource.AddTestSweepers("aws_licensemanager_report_generator", &resource.Sweeper{
+		Name: "aws_licensemanager_report_generator",
+		F:    sweepReportGenerators,
+	})
 }
 
 func sweepLicenseConfigurations(region string) error {
@@ -64,3 +69,47 @@ func sweepLicenseConfigurations(region string) error {
 
 	return nil
 }
+
+func sweepReportGenerators(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+	conn := client.LicenseManagerConn(ctx)
+	input := &licensemanager.ListLicenseManagerReportGeneratorsInput{}
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	err = listLicenseManagerReportGeneratorsPages(ctx, conn, input, func(page *licensemanager.ListLicenseManagerReportGeneratorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.ReportGenerators {
+			r := ResourceReportGenerator()
+			d := r.Data(nil)
+			d.SetId(aws.StringValue(v.LicenseManagerReportGeneratorArn))
+
+			sweepResources = append(sweepResources, sweep.NewSweepResource(r, d, client))
+		}
+
+		return !lastPage
+	})
+
+	if awsv1.SkipSweepError(err) {
+		log.Printf("[WARN] Skipping License Manager Report Generator sweep for %s: %s", region, err)
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error listing License Manager Report Generators (%s): %w", region, err)
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping License Manager Report Generators (%s): %w", region, err)
+	}
+
+	return nil
+}
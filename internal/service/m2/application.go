@@ -11,6 +11,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/m2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -38,6 +39,7 @@ import (
 
 // @FrameworkResource(name="Application")
 // @Tags(identifierAttribute="arn")
+// @ARNIdentity
 func newApplicationResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &applicationResource{}
 
@@ -594,6 +596,16 @@ func waitApplicationRunning(ctx context.Context, conn *m2.Client, id string, tim
 	return nil, err
 }
 
+func (r *applicationResource) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "m2", "app/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return parts[0], nil
+}
+
 type applicationResourceModel struct {
 	ApplicationID  types.String                                     `tfsdk:"application_id"`
 	ApplicationARN types.String                                     `tfsdk:"arn"`
@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/m2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Data Set Import Task")
+func newDataSetImportTaskResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &dataSetImportTaskResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+
+	return r, nil
+}
+
+type dataSetImportTaskResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpUpdate[dataSetImportTaskResourceModel]
+	framework.WithNoOpDelete
+	framework.WithTimeouts
+}
+
+func (*dataSetImportTaskResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_m2_data_set_import_task"
+}
+
+func (r *dataSetImportTaskResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DataSetTaskLifecycle](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"summary": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[dataSetImportSummaryModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[dataSetImportSummaryModel](ctx),
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"task_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"import_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataSetImportConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"s3_location": schema.StringAttribute{
+							Required: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.RequiresReplace(),
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *dataSetImportTaskResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data dataSetImportTaskResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().M2Client(ctx)
+
+	importConfig, diags := data.ImportConfig.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &m2.CreateDataSetImportTaskInput{
+		ApplicationId: fwflex.StringFromFramework(ctx, data.ApplicationID),
+		ClientToken:   aws.String(sdkid.UniqueId()),
+		ImportConfig: &awstypes.DataSetImportConfigMemberS3Location{
+			Value: importConfig.S3Location.ValueString(),
+		},
+	}
+
+	output, err := conn.CreateDataSetImportTask(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Mainframe Modernization Data Set Import Task (%s)", data.ApplicationID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.TaskID = fwflex.StringToFramework(ctx, output.TaskId)
+	data.setID()
+
+	task, err := waitDataSetImportTaskCompleted(ctx, conn, data.ApplicationID.ValueString(), data.TaskID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Mainframe Modernization Data Set Import Task (%s) complete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, task, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *dataSetImportTaskResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data dataSetImportTaskResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().M2Client(ctx)
+
+	output, err := findDataSetImportTaskByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.TaskID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Mainframe Modernization Data Set Import Task (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findDataSetImportTaskByTwoPartKey(ctx context.Context, conn *m2.Client, applicationID, taskID string) (*m2.GetDataSetImportTaskOutput, error) {
+	input := &m2.GetDataSetImportTaskInput{
+		ApplicationId: aws.String(applicationID),
+		TaskId:        aws.String(taskID),
+	}
+
+	output, err := conn.GetDataSetImportTask(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusDataSetImportTask(ctx context.Context, conn *m2.Client, applicationID, taskID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findDataSetImportTaskByTwoPartKey(ctx, conn, applicationID, taskID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDataSetImportTaskCompleted(ctx context.Context, conn *m2.Client, applicationID, taskID string, timeout time.Duration) (*m2.GetDataSetImportTaskOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.DataSetTaskLifecycleCreating, awstypes.DataSetTaskLifecycleRunning),
+		Target:  enum.Slice(awstypes.DataSetTaskLifecycleCompleted),
+		Refresh: statusDataSetImportTask(ctx, conn, applicationID, taskID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*m2.GetDataSetImportTaskOutput); ok {
+		if summary := output.Summary; summary != nil && summary.Failed > 0 {
+			tfresource.SetLastError(err, fmt.Errorf("%d of %d data set imports failed", summary.Failed, summary.Total))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type dataSetImportTaskResourceModel struct {
+	ApplicationID types.String                                               `tfsdk:"application_id"`
+	ID            types.String                                               `tfsdk:"id"`
+	ImportConfig  fwtypes.ListNestedObjectValueOf[dataSetImportConfigModel]  `tfsdk:"import_config"`
+	Status        fwtypes.StringEnum[awstypes.DataSetTaskLifecycle]          `tfsdk:"status"`
+	Summary       fwtypes.ListNestedObjectValueOf[dataSetImportSummaryModel] `tfsdk:"summary"`
+	TaskID        types.String                                               `tfsdk:"task_id"`
+	Timeouts      timeouts.Value                                             `tfsdk:"timeouts"`
+}
+
+const (
+	dataSetImportTaskResourceIDPartCount = 2
+)
+
+func (data *dataSetImportTaskResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), dataSetImportTaskResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ApplicationID = types.StringValue(parts[0])
+	data.TaskID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *dataSetImportTaskResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.ApplicationID.ValueString(), data.TaskID.ValueString()}, dataSetImportTaskResourceIDPartCount, false)))
+}
+
+type dataSetImportConfigModel struct {
+	S3Location types.String `tfsdk:"s3_location"`
+}
+
+type dataSetImportSummaryModel struct {
+	Failed     types.Int64 `tfsdk:"failed"`
+	InProgress types.Int64 `tfsdk:"in_progress"`
+	Pending    types.Int64 `tfsdk:"pending"`
+	Succeeded  types.Int64 `tfsdk:"succeeded"`
+	Total      types.Int64 `tfsdk:"total"`
+}
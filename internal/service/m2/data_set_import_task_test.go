@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package m2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/m2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfm2 "github.com/hashicorp/terraform-provider-aws/internal/service/m2"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccM2DataSetImportTask_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var task m2.GetDataSetImportTaskOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_data_set_import_task.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSetImportTaskConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataSetImportTaskExists(ctx, resourceName, &task),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrApplicationID, "aws_m2_application.test", names.AttrApplicationID),
+					resource.TestCheckResourceAttr(resourceName, "import_config.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "Completed"),
+					resource.TestCheckResourceAttr(resourceName, "summary.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "summary.0.failed", acctest.Ct0),
+					resource.TestCheckResourceAttr(resourceName, "summary.0.succeeded", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "summary.0.total", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "task_id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"import_config", names.AttrTimeouts},
+			},
+		},
+	})
+}
+
+func testAccCheckDataSetImportTaskExists(ctx context.Context, n string, v *m2.GetDataSetImportTaskOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).M2Client(ctx)
+
+		output, err := tfm2.FindDataSetImportTaskByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrApplicationID], rs.Primary.Attributes["task_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDataSetImportTaskConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_basic(rName, "bluage", 1, 1, true), fmt.Sprintf(`
+resource "aws_s3_object" "data" {
+  bucket  = aws_s3_bucket.test.id
+  key     = "data/%[1]s.dat"
+  content = "0001PLANET0001"
+}
+
+resource "aws_s3_object" "import" {
+  bucket = aws_s3_bucket.test.id
+  key    = "import/%[1]s.json"
+  content = jsonencode({
+    dataSets = [{
+      dataSet = {
+        storageType  = "Database"
+        datasetName  = "AWS.M2.TEST.%[1]s"
+        relativePath = "DATA"
+        datasetOrg = {
+          gdg = {
+            limit           = 1
+            rollDisposition = "No Action"
+          }
+        }
+        recordLength = {
+          min = 14
+          max = 14
+        }
+      }
+      externalLocation = {
+        s3Location = "s3://${aws_s3_bucket.test.id}/${aws_s3_object.data.key}"
+      }
+    }]
+  })
+}
+
+resource "aws_m2_data_set_import_task" "test" {
+  application_id = aws_m2_application.test.application_id
+
+  import_config {
+    s3_location = "s3://${aws_s3_bucket.test.id}/${aws_s3_object.import.key}"
+  }
+
+  depends_on = [aws_m2_deployment.test]
+}
+`, rName))
+}
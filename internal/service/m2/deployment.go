@@ -76,6 +76,9 @@ func (r *deploymentResource) Schema(ctx context.Context, request resource.Schema
 				Optional: true,
 			},
 			names.AttrID: framework.IDAttribute(),
+			"rollback_on_failure": schema.BoolAttribute{
+				Optional: true,
+			},
 			"start": schema.BoolAttribute{
 				Required: true,
 			},
@@ -238,6 +241,17 @@ func (r *deploymentResource) Update(ctx context.Context, request resource.Update
 		if _, err := waitDeploymentUpdated(ctx, conn, new.ApplicationID.ValueString(), new.DeploymentID.ValueString(), timeout); err != nil {
 			response.Diagnostics.AddError(fmt.Sprintf("waiting for Mainframe Modernization Deployment (%s) update", new.ID.ValueString()), err.Error())
 
+			if new.RollbackOnFailure.ValueBool() {
+				// Redeploy the previously deployed application version.
+				if err := rollbackDeployment(ctx, conn, &old, timeout); err != nil {
+					response.Diagnostics.AddError(fmt.Sprintf("rolling back Mainframe Modernization Deployment (%s) to application version %d", old.ID.ValueString(), old.ApplicationVersion.ValueInt64()), err.Error())
+
+					return
+				}
+
+				response.Diagnostics.Append(response.State.Set(ctx, old)...)
+			}
+
 			return
 		}
 
@@ -334,6 +348,37 @@ func (r *deploymentResource) ModifyPlan(ctx context.Context, request resource.Mo
 	}
 }
 
+func rollbackDeployment(ctx context.Context, conn *m2.Client, data *deploymentResourceModel, timeout time.Duration) error {
+	input := &m2.CreateDeploymentInput{}
+	if diags := fwflex.Expand(ctx, data, input); diags.HasError() {
+		return fwdiag.DiagnosticsError(diags)
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+
+	output, err := conn.CreateDeployment(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	data.DeploymentID = fwflex.StringToFramework(ctx, output.DeploymentId)
+	data.setID()
+
+	if _, err := waitDeploymentUpdated(ctx, conn, data.ApplicationID.ValueString(), data.DeploymentID.ValueString(), timeout); err != nil {
+		return err
+	}
+
+	if data.Start.ValueBool() {
+		if _, err := startApplication(ctx, conn, data.ApplicationID.ValueString(), timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func findDeploymentByTwoPartKey(ctx context.Context, conn *m2.Client, applicationID, deploymentID string) (*m2.GetDeploymentOutput, error) {
 	input := &m2.GetDeploymentInput{
 		ApplicationId: aws.String(applicationID),
@@ -421,6 +466,7 @@ type deploymentResourceModel struct {
 	EnvironmentID      types.String   `tfsdk:"environment_id"`
 	ForceStop          types.Bool     `tfsdk:"force_stop"`
 	ID                 types.String   `tfsdk:"id"`
+	RollbackOnFailure  types.Bool     `tfsdk:"rollback_on_failure"`
 	Start              types.Bool     `tfsdk:"start"`
 	Timeouts           timeouts.Value `tfsdk:"timeouts"`
 }
@@ -169,6 +169,51 @@ func TestAccM2Deployment_update(t *testing.T) {
 	})
 }
 
+func TestAccM2Deployment_rollbackOnFailure(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+	var deployment m2.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_m2_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.M2EndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.M2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_rollbackOnFailure(rName, "bluage", 1, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment),
+					resource.TestCheckResourceAttr(resourceName, "application_version", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "rollback_on_failure", acctest.CtTrue),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rollback_on_failure"},
+			},
+			{
+				Config: testAccDeploymentConfig_rollbackOnFailure(rName, "bluage", 2, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment),
+					resource.TestCheckResourceAttr(resourceName, "application_version", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "rollback_on_failure", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckDeploymentDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).M2Client(ctx)
@@ -255,3 +300,44 @@ resource "aws_m2_deployment" "test" {
 }
 `, rName, engineType, deployVersion, start))
 }
+
+func testAccDeploymentConfig_rollbackOnFailure(rName, engineType string, appVersion, deployVersion int) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_base(rName), testAccApplicationConfig_versioned(rName, engineType, appVersion, 2), fmt.Sprintf(`
+resource "aws_m2_environment" "test" {
+  name          = %[1]q
+  engine_type   = %[2]q
+  instance_type = "M2.m5.large"
+
+  security_group_ids = [aws_security_group.test.id]
+  subnet_ids         = aws_subnet.test[*].id
+}
+
+data "aws_region" "current" {}
+
+resource "aws_vpc_endpoint" "secretsmanager" {
+  vpc_id            = aws_vpc.test.id
+  service_name      = "com.amazonaws.${data.aws_region.current.name}.secretsmanager"
+  vpc_endpoint_type = "Interface"
+
+  security_group_ids = [
+    aws_security_group.test.id,
+  ]
+  subnet_ids = aws_subnet.test[*].id
+
+  private_dns_enabled = true
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_m2_deployment" "test" {
+  environment_id      = aws_m2_environment.test.id
+  application_id      = aws_m2_application.test.id
+  application_version = %[3]d
+  start               = true
+  rollback_on_failure = true
+  depends_on          = [aws_vpc_endpoint.secretsmanager]
+}
+`, rName, engineType, deployVersion))
+}
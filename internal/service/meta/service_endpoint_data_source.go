@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource
+func newDataSourceServiceEndpoint(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &dataSourceServiceEndpoint{}
+
+	return d, nil
+}
+
+type dataSourceServiceEndpoint struct {
+	framework.DataSourceWithConfigure
+}
+
+// Metadata should return the full name of the data source, such as
+// examplecloud_thing.
+func (d *dataSourceServiceEndpoint) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_service_endpoint"
+}
+
+// Schema returns the schema for this data source.
+func (d *dataSourceServiceEndpoint) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrEndpoint: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrRegion: schema.StringAttribute{
+				Computed: true,
+			},
+			"service": schema.StringAttribute{
+				Required: true,
+			},
+			"use_dualstack_endpoint": schema.BoolAttribute{
+				Computed: true,
+			},
+			"use_fips_endpoint": schema.BoolAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Read is called when the provider must read data source values in order to update state.
+// Config values should be read from the ReadRequest and new state values set on the ReadResponse.
+func (d *dataSourceServiceEndpoint) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceServiceEndpointData
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	pkg, err := names.ProviderPackageForAlias(data.Service.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError("finding service", err.Error())
+
+		return
+	}
+
+	meta := d.Meta()
+	region := meta.Region
+	useDualStackEndpoint := meta.UseDualStackEndpoint(ctx, pkg)
+	useFIPSEndpoint := meta.UseFIPSEndpoint(ctx, pkg)
+
+	endpoint := meta.ConfiguredEndpoint(ctx, pkg)
+
+	if endpoint == "" {
+		resolvedEndpoint, err := endpoints.DefaultResolver().EndpointFor(findEndpointsID(pkg, region), region, func(o *endpoints.Options) {
+			o.ResolveUnknownService = true
+			if useDualStackEndpoint {
+				o.UseDualStackEndpoint = endpoints.DualStackEndpointStateEnabled
+			}
+			if useFIPSEndpoint {
+				o.UseFIPSEndpoint = endpoints.FIPSEndpointStateEnabled
+			}
+		})
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("resolving %s endpoint", pkg), err.Error())
+
+			return
+		}
+
+		endpoint = resolvedEndpoint.URL
+	}
+
+	data.Endpoint = types.StringValue(endpoint)
+	data.ID = types.StringValue(pkg)
+	data.Region = types.StringValue(region)
+	data.UseDualStackEndpoint = types.BoolValue(useDualStackEndpoint)
+	data.UseFIPSEndpoint = types.BoolValue(useFIPSEndpoint)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceServiceEndpointData struct {
+	Endpoint             types.String `tfsdk:"endpoint"`
+	ID                   types.String `tfsdk:"id"`
+	Region               types.String `tfsdk:"region"`
+	Service              types.String `tfsdk:"service"`
+	UseDualStackEndpoint types.Bool   `tfsdk:"use_dualstack_endpoint"`
+	UseFIPSEndpoint      types.Bool   `tfsdk:"use_fips_endpoint"`
+}
+
+// findEndpointsID returns the identifier of the service in the SDK endpoints metadata for the Region's partition.
+// The service package name is returned if no matching identifier is found.
+func findEndpointsID(pkg, region string) string {
+	partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+
+	if !ok {
+		return pkg
+	}
+
+	services := partition.Services()
+
+	if _, ok := services[pkg]; ok {
+		return pkg
+	}
+
+	for _, endpoint := range names.Endpoints() {
+		if endpoint.ProviderPackage != pkg {
+			continue
+		}
+
+		for _, alias := range endpoint.Aliases {
+			if _, ok := services[alias]; ok {
+				return alias
+			}
+		}
+	}
+
+	return pkg
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMetaServiceEndpointDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_service_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceEndpointDataSourceConfig_basic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrEndpoint, fmt.Sprintf("https://sqs.%s.%s", acctest.Region(), acctest.PartitionDNSSuffix())),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrID, names.SQS),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrRegion, acctest.Region()),
+					resource.TestCheckResourceAttr(dataSourceName, "use_dualstack_endpoint", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "use_fips_endpoint", acctest.CtFalse),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaServiceEndpointDataSource_serviceEndpointOptions(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_service_endpoint.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartition(t, names.StandardPartitionID) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceEndpointDataSourceConfig_serviceEndpointOptions(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrEndpoint, fmt.Sprintf("https://sqs-fips.%s.%s", acctest.Region(), acctest.PartitionDNSSuffix())),
+					resource.TestCheckResourceAttr(dataSourceName, "use_dualstack_endpoint", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "use_fips_endpoint", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceEndpointDataSourceConfig_basic() string {
+	return `
+data "aws_service_endpoint" "test" {
+  service = "sqs"
+}
+`
+}
+
+func testAccServiceEndpointDataSourceConfig_serviceEndpointOptions() string {
+	//lintignore:AT004
+	return `
+provider "aws" {
+  service_endpoint_options {
+    service           = "sqs"
+    use_fips_endpoint = true
+  }
+}
+
+data "aws_service_endpoint" "test" {
+  service = "sqs"
+}
+`
+}
@@ -0,0 +1,105 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paymentcryptography
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/paymentcryptography"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/paymentcryptography/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Export Parameters")
+func newExportParametersDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &exportParametersDataSource{}, nil
+}
+
+type exportParametersDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*exportParametersDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_paymentcryptography_export_parameters"
+}
+
+func (d *exportParametersDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"export_token": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"key_material_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.KeyMaterialType](),
+				Required:   true,
+			},
+			"parameters_valid_until_timestamp": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"signing_key_algorithm": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.KeyAlgorithm](),
+				Required:   true,
+			},
+			"signing_key_certificate": schema.StringAttribute{
+				Computed: true,
+			},
+			"signing_key_certificate_chain": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *exportParametersDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data exportParametersDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().PaymentCryptographyClient(ctx)
+
+	input := &paymentcryptography.GetParametersForExportInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.GetParametersForExport(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Payment Cryptography Export Parameters", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The export token is unique per call and is valid for a limited time.
+	data.ID = fwflex.StringToFramework(ctx, output.ExportToken)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type exportParametersDataSourceModel struct {
+	ExportToken                   types.String                                 `tfsdk:"export_token"`
+	ID                            types.String                                 `tfsdk:"id"`
+	KeyMaterialType               fwtypes.StringEnum[awstypes.KeyMaterialType] `tfsdk:"key_material_type"`
+	ParametersValidUntilTimestamp timetypes.RFC3339                            `tfsdk:"parameters_valid_until_timestamp"`
+	SigningKeyAlgorithm           fwtypes.StringEnum[awstypes.KeyAlgorithm]    `tfsdk:"signing_key_algorithm"`
+	SigningKeyCertificate         types.String                                 `tfsdk:"signing_key_certificate"`
+	SigningKeyCertificateChain    types.String                                 `tfsdk:"signing_key_certificate_chain"`
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paymentcryptography_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPaymentCryptographyExportParametersDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_paymentcryptography_export_parameters.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PaymentCryptographyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExportParametersDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "export_token"),
+					resource.TestCheckResourceAttr(dataSourceName, "key_material_type", "TR34_KEY_BLOCK"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "parameters_valid_until_timestamp"),
+					resource.TestCheckResourceAttr(dataSourceName, "signing_key_algorithm", "RSA_2048"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "signing_key_certificate"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "signing_key_certificate_chain"),
+				),
+			},
+		},
+	})
+}
+
+const testAccExportParametersDataSourceConfig_basic = `
+data "aws_paymentcryptography_export_parameters" "test" {
+  key_material_type     = "TR34_KEY_BLOCK"
+  signing_key_algorithm = "RSA_2048"
+}
+`
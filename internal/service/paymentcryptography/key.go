@@ -37,6 +37,7 @@ import (
 // Function annotations are used for resource registration to the Provider. DO NOT EDIT.
 // @FrameworkResource("aws_paymentcryptography_key", name="Key")
 // @Tags(identifierAttribute="arn")
+// @ARNIdentity
 func newResourceKey(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceKey{}
 
@@ -161,10 +161,16 @@ func (r *keyAliasResource) Update(ctx context.Context, request resource.UpdateRe
 			return
 		}
 
-		_, err := conn.UpdateAlias(ctx, input)
+		// Re-pointing an alias to a new key rotates the key used by anything that references the alias.
+		output, err := conn.UpdateAlias(ctx, input)
 
 		if err != nil {
-			response.Diagnostics.AddError(fmt.Sprintf("reading PaymentCryptography key Alias (%s)", new.ID.String()), err.Error())
+			response.Diagnostics.AddError(fmt.Sprintf("updating PaymentCryptography key Alias (%s)", new.ID.String()), err.Error())
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output.Alias, &new)...)
+		if response.Diagnostics.HasError() {
 			return
 		}
 	}
@@ -11,6 +11,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/paymentcryptography/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -159,6 +160,45 @@ func TestAccPaymentCryptographyKeyAlias_updateName(t *testing.T) {
 	})
 }
 
+func TestAccPaymentCryptographyKeyAlias_rotate(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix("alias/")
+	resourceName := "aws_paymentcryptography_key_alias.test"
+	var v awstypes.Alias
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PaymentCryptographyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckKeyAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyAliasConfig_rotate(rName, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeyAliasExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alias_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "key_arn", "aws_paymentcryptography_key.test", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccKeyAliasConfig_rotate(rName, "next"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckKeyAliasExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "alias_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "key_arn", "aws_paymentcryptography_key.next", names.AttrARN),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckKeyAliasDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).PaymentCryptographyClient(ctx)
@@ -276,3 +316,42 @@ resource "aws_paymentcryptography_key" "test" {
 }
 `, name)
 }
+
+func testAccKeyAliasConfig_rotate(name, target string) string {
+	return fmt.Sprintf(`
+resource "aws_paymentcryptography_key" "test" {
+  exportable = true
+  key_attributes {
+    key_algorithm = "TDES_3KEY"
+    key_class     = "SYMMETRIC_KEY"
+    key_usage     = "TR31_P0_PIN_ENCRYPTION_KEY"
+    key_modes_of_use {
+      decrypt = true
+      encrypt = true
+      wrap    = true
+      unwrap  = true
+    }
+  }
+}
+
+resource "aws_paymentcryptography_key" "next" {
+  exportable = true
+  key_attributes {
+    key_algorithm = "TDES_3KEY"
+    key_class     = "SYMMETRIC_KEY"
+    key_usage     = "TR31_P0_PIN_ENCRYPTION_KEY"
+    key_modes_of_use {
+      decrypt = true
+      encrypt = true
+      wrap    = true
+      unwrap  = true
+    }
+  }
+}
+
+resource "aws_paymentcryptography_key_alias" "test" {
+  alias_name = %[1]q
+  key_arn    = aws_paymentcryptography_key.%[2]s.arn
+}
+`, name, target)
+}
@@ -0,0 +1,238 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paymentcryptography
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/paymentcryptography"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/paymentcryptography/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// The key is exported once, when the resource is created. The wrapped key is kept in state
+// and isn't exported again on refresh, as every export produces new wrapped key material.
+//
+// @FrameworkResource("aws_paymentcryptography_key_export", name="Key Export")
+func newKeyExportResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &keyExportResource{}, nil
+}
+
+type keyExportResource struct {
+	framework.ResourceWithConfigure
+	framework.WithNoOpRead
+	framework.WithNoUpdate
+	framework.WithNoOpDelete
+}
+
+func (*keyExportResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_paymentcryptography_key_export"
+}
+
+func (r *keyExportResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"export_key_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"key_check_value_algorithm": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.KeyCheckValueAlgorithm](),
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"wrapped_key": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[wrappedKeyModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[wrappedKeyModel](ctx),
+				Computed:    true,
+				Sensitive:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"tr31_key_block": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[exportTR31KeyBlockModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"wrapping_key_identifier": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"tr34_key_block": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[exportTR34KeyBlockModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"certificate_authority_public_key_identifier": schema.StringAttribute{
+							Required: true,
+						},
+						"export_token": schema.StringAttribute{
+							Required: true,
+						},
+						"key_block_format": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.Tr34KeyBlockFormat](),
+							Required:   true,
+						},
+						"random_nonce": schema.StringAttribute{
+							Optional: true,
+						},
+						"wrapping_key_certificate": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *keyExportResource) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("tr31_key_block"),
+			path.MatchRoot("tr34_key_block"),
+		),
+	}
+}
+
+func (r *keyExportResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data keyExportResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().PaymentCryptographyClient(ctx)
+
+	id := data.ExportKeyIdentifier.ValueString()
+	input := &paymentcryptography.ExportKeyInput{
+		ExportKeyIdentifier: fwflex.StringFromFramework(ctx, data.ExportKeyIdentifier),
+	}
+
+	if !data.KeyCheckValueAlgorithm.IsNull() {
+		input.ExportAttributes = &awstypes.ExportAttributes{
+			KeyCheckValueAlgorithm: data.KeyCheckValueAlgorithm.ValueEnum(),
+		}
+	}
+
+	tr31, diags := data.TR31KeyBlock.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tr34, diags := data.TR34KeyBlock.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case tr31 != nil && tr34 == nil:
+		var apiObject awstypes.ExportTr31KeyBlock
+		response.Diagnostics.Append(fwflex.Expand(ctx, tr31, &apiObject)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.KeyMaterial = &awstypes.ExportKeyMaterialMemberTr31KeyBlock{Value: apiObject}
+	case tr34 != nil && tr31 == nil:
+		var apiObject awstypes.ExportTr34KeyBlock
+		response.Diagnostics.Append(fwflex.Expand(ctx, tr34, &apiObject)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.KeyMaterial = &awstypes.ExportKeyMaterialMemberTr34KeyBlock{Value: apiObject}
+	default:
+		response.Diagnostics.AddError("invalid configuration", "exactly one of tr31_key_block or tr34_key_block must be specified")
+
+		return
+	}
+
+	output, err := conn.ExportKey(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("exporting Payment Cryptography Key (%s)", id), err.Error())
+
+		return
+	}
+
+	if output == nil || output.WrappedKey == nil {
+		response.Diagnostics.AddError(fmt.Sprintf("exporting Payment Cryptography Key (%s)", id), "empty result")
+
+		return
+	}
+
+	var wrappedKey wrappedKeyModel
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output.WrappedKey, &wrappedKey)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	data.WrappedKey = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &wrappedKey)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type keyExportResourceModel struct {
+	ExportKeyIdentifier    types.String                                             `tfsdk:"export_key_identifier"`
+	ID                     types.String                                             `tfsdk:"id"`
+	KeyCheckValueAlgorithm fwtypes.StringEnum[awstypes.KeyCheckValueAlgorithm]      `tfsdk:"key_check_value_algorithm"`
+	TR31KeyBlock           fwtypes.ListNestedObjectValueOf[exportTR31KeyBlockModel] `tfsdk:"tr31_key_block"`
+	TR34KeyBlock           fwtypes.ListNestedObjectValueOf[exportTR34KeyBlockModel] `tfsdk:"tr34_key_block"`
+	WrappedKey             fwtypes.ListNestedObjectValueOf[wrappedKeyModel]         `tfsdk:"wrapped_key"`
+}
+
+type exportTR31KeyBlockModel struct {
+	WrappingKeyIdentifier types.String `tfsdk:"wrapping_key_identifier"`
+}
+
+type exportTR34KeyBlockModel struct {
+	CertificateAuthorityPublicKeyIdentifier types.String                                    `tfsdk:"certificate_authority_public_key_identifier"`
+	ExportToken                             types.String                                    `tfsdk:"export_token"`
+	KeyBlockFormat                          fwtypes.StringEnum[awstypes.Tr34KeyBlockFormat] `tfsdk:"key_block_format"`
+	RandomNonce                             types.String                                    `tfsdk:"random_nonce"`
+	WrappingKeyCertificate                  types.String                                    `tfsdk:"wrapping_key_certificate"`
+}
+
+type wrappedKeyModel struct {
+	KeyCheckValue            types.String                                          `tfsdk:"key_check_value"`
+	KeyCheckValueAlgorithm   fwtypes.StringEnum[awstypes.KeyCheckValueAlgorithm]   `tfsdk:"key_check_value_algorithm"`
+	KeyMaterial              types.String                                          `tfsdk:"key_material"`
+	WrappedKeyMaterialFormat fwtypes.StringEnum[awstypes.WrappedKeyMaterialFormat] `tfsdk:"wrapped_key_material_format"`
+	WrappingKeyARN           types.String                                          `tfsdk:"wrapping_key_arn"`
+}
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package paymentcryptography_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccPaymentCryptographyKeyExport_tr31(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_paymentcryptography_key_export.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.PaymentCryptographyServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKeyExportConfig_tr31(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, "aws_paymentcryptography_key.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "wrapped_key.#", acctest.Ct1),
+					resource.TestCheckResourceAttrSet(resourceName, "wrapped_key.0.key_check_value"),
+					resource.TestCheckResourceAttrSet(resourceName, "wrapped_key.0.key_material"),
+					resource.TestCheckResourceAttr(resourceName, "wrapped_key.0.wrapped_key_material_format", "TR31_KEY_BLOCK"),
+					resource.TestCheckResourceAttrPair(resourceName, "wrapped_key.0.wrapping_key_arn", "aws_paymentcryptography_key.kek", names.AttrARN),
+				),
+			},
+			{
+				// The key isn't exported again on refresh.
+				Config:   testAccKeyExportConfig_tr31(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccKeyExportConfig_tr31() string {
+	return `
+resource "aws_paymentcryptography_key" "kek" {
+  exportable = true
+  key_attributes {
+    key_algorithm = "TDES_3KEY"
+    key_class     = "SYMMETRIC_KEY"
+    key_usage     = "TR31_K0_KEY_ENCRYPTION_KEY"
+    key_modes_of_use {
+      decrypt = true
+      encrypt = true
+      wrap    = true
+      unwrap  = true
+    }
+  }
+}
+
+resource "aws_paymentcryptography_key" "test" {
+  exportable = true
+  key_attributes {
+    key_algorithm = "TDES_3KEY"
+    key_class     = "SYMMETRIC_KEY"
+    key_usage     = "TR31_P0_PIN_ENCRYPTION_KEY"
+    key_modes_of_use {
+      decrypt = true
+      encrypt = true
+      wrap    = true
+      unwrap  = true
+    }
+  }
+}
+
+resource "aws_paymentcryptography_key_export" "test" {
+  export_key_identifier = aws_paymentcryptography_key.test.arn
+
+  tr31_key_block {
+    wrapping_key_identifier = aws_paymentcryptography_key.kek.arn
+  }
+}
+`
+}
@@ -0,0 +1,548 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Plugin")
+// @Tags(identifierAttribute="arn")
+// @ARNIdentity
+func newPluginResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &pluginResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type pluginResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*pluginResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_qbusiness_plugin"
+}
+
+func (r *pluginResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	credentialConfigurationObject := schema.NestedBlockObject{
+		Attributes: map[string]schema.Attribute{
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			"secret_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDisplayName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"plugin_id":  framework.IDAttribute(),
+			"server_url": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PluginState](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.PluginType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"basic_auth_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[pluginCredentialConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+					listvalidator.ConflictsWith(path.MatchRoot("oauth2_client_credential_configuration")),
+				},
+				NestedObject: credentialConfigurationObject,
+			},
+			"oauth2_client_credential_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[pluginCredentialConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: credentialConfigurationObject,
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *pluginResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data pluginResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	name := data.DisplayName.ValueString()
+	input := &qbusiness.CreatePluginInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// AutoFlEx doesn't yet handle union types.
+	authConfiguration, diags := data.expandAuthConfiguration(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.AuthConfiguration = authConfiguration
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreatePlugin(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Amazon Q Business Plugin (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.PluginARN = fwflex.StringToFramework(ctx, output.PluginArn)
+	data.PluginID = fwflex.StringToFramework(ctx, output.PluginId)
+	data.setID()
+
+	if _, err := waitPluginCreated(ctx, conn, data.ApplicationID.ValueString(), data.PluginID.ValueString(), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Plugin (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Plugins are created enabled.
+	if data.State.ValueEnum() == awstypes.PluginStateDisabled {
+		input := &qbusiness.UpdatePluginInput{
+			ApplicationId: aws.String(data.ApplicationID.ValueString()),
+			PluginId:      aws.String(data.PluginID.ValueString()),
+			State:         awstypes.PluginStateDisabled,
+		}
+
+		_, err := conn.UpdatePlugin(ctx, input)
+
+		if err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("disabling Amazon Q Business Plugin (%s)", data.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	plugin, err := findPluginByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.PluginID.ValueString())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Amazon Q Business Plugin (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.State = fwtypes.StringEnumValue(plugin.State)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *pluginResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data pluginResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	output, err := findPluginByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.PluginID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Amazon Q Business Plugin (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// AutoFlEx doesn't yet handle union types.
+	data.flattenAuthConfiguration(ctx, output.AuthConfiguration)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *pluginResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new pluginResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	if !new.BasicAuthConfiguration.Equal(old.BasicAuthConfiguration) ||
+		!new.DisplayName.Equal(old.DisplayName) ||
+		!new.OAuth2ClientCredentialConfiguration.Equal(old.OAuth2ClientCredentialConfiguration) ||
+		!new.ServerURL.Equal(old.ServerURL) ||
+		!new.State.Equal(old.State) {
+		input := &qbusiness.UpdatePluginInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		authConfiguration, diags := new.expandAuthConfiguration(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.AuthConfiguration = authConfiguration
+
+		_, err := conn.UpdatePlugin(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Amazon Q Business Plugin (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitPluginUpdated(ctx, conn, new.ApplicationID.ValueString(), new.PluginID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Plugin (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *pluginResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data pluginResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	_, err := conn.DeletePlugin(ctx, &qbusiness.DeletePluginInput{
+		ApplicationId: aws.String(data.ApplicationID.ValueString()),
+		PluginId:      aws.String(data.PluginID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Amazon Q Business Plugin (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitPluginDeleted(ctx, conn, data.ApplicationID.ValueString(), data.PluginID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Plugin (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *pluginResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findPluginByTwoPartKey(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string) (*qbusiness.GetPluginOutput, error) {
+	input := &qbusiness.GetPluginInput{
+		ApplicationId: aws.String(applicationID),
+		PluginId:      aws.String(pluginID),
+	}
+
+	output, err := conn.GetPlugin(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusPluginBuild(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findPluginByTwoPartKey(ctx, conn, applicationID, pluginID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BuildStatus), nil
+	}
+}
+
+func waitPluginCreated(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string, timeout time.Duration) (*qbusiness.GetPluginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PluginBuildStatusCreateInProgress),
+		Target:  enum.Slice(awstypes.PluginBuildStatusReady),
+		Refresh: statusPluginBuild(ctx, conn, applicationID, pluginID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetPluginOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPluginUpdated(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string, timeout time.Duration) (*qbusiness.GetPluginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PluginBuildStatusUpdateInProgress),
+		Target:  enum.Slice(awstypes.PluginBuildStatusReady),
+		Refresh: statusPluginBuild(ctx, conn, applicationID, pluginID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetPluginOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPluginDeleted(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string, timeout time.Duration) (*qbusiness.GetPluginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PluginBuildStatusReady, awstypes.PluginBuildStatusDeleteInProgress),
+		Target:  []string{},
+		Refresh: statusPluginBuild(ctx, conn, applicationID, pluginID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetPluginOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func (r *pluginResource) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "qbusiness", "application/*/plugin/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return flex.FlattenResourceId(parts, pluginResourceIDPartCount, false)
+}
+
+type pluginResourceModel struct {
+	ApplicationID                       types.String                                                        `tfsdk:"application_id"`
+	BasicAuthConfiguration              fwtypes.ListNestedObjectValueOf[pluginCredentialConfigurationModel] `tfsdk:"basic_auth_configuration"`
+	DisplayName                         types.String                                                        `tfsdk:"display_name"`
+	ID                                  types.String                                                        `tfsdk:"id"`
+	OAuth2ClientCredentialConfiguration fwtypes.ListNestedObjectValueOf[pluginCredentialConfigurationModel] `tfsdk:"oauth2_client_credential_configuration"`
+	PluginARN                           types.String                                                        `tfsdk:"arn"`
+	PluginID                            types.String                                                        `tfsdk:"plugin_id"`
+	ServerURL                           types.String                                                        `tfsdk:"server_url"`
+	State                               fwtypes.StringEnum[awstypes.PluginState]                            `tfsdk:"state"`
+	Tags                                types.Map                                                           `tfsdk:"tags"`
+	TagsAll                             types.Map                                                           `tfsdk:"tags_all"`
+	Timeouts                            timeouts.Value                                                      `tfsdk:"timeouts"`
+	Type                                fwtypes.StringEnum[awstypes.PluginType]                             `tfsdk:"type"`
+}
+
+const (
+	pluginResourceIDPartCount = 2
+)
+
+func (data *pluginResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), pluginResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ApplicationID = types.StringValue(parts[0])
+	data.PluginID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *pluginResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.ApplicationID.ValueString(), data.PluginID.ValueString()}, pluginResourceIDPartCount, false)))
+}
+
+// expandAuthConfiguration returns the plugin's authentication configuration.
+// Plugins configured with neither basic nor OAuth 2.0 credentials use no authentication.
+func (data *pluginResourceModel) expandAuthConfiguration(ctx context.Context) (awstypes.PluginAuthConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if !data.BasicAuthConfiguration.IsNull() {
+		credentialConfigurationData, d := data.BasicAuthConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.PluginAuthConfigurationMemberBasicAuthConfiguration{}
+		diags.Append(fwflex.Expand(ctx, credentialConfigurationData, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return apiObject, diags
+	}
+
+	if !data.OAuth2ClientCredentialConfiguration.IsNull() {
+		credentialConfigurationData, d := data.OAuth2ClientCredentialConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject := &awstypes.PluginAuthConfigurationMemberOAuth2ClientCredentialConfiguration{}
+		diags.Append(fwflex.Expand(ctx, credentialConfigurationData, &apiObject.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return apiObject, diags
+	}
+
+	return &awstypes.PluginAuthConfigurationMemberNoAuthConfiguration{}, diags
+}
+
+func (data *pluginResourceModel) flattenAuthConfiguration(ctx context.Context, apiObject awstypes.PluginAuthConfiguration) {
+	data.BasicAuthConfiguration = fwtypes.NewListNestedObjectValueOfNull[pluginCredentialConfigurationModel](ctx)
+	data.OAuth2ClientCredentialConfiguration = fwtypes.NewListNestedObjectValueOfNull[pluginCredentialConfigurationModel](ctx)
+
+	switch v := apiObject.(type) {
+	case *awstypes.PluginAuthConfigurationMemberBasicAuthConfiguration:
+		data.BasicAuthConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &pluginCredentialConfigurationModel{
+			RoleARN:   fwtypes.ARNValue(aws.ToString(v.Value.RoleArn)),
+			SecretARN: fwtypes.ARNValue(aws.ToString(v.Value.SecretArn)),
+		})
+
+	case *awstypes.PluginAuthConfigurationMemberOAuth2ClientCredentialConfiguration:
+		data.OAuth2ClientCredentialConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &pluginCredentialConfigurationModel{
+			RoleARN:   fwtypes.ARNValue(aws.ToString(v.Value.RoleArn)),
+			SecretARN: fwtypes.ARNValue(aws.ToString(v.Value.SecretArn)),
+		})
+	}
+}
+
+type pluginCredentialConfigurationModel struct {
+	RoleARN   fwtypes.ARN `tfsdk:"role_arn"`
+	SecretARN fwtypes.ARN `tfsdk:"secret_arn"`
+}
@@ -0,0 +1,408 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Web Experience")
+// @Tags(identifierAttribute="arn")
+// @ARNIdentity
+func newWebExperienceResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &webExperienceResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type webExperienceResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*webExperienceResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_qbusiness_web_experience"
+}
+
+func (r *webExperienceResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrApplicationID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"default_endpoint": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"iam_service_role_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"sample_prompts_control_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WebExperienceSamplePromptsControlMode](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"subtitle": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"title": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+			"web_experience_id": framework.IDAttribute(),
+			"welcome_message": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(300),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *webExperienceResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data webExperienceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreateWebExperienceInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateWebExperience(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Amazon Q Business Web Experience (%s)", data.ApplicationID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.WebExperienceID = fwflex.StringToFramework(ctx, output.WebExperienceId)
+	data.setID()
+
+	webExperience, err := waitWebExperienceCreated(ctx, conn, data.ApplicationID.ValueString(), data.WebExperienceID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Web Experience (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, webExperience, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *webExperienceResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data webExperienceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	output, err := findWebExperienceByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.WebExperienceID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Amazon Q Business Web Experience (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *webExperienceResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new webExperienceResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	if !new.RoleARN.Equal(old.RoleARN) ||
+		!new.SamplePromptsControlMode.Equal(old.SamplePromptsControlMode) ||
+		!new.Subtitle.Equal(old.Subtitle) ||
+		!new.Title.Equal(old.Title) ||
+		!new.WelcomeMessage.Equal(old.WelcomeMessage) {
+		input := &qbusiness.UpdateWebExperienceInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateWebExperience(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Amazon Q Business Web Experience (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *webExperienceResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data webExperienceResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	_, err := conn.DeleteWebExperience(ctx, &qbusiness.DeleteWebExperienceInput{
+		ApplicationId:   aws.String(data.ApplicationID.ValueString()),
+		WebExperienceId: aws.String(data.WebExperienceID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Amazon Q Business Web Experience (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitWebExperienceDeleted(ctx, conn, data.ApplicationID.ValueString(), data.WebExperienceID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Amazon Q Business Web Experience (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *webExperienceResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findWebExperienceByTwoPartKey(ctx context.Context, conn *qbusiness.Client, applicationID, webExperienceID string) (*qbusiness.GetWebExperienceOutput, error) {
+	input := &qbusiness.GetWebExperienceInput{
+		ApplicationId:   aws.String(applicationID),
+		WebExperienceId: aws.String(webExperienceID),
+	}
+
+	output, err := conn.GetWebExperience(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusWebExperience(ctx context.Context, conn *qbusiness.Client, applicationID, webExperienceID string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findWebExperienceByTwoPartKey(ctx, conn, applicationID, webExperienceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitWebExperienceCreated(ctx context.Context, conn *qbusiness.Client, applicationID, webExperienceID string, timeout time.Duration) (*qbusiness.GetWebExperienceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.WebExperienceStatusCreating),
+		// Web experiences for applications not using IAM Identity Center wait for an identity provider to be configured.
+		Target:  enum.Slice(awstypes.WebExperienceStatusActive, awstypes.WebExperienceStatusPendingAuthConfig),
+		Refresh: statusWebExperience(ctx, conn, applicationID, webExperienceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetWebExperienceOutput); ok {
+		if output.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Error.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitWebExperienceDeleted(ctx context.Context, conn *qbusiness.Client, applicationID, webExperienceID string, timeout time.Duration) (*qbusiness.GetWebExperienceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.WebExperienceStatusActive, awstypes.WebExperienceStatusPendingAuthConfig, awstypes.WebExperienceStatusDeleting),
+		Target:  []string{},
+		Refresh: statusWebExperience(ctx, conn, applicationID, webExperienceID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetWebExperienceOutput); ok {
+		if output.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Error.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func (r *webExperienceResource) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "qbusiness", "application/*/web-experience/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return flex.FlattenResourceId(parts, webExperienceResourceIDPartCount, false)
+}
+
+type webExperienceResourceModel struct {
+	ApplicationID            types.String                                                       `tfsdk:"application_id"`
+	DefaultEndpoint          types.String                                                       `tfsdk:"default_endpoint"`
+	ID                       types.String                                                       `tfsdk:"id"`
+	RoleARN                  fwtypes.ARN                                                        `tfsdk:"iam_service_role_arn"`
+	SamplePromptsControlMode fwtypes.StringEnum[awstypes.WebExperienceSamplePromptsControlMode] `tfsdk:"sample_prompts_control_mode"`
+	Subtitle                 types.String                                                       `tfsdk:"subtitle"`
+	Tags                     types.Map                                                          `tfsdk:"tags"`
+	TagsAll                  types.Map                                                          `tfsdk:"tags_all"`
+	Timeouts                 timeouts.Value                                                     `tfsdk:"timeouts"`
+	Title                    types.String                                                       `tfsdk:"title"`
+	WebExperienceARN         types.String                                                       `tfsdk:"arn"`
+	WebExperienceID          types.String                                                       `tfsdk:"web_experience_id"`
+	WelcomeMessage           types.String                                                       `tfsdk:"welcome_message"`
+}
+
+const (
+	webExperienceResourceIDPartCount = 2
+)
+
+func (data *webExperienceResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), webExperienceResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.ApplicationID = types.StringValue(parts[0])
+	data.WebExperienceID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *webExperienceResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.ApplicationID.ValueString(), data.WebExperienceID.ValueString()}, webExperienceResourceIDPartCount, false)))
+}
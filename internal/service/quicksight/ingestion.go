@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -29,6 +30,7 @@ import (
 )
 
 // @FrameworkResource(name="Ingestion")
+// @ARNIdentity
 func newResourceIngestion(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceIngestion{}, nil
 }
@@ -205,6 +207,16 @@ func (r *resourceIngestion) ImportState(ctx context.Context, req resource.Import
 	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
 }
 
+func (r *resourceIngestion) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "quicksight", "dataset/*/ingestion/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return createIngestionID(arn.AccountID, parts[0], parts[1]), nil
+}
+
 func FindIngestionByID(ctx context.Context, conn *quicksight.QuickSight, id string) (*quicksight.Ingestion, error) {
 	awsAccountID, dataSetID, ingestionID, err := ParseIngestionID(id)
 	if err != nil {
@@ -10,6 +10,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -32,6 +33,7 @@ import (
 
 // @FrameworkResource(name="Namespace")
 // @Tags(identifierAttribute="arn")
+// @ARNIdentity
 func newResourceNamespace(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceNamespace{}
 	r.SetDefaultCreateTimeout(2 * time.Minute)
@@ -252,6 +254,16 @@ func (r *resourceNamespace) ImportState(ctx context.Context, req resource.Import
 	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
 }
 
+func (r *resourceNamespace) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "quicksight", "namespace/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return createNamespaceID(arn.AccountID, parts[0]), nil
+}
+
 func (r *resourceNamespace) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, req, resp)
 }
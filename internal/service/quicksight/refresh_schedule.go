@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -35,6 +36,7 @@ import (
 )
 
 // @FrameworkResource(name="Refresh Schedule")
+// @ARNIdentity
 func newResourceRefreshSchedule(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceRefreshSchedule{}, nil
 }
@@ -409,6 +411,16 @@ func (r *resourceRefreshSchedule) ImportState(ctx context.Context, req resource.
 	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
 }
 
+func (r *resourceRefreshSchedule) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "quicksight", "dataset/*/refresh-schedule/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return createRefreshScheduleID(arn.AccountID, parts[0], parts[1]), nil
+}
+
 func (r *resourceRefreshSchedule) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var state resourceRefreshScheduleData
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
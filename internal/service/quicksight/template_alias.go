@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -27,6 +28,7 @@ import (
 )
 
 // @FrameworkResource(name="Template Alias")
+// @ARNIdentity
 func newResourceTemplateAlias(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceTemplateAlias{}, nil
 }
@@ -233,6 +235,16 @@ func (r *resourceTemplateAlias) ImportState(ctx context.Context, req resource.Im
 	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
 }
 
+func (r *resourceTemplateAlias) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "quicksight", "template/*/alias/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return createTemplateAliasID(arn.AccountID, parts[0], parts[1]), nil
+}
+
 func FindTemplateAliasByID(ctx context.Context, conn *quicksight.QuickSight, id string) (*quicksight.TemplateAlias, error) {
 	awsAccountID, templateID, aliasName, err := ParseTemplateAliasID(id)
 	if err != nil {
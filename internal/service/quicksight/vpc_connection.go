@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/quicksight"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
@@ -35,6 +36,7 @@ import (
 
 // @FrameworkResource(name="VPC Connection")
 // @Tags(identifierAttribute="arn")
+// @ARNIdentity
 func newResourceVPCConnection(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceVPCConnection{}
 	r.SetDefaultCreateTimeout(5 * time.Minute)
@@ -376,6 +378,16 @@ func (r *resourceVPCConnection) ImportState(ctx context.Context, req resource.Im
 	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
 }
 
+func (r *resourceVPCConnection) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "quicksight", "vpcConnection/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return createVPCConnectionID(arn.AccountID, parts[0]), nil
+}
+
 func (r *resourceVPCConnection) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	r.SetTagsAll(ctx, req, resp)
 }
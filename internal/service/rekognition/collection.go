@@ -9,6 +9,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/rekognition"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/rekognition/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -31,6 +32,7 @@ import (
 
 // @FrameworkResource(name="Collection")
 // @Tags(identifierAttribute="arn")
+// @ARNIdentity
 func newResourceCollection(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceCollection{}
 	r.SetDefaultCreateTimeout(2 * time.Minute)
@@ -239,6 +241,16 @@ func findCollectionByID(ctx context.Context, conn *rekognition.Client, id string
 	return out, nil
 }
 
+func (r *resourceCollection) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "rekognition", "collection/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return parts[0], nil
+}
+
 type resourceCollectionData struct {
 	ARN              types.String   `tfsdk:"arn"`
 	CollectionID     types.String   `tfsdk:"collection_id"`
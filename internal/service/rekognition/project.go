@@ -8,6 +8,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/rekognition"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/rekognition/types"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
@@ -28,6 +29,7 @@ import (
 )
 
 // @FrameworkResource(name="Project")
+// @ARNIdentity
 func newResourceProject(_ context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceProject{}
 
@@ -334,6 +336,16 @@ func statusProject(ctx context.Context, conn *rekognition.Client, name string, f
 	}
 }
 
+func (r *resourceProject) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "rekognition", "project/*/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return parts[0], nil
+}
+
 type resourceProjectData struct {
 	ARN        types.String                                      `tfsdk:"arn"`
 	AutoUpdate fwtypes.StringEnum[awstypes.ProjectAutoUpdate]    `tfsdk:"auto_update"`
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourceexplorer2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourceexplorer2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourceexplorer2/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Account Level Service Configuration")
+func newDataSourceAccountLevelServiceConfiguration(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceAccountLevelServiceConfiguration{}, nil
+}
+
+const (
+	DSNameAccountLevelServiceConfiguration = "Account Level Service Configuration Data Source"
+)
+
+type dataSourceAccountLevelServiceConfiguration struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceAccountLevelServiceConfiguration) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	resp.TypeName = "aws_resourceexplorer2_account_level_service_configuration"
+}
+
+func (d *dataSourceAccountLevelServiceConfiguration) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"aws_service_access_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.AWSServiceAccessStatus](),
+				Computed:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"service_linked_role": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceAccountLevelServiceConfiguration) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().ResourceExplorer2Client(ctx)
+
+	var data dataSourceAccountLevelServiceConfigurationData
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findAccountLevelServiceConfiguration(ctx, conn)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.ResourceExplorer2, create.ErrActionReading, DSNameAccountLevelServiceConfiguration, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(d.Meta().AccountID)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func findAccountLevelServiceConfiguration(ctx context.Context, conn *resourceexplorer2.Client) (*awstypes.OrgConfiguration, error) {
+	input := &resourceexplorer2.GetAccountLevelServiceConfigurationInput{}
+
+	output, err := conn.GetAccountLevelServiceConfiguration(ctx, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.OrgConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.OrgConfiguration, nil
+}
+
+type dataSourceAccountLevelServiceConfigurationData struct {
+	AWSServiceAccessStatus fwtypes.StringEnum[awstypes.AWSServiceAccessStatus] `tfsdk:"aws_service_access_status"`
+	ID                     types.String                                        `tfsdk:"id"`
+	ServiceLinkedRole      types.String                                        `tfsdk:"service_linked_role"`
+}
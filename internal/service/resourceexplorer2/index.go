@@ -31,6 +31,7 @@ import (
 
 // @FrameworkResource(name="Index")
 // @Tags(identifierAttribute="id")
+// @ARNIdentity
 func newResourceIndex(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceIndex{}
 	r.SetDefaultCreateTimeout(2 * time.Hour)
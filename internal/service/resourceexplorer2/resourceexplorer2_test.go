@@ -13,6 +13,9 @@ func TestAccResourceExplorer2_serial(t *testing.T) {
 	t.Parallel()
 
 	testCases := map[string]map[string]func(t *testing.T){
+		"AccountLevelServiceConfigurationDataSource": {
+			acctest.CtBasic: testAccAccountLevelServiceConfigurationDataSource_basic,
+		},
 		"Index": {
 			acctest.CtBasic:      testAccIndex_basic,
 			acctest.CtDisappears: testAccIndex_disappears,
@@ -38,6 +38,7 @@ import (
 
 // @FrameworkResource(name="View")
 // @Tags(identifierAttribute="id")
+// @ARNIdentity
 func newResourceView(context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceView{}, nil
 }
@@ -11,9 +11,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -38,6 +40,11 @@ func dataSourceResources() *schema.Resource {
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				ConflictsWith: []string{"tag_filter"},
 			},
+			"resource_arns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"resource_type_filters": {
 				Type:          schema.TypeSet,
 				Optional:      true,
@@ -45,6 +52,12 @@ func dataSourceResources() *schema.Resource {
 				Elem:          &schema.Schema{Type: schema.TypeString},
 				ConflictsWith: []string{"resource_arn_list"},
 			},
+			"resources_per_page": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ValidateFunc:  validation.IntBetween(1, 100),
+				ConflictsWith: []string{"resource_arn_list"},
+			},
 			"tag_filter": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -129,21 +142,40 @@ func dataSourceResourcesRead(ctx context.Context, d *schema.ResourceData, meta i
 		input.ResourceTypeFilters = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("resources_per_page"); ok {
+		input.ResourcesPerPage = aws.Int32(int32(v.(int)))
+	}
+
 	var taggings []types.ResourceTagMapping
+	var err error
+
+	// GetResources accepts at most 100 resource ARNs per request.
+	if resourceARNs := input.ResourceARNList; len(resourceARNs) > getResourcesMaxResourceARNs {
+		for _, chunk := range tfslices.Chunks(resourceARNs, getResourcesMaxResourceARNs) {
+			input.ResourceARNList = chunk
 
-	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
-	for pages.HasMorePages() {
-		page, err := pages.NextPage(ctx)
+			var output []types.ResourceTagMapping
+			output, err = findResourceTagMappings(ctx, conn, input)
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "reading Resource Groups Tagging API Resources: %s", err)
+			if err != nil {
+				break
+			}
+
+			taggings = append(taggings, output...)
 		}
+	} else {
+		taggings, err = findResourceTagMappings(ctx, conn, input)
+	}
 
-		taggings = append(taggings, page.ResourceTagMappingList...)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resource Groups Tagging API Resources: %s", err)
 	}
 
 	d.SetId(meta.(*conns.AWSClient).Partition)
 
+	d.Set("resource_arns", tfslices.ApplyToAll(taggings, func(v types.ResourceTagMapping) string {
+		return aws.ToString(v.ResourceARN)
+	}))
 	if err := d.Set("resource_tag_mapping_list", flattenResourceTagMappings(ctx, taggings)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting resource tag mapping list: %s", err)
 	}
@@ -96,6 +96,8 @@ func TestAccResourceGroupsTaggingAPIResourcesDataSource_resourceARNList(t *testi
 			{
 				Config: testAccResourcesDataSourceConfig_resourceARNList(rName),
 				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_arns.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_arns.0", resourceName, names.AttrARN),
 					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "resource_tag_mapping_list.*", map[string]string{
 						"tags.Key": rName,
 					}),
@@ -106,6 +108,27 @@ func TestAccResourceGroupsTaggingAPIResourcesDataSource_resourceARNList(t *testi
 	})
 }
 
+func TestAccResourceGroupsTaggingAPIResourcesDataSource_resourcesPerPage(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_resourcegroupstaggingapi_resources.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourcesDataSourceConfig_resourcesPerPage(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "resource_arns.#", acctest.Ct3),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_tag_mapping_list.#", acctest.Ct3),
+				),
+			},
+		},
+	})
+}
+
 func testAccResourcesDataSourceConfig_tagFilter(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_vpc" "test" {
@@ -176,3 +199,28 @@ data "aws_resourcegroupstaggingapi_resources" "test" {
 }
 `, rName)
 }
+
+func testAccResourcesDataSourceConfig_resourcesPerPage(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  count = 3
+
+  cidr_block = "10.${count.index}.0.0/16"
+
+  tags = {
+    Key = %[1]q
+  }
+}
+
+data "aws_resourcegroupstaggingapi_resources" "test" {
+  resources_per_page = 1
+
+  tag_filter {
+    key    = "Key"
+    values = [aws_vpc.test[0].tags["Key"]]
+  }
+
+  depends_on = [aws_vpc.test]
+}
+`, rName)
+}
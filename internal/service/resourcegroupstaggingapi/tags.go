@@ -0,0 +1,483 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// See https://docs.aws.amazon.com/resourcegroupstagging/latest/APIReference/API_TagResources.html.
+	tagResourcesMaxResourceARNs = 20
+	// See https://docs.aws.amazon.com/resourcegroupstagging/latest/APIReference/API_GetResources.html.
+	getResourcesMaxResourceARNs = 100
+)
+
+// @FrameworkResource(name="Tags")
+func newTagsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &tagsResource{}
+
+	return r, nil
+}
+
+type tagsResource struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *tagsResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_resourcegroupstaggingapi_tags"
+}
+
+func (r *tagsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"resource_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ConflictsWith(path.MatchRoot("resource_type_filters")),
+				},
+			},
+			"resource_type_filters": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 100),
+				},
+			},
+			"tagged_resource_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrTags: schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"tag_filter": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[tagFilterModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(50),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrKey: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrValues: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtMost(20),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *tagsResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data tagsResourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	hasARNs := !data.ResourceARNs.IsNull()
+	hasQuery := !data.ResourceTypeFilters.IsNull() || (!data.TagFilters.IsNull() && len(data.TagFilters.Elements()) > 0)
+
+	if hasARNs && hasQuery {
+		response.Diagnostics.AddAttributeError(
+			path.Root("resource_arns"),
+			"Invalid Attribute Combination",
+			"resource_arns cannot be specified when tag_filter or resource_type_filters is specified",
+		)
+	}
+
+	if !data.ResourceARNs.IsUnknown() && !data.ResourceTypeFilters.IsUnknown() && !hasARNs && !hasQuery {
+		response.Diagnostics.AddError(
+			"Invalid Attribute Combination",
+			"One of resource_arns, tag_filter or resource_type_filters must be specified",
+		)
+	}
+}
+
+func (r *tagsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data tagsResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceGroupsTaggingAPIClient(ctx)
+
+	resourceARNs, err := data.targetResourceARNs(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Resource Groups Tagging API target resources", err.Error())
+
+		return
+	}
+
+	tags := fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags)
+
+	if err := tagResources(ctx, conn, resourceARNs, tags); err != nil {
+		response.Diagnostics.AddError("creating Resource Groups Tagging API Tags", err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = types.StringValue(sdkid.UniqueId())
+	data.TaggedResourceARNs = fwflex.FlattenFrameworkStringValueSetOfString(ctx, resourceARNs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tagsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data tagsResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceGroupsTaggingAPIClient(ctx)
+
+	var resourceARNs []string
+	var mappings []awstypes.ResourceTagMapping
+	var err error
+
+	if data.ResourceARNs.IsNull() {
+		// Tag filter query. Resources that newly match the query are reconciled too.
+		mappings, err = findResourceTagMappings(ctx, conn, data.getResourcesInput(ctx))
+		resourceARNs = tfslices.ApplyToAll(mappings, func(v awstypes.ResourceTagMapping) string {
+			return aws.ToString(v.ResourceARN)
+		})
+	} else {
+		resourceARNs = fwflex.ExpandFrameworkStringValueSet(ctx, data.TaggedResourceARNs)
+		mappings, err = findResourceTagMappingsByARNs(ctx, conn, resourceARNs)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resource Groups Tagging API Tags (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Target resources that no longer exist are not returned and are no longer tracked.
+	// Tags on the remaining target resources are kept.
+	resourceARNs = tfslices.Filter(resourceARNs, func(resourceARN string) bool {
+		return tfslices.Any(mappings, func(v awstypes.ResourceTagMapping) bool {
+			return aws.ToString(v.ResourceARN) == resourceARN
+		})
+	})
+
+	if len(resourceARNs) == 0 && !data.ResourceARNs.IsNull() {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(tfresource.NewEmptyResultError(data.TaggedResourceARNs)))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	// Only tags with the same value on every target resource are reported in state.
+	// Any difference causes the tags to be reapplied to all target resources.
+	resourceTags := make(map[string]map[string]string, len(mappings))
+	for _, v := range mappings {
+		resourceTags[aws.ToString(v.ResourceARN)] = KeyValueTags(ctx, v.Tags).Map()
+	}
+
+	tags := fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags)
+	for key, value := range tags {
+		for _, resourceARN := range resourceARNs {
+			if v, ok := resourceTags[resourceARN][key]; !ok || v != value {
+				delete(tags, key)
+
+				break
+			}
+		}
+	}
+
+	data.Tags = fwflex.FlattenFrameworkStringValueMapOfString(ctx, tags)
+	data.TaggedResourceARNs = fwflex.FlattenFrameworkStringValueSetOfString(ctx, resourceARNs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tagsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new tagsResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceGroupsTaggingAPIClient(ctx)
+
+	resourceARNs, err := new.targetResourceARNs(ctx, conn)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading Resource Groups Tagging API target resources", err.Error())
+
+		return
+	}
+
+	oldResourceARNs := fwflex.ExpandFrameworkStringValueSet(ctx, old.TaggedResourceARNs)
+	oldTags, newTags := fwflex.ExpandFrameworkStringValueMap(ctx, old.Tags), fwflex.ExpandFrameworkStringValueMap(ctx, new.Tags)
+
+	// Remove all tags from resources that are no longer targeted.
+	var removedResourceARNs []string
+	for _, v := range oldResourceARNs {
+		if !tfslices.Any(resourceARNs, tfslices.PredicateEquals(v)) {
+			removedResourceARNs = append(removedResourceARNs, v)
+		}
+	}
+
+	if err := untagResources(ctx, conn, removedResourceARNs, tfmaps.Keys(oldTags)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Resource Groups Tagging API Tags (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Remove tags that are no longer configured from resources that are still targeted.
+	var removedTagKeys []string
+	for k := range oldTags {
+		if _, ok := newTags[k]; !ok {
+			removedTagKeys = append(removedTagKeys, k)
+		}
+	}
+
+	if err := untagResources(ctx, conn, oldResourceARNs, removedTagKeys); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Resource Groups Tagging API Tags (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if err := tagResources(ctx, conn, resourceARNs, newTags); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Resource Groups Tagging API Tags (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.TaggedResourceARNs = fwflex.FlattenFrameworkStringValueSetOfString(ctx, resourceARNs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *tagsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data tagsResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResourceGroupsTaggingAPIClient(ctx)
+
+	resourceARNs := fwflex.ExpandFrameworkStringValueSet(ctx, data.TaggedResourceARNs)
+	tagKeys := tfmaps.Keys(fwflex.ExpandFrameworkStringValueMap(ctx, data.Tags))
+
+	if err := untagResources(ctx, conn, resourceARNs, tagKeys); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Resource Groups Tagging API Tags (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+type tagsResourceModel struct {
+	ID                  types.String                                    `tfsdk:"id"`
+	ResourceARNs        fwtypes.SetValueOf[types.String]                `tfsdk:"resource_arns"`
+	ResourceTypeFilters fwtypes.SetValueOf[types.String]                `tfsdk:"resource_type_filters"`
+	TagFilters          fwtypes.ListNestedObjectValueOf[tagFilterModel] `tfsdk:"tag_filter"`
+	TaggedResourceARNs  fwtypes.SetValueOf[types.String]                `tfsdk:"tagged_resource_arns"`
+	Tags                fwtypes.MapValueOf[types.String]                `tfsdk:"tags"`
+}
+
+type tagFilterModel struct {
+	Key    types.String                     `tfsdk:"key"`
+	Values fwtypes.SetValueOf[types.String] `tfsdk:"values"`
+}
+
+func (data *tagsResourceModel) getResourcesInput(ctx context.Context) *resourcegroupstaggingapi.GetResourcesInput {
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: fwflex.ExpandFrameworkStringValueSet(ctx, data.ResourceTypeFilters),
+	}
+
+	if tagFilters, _ := data.TagFilters.ToSlice(ctx); len(tagFilters) > 0 {
+		input.TagFilters = tfslices.ApplyToAll(tagFilters, func(v *tagFilterModel) awstypes.TagFilter {
+			return awstypes.TagFilter{
+				Key:    fwflex.StringFromFramework(ctx, v.Key),
+				Values: fwflex.ExpandFrameworkStringValueSet(ctx, v.Values),
+			}
+		})
+	}
+
+	return input
+}
+
+// targetResourceARNs returns the ARNs of the resources to be tagged,
+// either as configured or by running the configured tag filter query.
+func (data *tagsResourceModel) targetResourceARNs(ctx context.Context, conn *resourcegroupstaggingapi.Client) ([]string, error) {
+	if !data.ResourceARNs.IsNull() {
+		return fwflex.ExpandFrameworkStringValueSet(ctx, data.ResourceARNs), nil
+	}
+
+	mappings, err := findResourceTagMappings(ctx, conn, data.getResourcesInput(ctx))
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(mappings, func(v awstypes.ResourceTagMapping) string {
+		return aws.ToString(v.ResourceARN)
+	}), nil
+}
+
+func findResourceTagMappings(ctx context.Context, conn *resourcegroupstaggingapi.Client, input *resourcegroupstaggingapi.GetResourcesInput) ([]awstypes.ResourceTagMapping, error) {
+	var output []awstypes.ResourceTagMapping
+
+	pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ResourceTagMappingList...)
+	}
+
+	return output, nil
+}
+
+func findResourceTagMappingsByARNs(ctx context.Context, conn *resourcegroupstaggingapi.Client, resourceARNs []string) ([]awstypes.ResourceTagMapping, error) {
+	var output []awstypes.ResourceTagMapping
+
+	for _, chunk := range tfslices.Chunks(resourceARNs, getResourcesMaxResourceARNs) {
+		input := &resourcegroupstaggingapi.GetResourcesInput{
+			ResourceARNList: chunk,
+		}
+
+		mappings, err := findResourceTagMappings(ctx, conn, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, mappings...)
+	}
+
+	return output, nil
+}
+
+func tagResources(ctx context.Context, conn *resourcegroupstaggingapi.Client, resourceARNs []string, tags map[string]string) error {
+	if len(resourceARNs) == 0 || len(tags) == 0 {
+		return nil
+	}
+
+	var errs []error
+
+	for _, chunk := range tfslices.Chunks(resourceARNs, tagResourcesMaxResourceARNs) {
+		input := &resourcegroupstaggingapi.TagResourcesInput{
+			ResourceARNList: chunk,
+			Tags:            tags,
+		}
+
+		output, err := conn.TagResources(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("tagging resources: %w", err)
+		}
+
+		errs = append(errs, failedResourcesError(output.FailedResourcesMap)...)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("tagging resources: %w", err)
+	}
+
+	return nil
+}
+
+func untagResources(ctx context.Context, conn *resourcegroupstaggingapi.Client, resourceARNs []string, tagKeys []string) error {
+	if len(resourceARNs) == 0 || len(tagKeys) == 0 {
+		return nil
+	}
+
+	var errs []error
+
+	for _, chunk := range tfslices.Chunks(resourceARNs, tagResourcesMaxResourceARNs) {
+		input := &resourcegroupstaggingapi.UntagResourcesInput{
+			ResourceARNList: chunk,
+			TagKeys:         tagKeys,
+		}
+
+		output, err := conn.UntagResources(ctx, input)
+
+		if err != nil {
+			return fmt.Errorf("untagging resources: %w", err)
+		}
+
+		errs = append(errs, failedResourcesError(output.FailedResourcesMap)...)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		return fmt.Errorf("untagging resources: %w", err)
+	}
+
+	return nil
+}
+
+func failedResourcesError(failedResources map[string]awstypes.FailureInfo) []error {
+	var errs []error
+
+	for resourceARN, v := range failedResources {
+		errs = append(errs, fmt.Errorf("%s: %s: %s", resourceARN, v.ErrorCode, aws.ToString(v.ErrorMessage)))
+	}
+
+	return errs
+}
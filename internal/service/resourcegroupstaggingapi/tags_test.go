@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourcegroupstaggingapi "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccResourceGroupsTaggingAPITags_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourcegroupstaggingapi_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagsConfig_resourceARNs(rName, "CostCenter", "1234"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "tagged_resource_arns.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "tags.CostCenter", "1234"),
+					testAccCheckResourcesTagged(ctx, "aws_sqs_queue.test.0", "CostCenter", "1234"),
+					testAccCheckResourcesTagged(ctx, "aws_sqs_queue.test.1", "CostCenter", "1234"),
+				),
+			},
+			{
+				Config: testAccTagsConfig_resourceARNs(rName, "CostCenter", "5678"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tagged_resource_arns.#", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "tags.CostCenter", "5678"),
+					testAccCheckResourcesTagged(ctx, "aws_sqs_queue.test.0", "CostCenter", "5678"),
+					testAccCheckResourcesTagged(ctx, "aws_sqs_queue.test.1", "CostCenter", "5678"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPITags_tagFilter(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_resourcegroupstaggingapi_tags.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagsConfig_tagFilter(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tagged_resource_arns.#", acctest.Ct1),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "tagged_resource_arns.*", "aws_vpc.test", names.AttrARN),
+					testAccCheckResourcesTagged(ctx, "aws_vpc.test", "CostCenter", "1234"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckResourcesTagged(ctx context.Context, n, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+		output, err := tfresourcegroupstaggingapi.FindResourceTagMappingsByARNs(ctx, conn, []string{rs.Primary.Attributes[names.AttrARN]})
+
+		if err != nil {
+			return err
+		}
+
+		for _, mapping := range output {
+			for _, tag := range mapping.Tags {
+				if aws.ToString(tag.Key) == key {
+					if got := aws.ToString(tag.Value); got != value {
+						return fmt.Errorf("%s tag %s = %q, want %q", n, key, got, value)
+					}
+
+					return nil
+				}
+			}
+		}
+
+		return fmt.Errorf("%s tag %s not found", n, key)
+	}
+}
+
+// The tags are ignored by the target resources' own tagging to avoid perpetual differences.
+func testAccTagsConfig_resourceARNs(rName, key, value string) string {
+	return acctest.ConfigCompose(acctest.ConfigIgnoreTagsKeys(key), fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  count = 2
+
+  name = "%[1]s-${count.index}"
+}
+
+resource "aws_resourcegroupstaggingapi_tags" "test" {
+  resource_arns = aws_sqs_queue.test[*].arn
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, key, value))
+}
+
+func testAccTagsConfig_tagFilter(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigIgnoreTagsKeys("CostCenter"), fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_resourcegroupstaggingapi_tags" "test" {
+  resource_type_filters = ["ec2:vpc"]
+
+  tag_filter {
+    key    = "Name"
+    values = [aws_vpc.test.tags["Name"]]
+  }
+
+  tags = {
+    CostCenter = "1234"
+  }
+}
+`, rName))
+}
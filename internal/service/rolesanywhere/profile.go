@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"log"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere/types"
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -38,6 +40,32 @@ func ResourceProfile() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"attribute_mapping": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate_field": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.CertificateField](),
+						},
+						"mapping_rule": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"specifier": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"duration_seconds": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -120,6 +148,14 @@ func resourceProfileCreate(ctx context.Context, d *schema.ResourceData, meta int
 
 	d.SetId(aws.StringValue(output.Profile.ProfileId))
 
+	if v, ok := d.GetOk("attribute_mapping"); ok && v.(*schema.Set).Len() > 0 {
+		for _, v := range expandAttributeMappings(v.(*schema.Set).List()) {
+			if err := putAttributeMapping(ctx, conn, d.Id(), v); err != nil {
+				return sdkdiag.AppendErrorf(diags, "creating RolesAnywhere Profile (%s) attribute mapping (%s): %s", d.Id(), v.CertificateField, err)
+			}
+		}
+	}
+
 	return append(diags, resourceProfileRead(ctx, d, meta)...)
 }
 
@@ -140,6 +176,9 @@ func resourceProfileRead(ctx context.Context, d *schema.ResourceData, meta inter
 	}
 
 	d.Set(names.AttrARN, profile.ProfileArn)
+	if err := d.Set("attribute_mapping", flattenAttributeMappings(profile.AttributeMappings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting attribute_mapping: %s", err)
+	}
 	d.Set("duration_seconds", profile.DurationSeconds)
 	d.Set(names.AttrEnabled, profile.Enabled)
 	d.Set("managed_policy_arns", profile.ManagedPolicyArns)
@@ -155,7 +194,7 @@ func resourceProfileUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "attribute_mapping") {
 		input := &rolesanywhere.UpdateProfileInput{
 			ProfileId: aws.String(d.Id()),
 		}
@@ -187,6 +226,36 @@ func resourceProfileUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		}
 	}
 
+	if d.HasChange("attribute_mapping") {
+		o, n := d.GetChange("attribute_mapping")
+		oldMappings, newMappings := expandAttributeMappings(o.(*schema.Set).List()), expandAttributeMappings(n.(*schema.Set).List())
+
+		for _, old := range oldMappings {
+			if slices.ContainsFunc(newMappings, func(new types.AttributeMapping) bool {
+				return new.CertificateField == old.CertificateField
+			}) {
+				continue
+			}
+
+			input := &rolesanywhere.DeleteAttributeMappingInput{
+				CertificateField: old.CertificateField,
+				ProfileId:        aws.String(d.Id()),
+			}
+
+			_, err := conn.DeleteAttributeMapping(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "deleting RolesAnywhere Profile (%s) attribute mapping (%s): %s", d.Id(), old.CertificateField, err)
+			}
+		}
+
+		for _, v := range newMappings {
+			if err := putAttributeMapping(ctx, conn, d.Id(), v); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating RolesAnywhere Profile (%s) attribute mapping (%s): %s", d.Id(), v.CertificateField, err)
+			}
+		}
+	}
+
 	if d.HasChange(names.AttrEnabled) {
 		_, n := d.GetChange(names.AttrEnabled)
 		if n == true {
@@ -226,6 +295,69 @@ func resourceProfileDelete(ctx context.Context, d *schema.ResourceData, meta int
 	return diags
 }
 
+func putAttributeMapping(ctx context.Context, conn *rolesanywhere.Client, profileID string, apiObject types.AttributeMapping) error {
+	input := &rolesanywhere.PutAttributeMappingInput{
+		CertificateField: apiObject.CertificateField,
+		MappingRules:     apiObject.MappingRules,
+		ProfileId:        aws.String(profileID),
+	}
+
+	_, err := conn.PutAttributeMapping(ctx, input)
+
+	return err
+}
+
+func expandAttributeMappings(tfList []interface{}) []types.AttributeMapping {
+	var apiObjects []types.AttributeMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.AttributeMapping{
+			CertificateField: types.CertificateField(tfMap["certificate_field"].(string)),
+		}
+
+		for _, tfMapRaw := range tfMap["mapping_rule"].(*schema.Set).List() {
+			tfMap, ok := tfMapRaw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			apiObject.MappingRules = append(apiObject.MappingRules, types.MappingRule{
+				Specifier: aws.String(tfMap["specifier"].(string)),
+			})
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenAttributeMappings(apiObjects []types.AttributeMapping) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		var mappingRules []interface{}
+
+		for _, v := range apiObject.MappingRules {
+			mappingRules = append(mappingRules, map[string]interface{}{
+				"specifier": aws.StringValue(v.Specifier),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"certificate_field": string(apiObject.CertificateField),
+			"mapping_rule":      mappingRules,
+		})
+	}
+
+	return tfList
+}
+
 func disableProfile(ctx context.Context, profileId string, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
 
@@ -161,6 +161,51 @@ func TestAccRolesAnywhereProfile_enabled(t *testing.T) {
 	})
 }
 
+func TestAccRolesAnywhereProfile_attributeMapping(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	roleName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rolesanywhere_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RolesAnywhereServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProfileConfig_attributeMapping(rName, roleName, "CN"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfileExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attribute_mapping.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute_mapping.*", map[string]string{
+						"certificate_field":        "x509Subject",
+						"mapping_rule.#":           acctest.Ct1,
+						"mapping_rule.0.specifier": "CN",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccProfileConfig_attributeMapping(rName, roleName, "OU"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckProfileExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "attribute_mapping.#", acctest.Ct3),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "attribute_mapping.*", map[string]string{
+						"certificate_field":        "x509Subject",
+						"mapping_rule.#":           acctest.Ct1,
+						"mapping_rule.0.specifier": "OU",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckProfileDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RolesAnywhereClient(ctx)
@@ -285,3 +330,38 @@ resource "aws_rolesanywhere_profile" "test" {
 }
 `, rName, enabled))
 }
+
+func testAccProfileConfig_attributeMapping(rName, roleName, subjectSpecifier string) string {
+	return acctest.ConfigCompose(
+		testAccProfileConfig_base(roleName),
+		fmt.Sprintf(`
+resource "aws_rolesanywhere_profile" "test" {
+  name      = %[1]q
+  role_arns = [aws_iam_role.test.arn]
+
+  attribute_mapping {
+    certificate_field = "x509Subject"
+
+    mapping_rule {
+      specifier = %[2]q
+    }
+  }
+
+  attribute_mapping {
+    certificate_field = "x509Issuer"
+
+    mapping_rule {
+      specifier = "CN"
+    }
+  }
+
+  attribute_mapping {
+    certificate_field = "x509SAN"
+
+    mapping_rule {
+      specifier = "DNS"
+    }
+  }
+}
+`, rName, subjectSpecifier))
+}
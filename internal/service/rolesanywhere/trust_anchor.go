@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"log"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere"
 	"github.com/aws/aws-sdk-go-v2/service/rolesanywhere/types"
@@ -15,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -49,6 +51,41 @@ func ResourceTrustAnchor() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"notification_settings": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				MaxItems: 50,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[types.NotificationChannel](),
+						},
+						"configured_by": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"event": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: enum.Validate[types.NotificationEvent](),
+						},
+						"threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 360),
+						},
+					},
+				},
+			},
 			names.AttrSource: {
 				Type:     schema.TypeList,
 				Required: true,
@@ -103,6 +140,10 @@ func resourceTrustAnchorCreate(ctx context.Context, d *schema.ResourceData, meta
 		Tags:    getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("notification_settings"); ok && v.(*schema.Set).Len() > 0 {
+		input.NotificationSettings = expandNotificationSettings(v.(*schema.Set).List())
+	}
+
 	log.Printf("[DEBUG] Creating RolesAnywhere Trust Anchor (%s): %#v", d.Id(), input)
 	output, err := conn.CreateTrustAnchor(ctx, input)
 
@@ -135,6 +176,10 @@ func resourceTrustAnchorRead(ctx context.Context, d *schema.ResourceData, meta i
 	d.Set(names.AttrEnabled, trustAnchor.Enabled)
 	d.Set(names.AttrName, trustAnchor.Name)
 
+	if err := d.Set("notification_settings", flattenNotificationSettingDetails(trustAnchor.NotificationSettings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting notification_settings: %s", err)
+	}
+
 	if err := d.Set(names.AttrSource, flattenSource(trustAnchor.Source)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting source: %s", err)
 	}
@@ -146,7 +191,7 @@ func resourceTrustAnchorUpdate(ctx context.Context, d *schema.ResourceData, meta
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "notification_settings") {
 		input := &rolesanywhere.UpdateTrustAnchorInput{
 			TrustAnchorId: aws.String(d.Id()),
 			Name:          aws.String(d.Get(names.AttrName).(string)),
@@ -174,6 +219,50 @@ func resourceTrustAnchorUpdate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if d.HasChange("notification_settings") {
+		o, n := d.GetChange("notification_settings")
+		oldSettings, newSettings := expandNotificationSettings(o.(*schema.Set).List()), expandNotificationSettings(n.(*schema.Set).List())
+
+		// Settings that are no longer configured are reset to their defaults.
+		var keys []types.NotificationSettingKey
+		for _, old := range oldSettings {
+			if !slices.ContainsFunc(newSettings, func(new types.NotificationSetting) bool {
+				return new.Event == old.Event && new.Channel == old.Channel
+			}) {
+				keys = append(keys, types.NotificationSettingKey{
+					Channel: old.Channel,
+					Event:   old.Event,
+				})
+			}
+		}
+
+		if len(keys) > 0 {
+			input := &rolesanywhere.ResetNotificationSettingsInput{
+				NotificationSettingKeys: keys,
+				TrustAnchorId:           aws.String(d.Id()),
+			}
+
+			_, err := conn.ResetNotificationSettings(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "resetting RolesAnywhere Trust Anchor (%s) notification settings: %s", d.Id(), err)
+			}
+		}
+
+		if len(newSettings) > 0 {
+			input := &rolesanywhere.PutNotificationSettingsInput{
+				NotificationSettings: newSettings,
+				TrustAnchorId:        aws.String(d.Id()),
+			}
+
+			_, err := conn.PutNotificationSettings(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "putting RolesAnywhere Trust Anchor (%s) notification settings: %s", d.Id(), err)
+			}
+		}
+	}
+
 	return append(diags, resourceTrustAnchorRead(ctx, d, meta)...)
 }
 
@@ -279,6 +368,50 @@ func expandSourceDataCertificateBundle(tfMap map[string]interface{}) *types.Sour
 	return result
 }
 
+func expandNotificationSettings(tfList []interface{}) []types.NotificationSetting {
+	var apiObjects []types.NotificationSetting
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := types.NotificationSetting{
+			Enabled: aws.Bool(tfMap[names.AttrEnabled].(bool)),
+			Event:   types.NotificationEvent(tfMap["event"].(string)),
+		}
+
+		if v, ok := tfMap["channel"].(string); ok && v != "" {
+			apiObject.Channel = types.NotificationChannel(v)
+		}
+
+		if v, ok := tfMap["threshold"].(int); ok && v != 0 {
+			apiObject.Threshold = aws.Int32(int32(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenNotificationSettingDetails(apiObjects []types.NotificationSettingDetail) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"channel":         string(apiObject.Channel),
+			"configured_by":   aws.StringValue(apiObject.ConfiguredBy),
+			names.AttrEnabled: aws.BoolValue(apiObject.Enabled),
+			"event":           string(apiObject.Event),
+			"threshold":       aws.Int32Value(apiObject.Threshold),
+		})
+	}
+
+	return tfList
+}
+
 func disableTrustAnchor(ctx context.Context, trustAnchorId string, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).RolesAnywhereClient(ctx)
 
@@ -194,6 +194,63 @@ func TestAccRolesAnywhereTrustAnchor_enabled(t *testing.T) {
 	})
 }
 
+func TestAccRolesAnywhereTrustAnchor_notificationSettings(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	caCommonName := acctest.RandomDomainName()
+	resourceName := "aws_rolesanywhere_trust_anchor.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RolesAnywhereServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTrustAnchorDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTrustAnchorConfig_notificationSettings(rName, caCommonName, true, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustAnchorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification_settings.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "notification_settings.*", map[string]string{
+						"channel":         "ALL",
+						names.AttrEnabled: acctest.CtTrue,
+						"event":           "CA_CERTIFICATE_EXPIRY",
+						"threshold":       "30",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "notification_settings.*", map[string]string{
+						"channel":         "ALL",
+						names.AttrEnabled: acctest.CtTrue,
+						"event":           "END_ENTITY_CERTIFICATE_EXPIRY",
+						"threshold":       "30",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTrustAnchorConfig_notificationSettings(rName, caCommonName, false, 60),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrustAnchorExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "notification_settings.#", acctest.Ct2),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "notification_settings.*", map[string]string{
+						names.AttrEnabled: acctest.CtFalse,
+						"event":           "CA_CERTIFICATE_EXPIRY",
+						"threshold":       "60",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "notification_settings.*", map[string]string{
+						names.AttrEnabled: acctest.CtFalse,
+						"event":           "END_ENTITY_CERTIFICATE_EXPIRY",
+						"threshold":       "60",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTrustAnchorDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).RolesAnywhereClient(ctx)
@@ -294,6 +351,36 @@ resource "aws_rolesanywhere_trust_anchor" "test" {
 `, rName))
 }
 
+func testAccTrustAnchorConfig_notificationSettings(rName, caCommonName string, enabled bool, threshold int) string {
+	return acctest.ConfigCompose(
+		testAccTrustAnchorConfig_acmBase(caCommonName),
+		fmt.Sprintf(`
+resource "aws_rolesanywhere_trust_anchor" "test" {
+  name = %[1]q
+  source {
+    source_data {
+      acm_pca_arn = aws_acmpca_certificate_authority.test.arn
+    }
+    source_type = "AWS_ACM_PCA"
+  }
+
+  notification_settings {
+    enabled   = %[2]t
+    event     = "CA_CERTIFICATE_EXPIRY"
+    threshold = %[3]d
+  }
+
+  notification_settings {
+    enabled   = %[2]t
+    event     = "END_ENTITY_CERTIFICATE_EXPIRY"
+    threshold = %[3]d
+  }
+
+  depends_on = [aws_acmpca_certificate_authority_certificate.test]
+}
+`, rName, enabled, threshold))
+}
+
 func testAccTrustAnchorConfig_tags1(rName, caCommonName, tag, value string) string {
 	return acctest.ConfigCompose(
 		testAccTrustAnchorConfig_acmBase(caCommonName),
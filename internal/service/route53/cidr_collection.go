@@ -10,6 +10,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -31,6 +32,7 @@ import (
 )
 
 // @FrameworkResource
+// @ARNIdentity
 func newCIDRCollectionResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &cidrCollectionResource{}
 
@@ -164,6 +166,16 @@ func (r *cidrCollectionResource) Delete(ctx context.Context, request resource.De
 	}
 }
 
+func (r *cidrCollectionResource) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "route53", "cidrcollection/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return parts[0], nil
+}
+
 type cidrCollectionResourceModel struct {
 	ARN     types.String `tfsdk:"arn"`
 	ID      types.String `tfsdk:"id"`
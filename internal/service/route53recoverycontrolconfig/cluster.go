@@ -6,6 +6,7 @@ package route53recoverycontrolconfig
 import (
 	"context"
 	"log"
+	"sort"
 
 	"github.com/aws/aws-sdk-go/aws"
 	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
@@ -172,6 +173,14 @@ func flattenClusterEndpoints(endpoints []*r53rcc.ClusterEndpoint) []interface{}
 		tfList = append(tfList, flattenClusterEndpoint(endpoint))
 	}
 
+	// The API returns endpoints in no particular order; sort them by Region to avoid spurious diffs.
+	sort.SliceStable(tfList, func(i, j int) bool {
+		ri, _ := tfList[i].(map[string]interface{})[names.AttrRegion].(string)
+		rj, _ := tfList[j].(map[string]interface{})[names.AttrRegion].(string)
+
+		return ri < rj
+	})
+
 	return tfList
 }
 
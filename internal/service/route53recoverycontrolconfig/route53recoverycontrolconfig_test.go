@@ -31,6 +31,7 @@ func TestAccRoute53RecoveryControlConfig_serial(t *testing.T) {
 		"SafetyRule": {
 			"assertionRule":      testAccSafetyRule_assertionRule,
 			"gatingRule":         testAccSafetyRule_gatingRule,
+			"update":             testAccSafetyRule_update,
 			acctest.CtDisappears: testAccSafetyRule_disappears,
 		},
 	}
@@ -321,16 +321,11 @@ func updateAssertionRule(ctx context.Context, d *schema.ResourceData, meta inter
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53RecoveryControlConfigConn(ctx)
 
+	// Both name and wait period are required by the API, even if unchanged.
 	assertionRuleUpdate := &r53rcc.AssertionRuleUpdate{
-		SafetyRuleArn: aws.String(d.Get(names.AttrARN).(string)),
-	}
-
-	if d.HasChange(names.AttrName) {
-		assertionRuleUpdate.Name = aws.String(d.Get(names.AttrName).(string))
-	}
-
-	if d.HasChange("wait_period_ms") {
-		assertionRuleUpdate.WaitPeriodMs = aws.Int64(int64(d.Get("wait_period_ms").(int)))
+		Name:          aws.String(d.Get(names.AttrName).(string)),
+		SafetyRuleArn: aws.String(d.Id()),
+		WaitPeriodMs:  aws.Int64(int64(d.Get("wait_period_ms").(int))),
 	}
 
 	input := &r53rcc.UpdateSafetyRuleInput{
@@ -343,23 +338,22 @@ func updateAssertionRule(ctx context.Context, d *schema.ResourceData, meta inter
 		return sdkdiag.AppendErrorf(diags, "updating Route53 Recovery Control Config Assertion Rule: %s", err)
 	}
 
-	return append(diags, sdkdiag.WrapDiagsf(resourceControlPanelRead(ctx, d, meta), "updating Route53 Recovery Control Config Assertion Rule")...)
+	if _, err := waitSafetyRuleCreated(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Route53 Recovery Control Config Assertion Rule (%s) to be Deployed: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSafetyRuleRead(ctx, d, meta)...)
 }
 
 func updateGatingRule(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).Route53RecoveryControlConfigConn(ctx)
 
+	// Both name and wait period are required by the API, even if unchanged.
 	gatingRuleUpdate := &r53rcc.GatingRuleUpdate{
-		SafetyRuleArn: aws.String(d.Get(names.AttrARN).(string)),
-	}
-
-	if d.HasChange(names.AttrName) {
-		gatingRuleUpdate.Name = aws.String(d.Get(names.AttrName).(string))
-	}
-
-	if d.HasChange("wait_period_ms") {
-		gatingRuleUpdate.WaitPeriodMs = aws.Int64(int64(d.Get("wait_period_ms").(int)))
+		Name:          aws.String(d.Get(names.AttrName).(string)),
+		SafetyRuleArn: aws.String(d.Id()),
+		WaitPeriodMs:  aws.Int64(int64(d.Get("wait_period_ms").(int))),
 	}
 
 	input := &r53rcc.UpdateSafetyRuleInput{
@@ -372,7 +366,11 @@ func updateGatingRule(ctx context.Context, d *schema.ResourceData, meta interfac
 		return sdkdiag.AppendErrorf(diags, "updating Route53 Recovery Control Config Gating Rule: %s", err)
 	}
 
-	return append(diags, sdkdiag.WrapDiagsf(resourceControlPanelRead(ctx, d, meta), "updating Route53 Recovery Control Config Gating Rule")...)
+	if _, err := waitSafetyRuleCreated(ctx, conn, d.Id()); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Route53 Recovery Control Config Gating Rule (%s) to be Deployed: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSafetyRuleRead(ctx, d, meta)...)
 }
 
 func testAccSafetyRuleConfig_expandRule(tfMap map[string]interface{}) *r53rcc.RuleConfig {
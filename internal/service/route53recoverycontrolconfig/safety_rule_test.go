@@ -12,6 +12,7 @@ import (
 	r53rcc "github.com/aws/aws-sdk-go/service/route53recoverycontrolconfig"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -105,6 +106,57 @@ func testAccSafetyRule_gatingRule(t *testing.T) {
 	})
 }
 
+func testAccSafetyRule_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_route53recoverycontrolconfig_safety_rule.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, r53rcc.EndpointsID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53RecoveryControlConfigServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSafetyRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSafetyRuleConfig_update(rName, rName, 5000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSafetyRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "wait_period_ms", "5000"),
+				),
+			},
+			{
+				Config: testAccSafetyRuleConfig_update(rName, rName, 10000),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSafetyRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "DEPLOYED"),
+					resource.TestCheckResourceAttr(resourceName, "wait_period_ms", "10000"),
+				),
+			},
+			{
+				Config: testAccSafetyRuleConfig_update(rName, rNameUpdated, 10000),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSafetyRuleExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "wait_period_ms", "10000"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckSafetyRuleDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53RecoveryControlConfigConn(ctx)
@@ -212,3 +264,35 @@ resource "aws_route53recoverycontrolconfig_safety_rule" "test" {
 }
 `, rName)
 }
+
+func testAccSafetyRuleConfig_update(rName, ruleName string, waitPeriodMs int) string {
+	return fmt.Sprintf(`
+resource "aws_route53recoverycontrolconfig_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53recoverycontrolconfig_control_panel" "test" {
+  name        = %[1]q
+  cluster_arn = aws_route53recoverycontrolconfig_cluster.test.arn
+}
+
+resource "aws_route53recoverycontrolconfig_routing_control" "test" {
+  name              = %[1]q
+  cluster_arn       = aws_route53recoverycontrolconfig_cluster.test.arn
+  control_panel_arn = aws_route53recoverycontrolconfig_control_panel.test.arn
+}
+
+resource "aws_route53recoverycontrolconfig_safety_rule" "test" {
+  name              = %[2]q
+  control_panel_arn = aws_route53recoverycontrolconfig_control_panel.test.arn
+  wait_period_ms    = %[3]d
+  asserted_controls = [aws_route53recoverycontrolconfig_routing_control.test.arn]
+
+  rule_config {
+    inverted  = false
+    threshold = 0
+    type      = "AND"
+  }
+}
+`, rName, ruleName, waitPeriodMs)
+}
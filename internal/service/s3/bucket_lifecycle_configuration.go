@@ -13,8 +13,11 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -252,7 +255,15 @@ func resourceBucketLifecycleConfiguration() *schema.Resource {
 					},
 				},
 			},
+			"transition_default_minimum_object_size": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(transitionDefaultMinimumObjectSize_Values(), false),
+			},
 		},
+
+		CustomizeDiff: bucketLifecycleConfigurationRulesCustomizeDiff,
 	}
 }
 
@@ -273,8 +284,13 @@ func resourceBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.R
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
+	var optFns []func(*s3.Options)
+	if v, ok := d.GetOk("transition_default_minimum_object_size"); ok {
+		optFns = append(optFns, withTransitionDefaultMinimumObjectSize(v.(string)))
+	}
+
 	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (interface{}, error) {
-		return conn.PutBucketLifecycleConfiguration(ctx, input)
+		return conn.PutBucketLifecycleConfiguration(ctx, input, optFns...)
 	}, errCodeNoSuchBucket)
 
 	if tfawserr.ErrMessageContains(err, errCodeInvalidArgument, "LifecycleConfiguration is not valid, expected CreateBucketConfiguration") {
@@ -309,14 +325,14 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 		lifecycleConfigurationExtraRetryDelay    = 5 * time.Second
 		lifecycleConfigurationRulesSteadyTimeout = 2 * time.Minute
 	)
-	var lastOutput, output []types.LifecycleRule
+	var lastOutput, output *s3.GetBucketLifecycleConfigurationOutput
 
 	err = retry.RetryContext(ctx, lifecycleConfigurationRulesSteadyTimeout, func() *retry.RetryError {
 		var err error
 
 		time.Sleep(lifecycleConfigurationExtraRetryDelay)
 
-		output, err = findLifecycleRules(ctx, conn, bucket, expectedBucketOwner)
+		output, err = findBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner)
 
 		if d.IsNewResource() && tfresource.NotFound(err) {
 			return retry.RetryableError(err)
@@ -326,7 +342,7 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 			return retry.NonRetryableError(err)
 		}
 
-		if lastOutput == nil || !lifecycleRulesEqual(lastOutput, output) {
+		if lastOutput == nil || !lifecycleRulesEqual(lastOutput.Rules, output.Rules) {
 			lastOutput = output
 			return retry.RetryableError(fmt.Errorf("S3 Bucket Lifecycle Configuration (%s) has not stablized; retrying", d.Id()))
 		}
@@ -335,7 +351,7 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 	})
 
 	if tfresource.TimedOut(err) {
-		output, err = findLifecycleRules(ctx, conn, bucket, expectedBucketOwner)
+		output, err = findBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner)
 	}
 
 	if !d.IsNewResource() && tfresource.NotFound(err) {
@@ -350,9 +366,12 @@ func resourceBucketLifecycleConfigurationRead(ctx context.Context, d *schema.Res
 
 	d.Set(names.AttrBucket, bucket)
 	d.Set(names.AttrExpectedBucketOwner, expectedBucketOwner)
-	if err := d.Set(names.AttrRule, flattenLifecycleRules(ctx, output)); err != nil {
+	// S3 doesn't preserve the order of rules, so return them in the configured order.
+	rules := sortLifecycleRulesByID(output.Rules, d.Get(names.AttrRule).([]interface{}))
+	if err := d.Set(names.AttrRule, flattenLifecycleRules(ctx, rules)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
 	}
+	d.Set("transition_default_minimum_object_size", transitionDefaultMinimumObjectSize(output.ResultMetadata))
 
 	return diags
 }
@@ -377,8 +396,13 @@ func resourceBucketLifecycleConfigurationUpdate(ctx context.Context, d *schema.R
 		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
 	}
 
+	var optFns []func(*s3.Options)
+	if v, ok := d.GetOk("transition_default_minimum_object_size"); ok {
+		optFns = append(optFns, withTransitionDefaultMinimumObjectSize(v.(string)))
+	}
+
 	_, err = tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (interface{}, error) {
-		return conn.PutBucketLifecycleConfiguration(ctx, input)
+		return conn.PutBucketLifecycleConfiguration(ctx, input, optFns...)
 	}, errCodeNoSuchLifecycleConfiguration)
 
 	if err != nil {
@@ -455,6 +479,16 @@ func suppressMissingFilterConfigurationBlock(k, old, new string, d *schema.Resou
 }
 
 func findLifecycleRules(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) ([]types.LifecycleRule, error) {
+	output, err := findBucketLifecycleConfiguration(ctx, conn, bucket, expectedBucketOwner)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output.Rules, nil
+}
+
+func findBucketLifecycleConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) (*s3.GetBucketLifecycleConfigurationOutput, error) {
 	input := &s3.GetBucketLifecycleConfigurationInput{
 		Bucket: aws.String(bucket),
 	}
@@ -479,7 +513,7 @@ func findLifecycleRules(ctx context.Context, conn *s3.Client, bucket, expectedBu
 		return nil, tfresource.NewEmptyResultError(input)
 	}
 
-	return output.Rules, nil
+	return output, nil
 }
 
 func lifecycleRulesEqual(rules1, rules2 []types.LifecycleRule) bool {
@@ -546,6 +580,188 @@ func lifecycleRuleStatus_Values() []string {
 	}
 }
 
+// The transition default minimum object size isn't supported by the pinned AWS SDK for Go v2 S3 client,
+// so it is sent and read via its HTTP header.
+const (
+	headerTransitionDefaultMinimumObjectSize = "x-amz-transition-default-minimum-object-size"
+)
+
+const (
+	transitionDefaultMinimumObjectSizeAllStorageClasses128K = "all_storage_classes_128K"
+	transitionDefaultMinimumObjectSizeVariesByStorageClass  = "varies_by_storage_class"
+)
+
+func transitionDefaultMinimumObjectSize_Values() []string {
+	return []string{
+		transitionDefaultMinimumObjectSizeAllStorageClasses128K,
+		transitionDefaultMinimumObjectSizeVariesByStorageClass,
+	}
+}
+
+func withTransitionDefaultMinimumObjectSize(v string) func(*s3.Options) {
+	return func(o *s3.Options) {
+		o.APIOptions = append(o.APIOptions, func(stack *middleware.Stack) error {
+			return stack.Build.Add(middleware.BuildMiddlewareFunc(
+				"SetTransitionDefaultMinimumObjectSize",
+				func(ctx context.Context, in middleware.BuildInput, next middleware.BuildHandler) (middleware.BuildOutput, middleware.Metadata, error) {
+					if req, ok := in.Request.(*smithyhttp.Request); ok {
+						req.Header.Set(headerTransitionDefaultMinimumObjectSize, v)
+					}
+
+					return next.HandleBuild(ctx, in)
+				},
+			), middleware.After)
+		})
+	}
+}
+
+func transitionDefaultMinimumObjectSize(metadata middleware.Metadata) string {
+	if resp, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok {
+		return resp.Header.Get(headerTransitionDefaultMinimumObjectSize)
+	}
+
+	return ""
+}
+
+// sortLifecycleRulesByID orders rules as they appear in the configuration.
+// Rules that aren't configured retain their relative order after the configured rules.
+func sortLifecycleRulesByID(rules []types.LifecycleRule, tfList []interface{}) []types.LifecycleRule {
+	positions := make(map[string]int, len(tfList))
+	for i, tfMapRaw := range tfList {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			positions[tfMap[names.AttrID].(string)] = i
+		}
+	}
+
+	position := func(rule types.LifecycleRule) int {
+		if v, ok := positions[aws.ToString(rule.ID)]; ok {
+			return v
+		}
+
+		return len(tfList)
+	}
+
+	rules = slices.Clone(rules)
+	slices.SortStableFunc(rules, func(a, b types.LifecycleRule) int {
+		return position(a) - position(b)
+	})
+
+	return rules
+}
+
+// bucketLifecycleConfigurationRulesCustomizeDiff catches rule conflicts that S3 would otherwise
+// only report on apply.
+func bucketLifecycleConfigurationRulesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	type prefixRule struct {
+		id      string
+		prefix  string
+		actions []string
+	}
+	var prefixRules []prefixRule
+	ids := make(map[string]struct{})
+
+	for i, tfMapRaw := range d.Get(names.AttrRule).([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		path := fmt.Sprintf("%s.%d", names.AttrRule, i)
+		id := tfMap[names.AttrID].(string)
+
+		if d.NewValueKnown(path + "." + names.AttrID) {
+			if _, ok := ids[id]; ok {
+				return fmt.Errorf("rule ID (%s) is not unique", id)
+			}
+			ids[id] = struct{}{}
+		}
+
+		if err := validLifecycleRuleDays(tfMap); err != nil {
+			return fmt.Errorf("rule (%s): %w", id, err)
+		}
+
+		if tfMap[names.AttrStatus].(string) != lifecycleRuleStatusEnabled {
+			continue
+		}
+
+		if prefix, ok := lifecycleRuleLegacyPrefix(d, path, tfMap); ok {
+			prefixRules = append(prefixRules, prefixRule{
+				id:      id,
+				prefix:  prefix,
+				actions: lifecycleRuleActionTypes(tfMap),
+			})
+		}
+	}
+
+	// S3 rejects enabled rule-level prefixes that overlap and specify the same action type.
+	for i, rule1 := range prefixRules {
+		for _, rule2 := range prefixRules[i+1:] {
+			if !strings.HasPrefix(rule1.prefix, rule2.prefix) && !strings.HasPrefix(rule2.prefix, rule1.prefix) {
+				continue
+			}
+
+			for _, action := range rule1.actions {
+				if slices.Contains(rule2.actions, action) {
+					return fmt.Errorf("rules (%s) and (%s) have overlapping prefixes (%q and %q) for the same action type (%s)", rule1.id, rule2.id, rule1.prefix, rule2.prefix, action)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// lifecycleRuleLegacyPrefix returns the rule's deprecated rule-level prefix.
+// Such rules are sent without a filter, and S3 rejects them if they overlap for the same action type.
+func lifecycleRuleLegacyPrefix(d *schema.ResourceDiff, path string, tfMap map[string]interface{}) (string, bool) {
+	if v, ok := tfMap[names.AttrFilter].([]interface{}); ok && len(v) > 0 {
+		return "", false
+	}
+
+	v := tfMap[names.AttrPrefix].(string)
+
+	return v, v != "" && d.NewValueKnown(path+"."+names.AttrPrefix)
+}
+
+func lifecycleRuleActionTypes(tfMap map[string]interface{}) []string {
+	var actions []string
+
+	if v, ok := tfMap["abort_incomplete_multipart_upload"].([]interface{}); ok && len(v) > 0 {
+		actions = append(actions, "AbortIncompleteMultipartUpload")
+	}
+	if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 {
+		actions = append(actions, "Expiration")
+	}
+	if v, ok := tfMap["noncurrent_version_expiration"].([]interface{}); ok && len(v) > 0 {
+		actions = append(actions, "NoncurrentVersionExpiration")
+	}
+	if v, ok := tfMap["noncurrent_version_transition"].(*schema.Set); ok && v.Len() > 0 {
+		actions = append(actions, "NoncurrentVersionTransition")
+	}
+	if v, ok := tfMap["transition"].(*schema.Set); ok && v.Len() > 0 {
+		actions = append(actions, "Transition")
+	}
+
+	return actions
+}
+
+// validLifecycleRuleDays checks that objects aren't expired before they are transitioned.
+func validLifecycleRuleDays(tfMap map[string]interface{}) error {
+	if v, ok := tfMap["expiration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		if days := v[0].(map[string]interface{})["days"].(int); days > 0 {
+			if v, ok := tfMap["transition"].(*schema.Set); ok {
+				for _, tfMapRaw := range v.List() {
+					if transitionDays := tfMapRaw.(map[string]interface{})["days"].(int); transitionDays >= days {
+						return fmt.Errorf("expiration days (%d) must be greater than transition days (%d)", days, transitionDays)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandLifecycleRules(ctx context.Context, l []interface{}) []types.LifecycleRule {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -57,6 +57,97 @@ func TestAccS3BucketLifecycleConfiguration_basic(t *testing.T) {
 	})
 }
 
+func TestAccS3BucketLifecycleConfiguration_transitionDefaultMinimumObjectSize(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleConfigurationConfig_transitionDefaultMinimumObjectSize(rName, "varies_by_storage_class"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transition_default_minimum_object_size", "varies_by_storage_class"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccBucketLifecycleConfigurationConfig_transitionDefaultMinimumObjectSize(rName, "all_storage_classes_128K"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "transition_default_minimum_object_size", "all_storage_classes_128K"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketLifecycleConfiguration_ruleValidation(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_twoRules(rName, "one", "logs/", "one", "tmp/"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`rule ID \(one\) is not unique`),
+			},
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_twoLegacyPrefixRules(rName, "logs/", "logs/2024/"),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`overlapping prefixes .* for the same action type \(Expiration\)`),
+			},
+			{
+				Config:      testAccBucketLifecycleConfigurationConfig_expirationBeforeTransition(rName),
+				PlanOnly:    true,
+				ExpectError: regexache.MustCompile(`expiration days \(30\) must be greater than transition days \(30\)`),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketLifecycleConfiguration_ruleOrder(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_lifecycle_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketLifecycleConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleConfigurationConfig_twoRules(rName, "zzz", "logs/", "aaa", "tmp/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketLifecycleConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.id", "zzz"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.id", "aaa"),
+				),
+			},
+			{
+				Config:   testAccBucketLifecycleConfigurationConfig_twoRules(rName, "zzz", "logs/", "aaa", "tmp/"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccS3BucketLifecycleConfiguration_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1138,6 +1229,130 @@ resource "aws_s3_bucket_lifecycle_configuration" "test" {
 `, rName)
 }
 
+func testAccBucketLifecycleConfigurationConfig_transitionDefaultMinimumObjectSize(rName, size string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  transition_default_minimum_object_size = %[2]q
+
+  rule {
+    id     = %[1]q
+    status = "Enabled"
+
+    filter {}
+
+    transition {
+      days          = 30
+      storage_class = "STANDARD_IA"
+    }
+  }
+}
+`, rName, size)
+}
+
+func testAccBucketLifecycleConfigurationConfig_twoRules(rName, id1, prefix1, id2, prefix2 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  rule {
+    id     = %[2]q
+    status = "Enabled"
+
+    filter {
+      prefix = %[3]q
+    }
+
+    expiration {
+      days = 90
+    }
+  }
+
+  rule {
+    id     = %[4]q
+    status = "Enabled"
+
+    filter {
+      prefix = %[5]q
+    }
+
+    expiration {
+      days = 30
+    }
+  }
+}
+`, rName, id1, prefix1, id2, prefix2)
+}
+
+func testAccBucketLifecycleConfigurationConfig_twoLegacyPrefixRules(rName, prefix1, prefix2 string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  rule {
+    id     = "%[1]s-1"
+    prefix = %[2]q
+    status = "Enabled"
+
+    expiration {
+      days = 90
+    }
+  }
+
+  rule {
+    id     = "%[1]s-2"
+    prefix = %[3]q
+    status = "Enabled"
+
+    expiration {
+      days = 30
+    }
+  }
+}
+`, rName, prefix1, prefix2)
+}
+
+func testAccBucketLifecycleConfigurationConfig_expirationBeforeTransition(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_lifecycle_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  rule {
+    id     = %[1]q
+    status = "Enabled"
+
+    filter {}
+
+    expiration {
+      days = 30
+    }
+
+    transition {
+      days          = 30
+      storage_class = "STANDARD_IA"
+    }
+  }
+}
+`, rName)
+}
+
 func testAccBucketLifecycleConfigurationConfig_multipleRulesNoFilterOrPrefix(rName, status string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
@@ -38,6 +39,7 @@ func isDirectoryBucket(bucket string) bool {
 }
 
 // @FrameworkResource("aws_s3_directory_bucket", name="Directory Bucket")
+// @ARNIdentity
 func newDirectoryBucketResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &directoryBucketResource{}
 
@@ -288,6 +290,16 @@ func (r *directoryBucketResource) arn(bucket string) string {
 	return r.RegionalARN("s3express", fmt.Sprintf("bucket/%s", bucket))
 }
 
+func (r *directoryBucketResource) ImportIDFromARN(_ context.Context, arn arn.ARN) (string, error) {
+	parts, err := framework.ARNResourceParts(arn, "s3express", "bucket/*")
+
+	if err != nil {
+		return "", err
+	}
+
+	return parts[0], nil
+}
+
 type directoryBucketResourceModel struct {
 	ARN            types.String                                       `tfsdk:"arn"`
 	Bucket         types.String                                       `tfsdk:"bucket"`
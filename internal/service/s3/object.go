@@ -6,6 +6,8 @@ package s3
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
@@ -142,8 +144,7 @@ func resourceObject() *schema.Resource {
 			},
 			"etag": {
 				Type: schema.TypeString,
-				// This will conflict with SSE-C and SSE-KMS encryption and multi-part upload
-				// if/when it's actually implemented. The Etag then won't match raw-file MD5.
+				// This will conflict with SSE-C and SSE-KMS encryption. The Etag then won't match raw-file MD5.
 				// See http://docs.aws.amazon.com/AmazonS3/latest/API/RESTCommonResponseHeaders.html
 				Optional:      true,
 				Computed:      true,
@@ -241,6 +242,16 @@ func resourceObject() *schema.Resource {
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"upload_part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(int(manager.MinUploadPartSize), int(maxUploadPartSize)),
+			},
 			"version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -464,39 +475,11 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		optFns = append(optFns, func(o *s3.Options) { o.UseARNRegion = true })
 	}
 
-	var body io.ReadSeeker
-
-	if v, ok := d.GetOk(names.AttrSource); ok {
-		source := v.(string)
-		path, err := homedir.Expand(source)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "expanding homedir in source (%s): %s", source, err)
-		}
-		file, err := os.Open(path)
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "opening S3 object source (%s): %s", path, err)
-		}
-
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
-			}
-		}()
-	} else if v, ok := d.GetOk(names.AttrContent); ok {
-		body = strings.NewReader(v.(string))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		// We can't do streaming decoding here (with base64.NewDecoder) because
-		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
-		v, err := itypes.Base64Decode(v.(string))
-		if err != nil {
-			return sdkdiag.AppendFromErr(diags, err)
-		}
-		body = bytes.NewReader(v)
-	} else {
-		body = bytes.NewReader([]byte{})
+	body, closeBody, err := objectBody(d)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
 	}
+	defer closeBody()
 
 	input := &s3.PutObjectInput{
 		Body:   body,
@@ -565,7 +548,8 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.StorageClass = types.StorageClass(v.(string))
 	}
 
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig(ctx)
+
 	tags := tftags.New(ctx, getContextTags(ctx))
 	if ignoreProviderDefaultTags(ctx, d) {
 		tags = tags.RemoveDefaultConfig(defaultTagsConfig)
@@ -588,7 +572,13 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 		input.ChecksumAlgorithm = types.ChecksumAlgorithmCrc32
 	}
 
-	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...))
+	uploader := manager.NewUploader(conn, manager.WithUploaderRequestOptions(optFns...), func(u *manager.Uploader) {
+		u.PartSize = objectUploadPartSize(d)
+
+		if v, ok := d.GetOk("upload_concurrency"); ok {
+			u.Concurrency = v.(int)
+		}
+	})
 
 	if _, err := uploader.Upload(ctx, input); err != nil {
 		return sdkdiag.AppendErrorf(diags, "uploading S3 Object (%s) to Bucket (%s): %s", aws.ToString(input.Key), aws.ToString(input.Bucket), err)
@@ -601,6 +591,142 @@ func resourceObjectUpload(ctx context.Context, d *schema.ResourceData, meta inte
 	return append(diags, resourceObjectRead(ctx, d, meta)...)
 }
 
+// objectBody returns the content to upload, from the source file or the inline content.
+func objectBody(d sdkv2.ResourceDiffer) (io.ReadSeeker, func(), error) {
+	if v, ok := d.GetOk(names.AttrSource); ok {
+		source := v.(string)
+		path, err := homedir.Expand(source)
+		if err != nil {
+			return nil, nil, fmt.Errorf("expanding homedir in source (%s): %w", source, err)
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("opening S3 object source (%s): %w", path, err)
+		}
+
+		return file, func() {
+			err := file.Close()
+			if err != nil {
+				log.Printf("[WARN] Error closing S3 object source (%s): %s", path, err)
+			}
+		}, nil
+	} else if v, ok := d.GetOk(names.AttrContent); ok {
+		return strings.NewReader(v.(string)), func() {}, nil
+	} else if v, ok := d.GetOk("content_base64"); ok {
+		// We can't do streaming decoding here (with base64.NewDecoder) because
+		// the AWS SDK requires an io.ReadSeeker but a base64 decoder can't seek.
+		v, err := itypes.Base64Decode(v.(string))
+		if err != nil {
+			return nil, nil, err
+		}
+		return bytes.NewReader(v), func() {}, nil
+	}
+
+	return bytes.NewReader([]byte{}), func() {}, nil
+}
+
+const (
+	maxUploadPartSize = 5 * 1024 * 1024 * 1024 // 5 GiB
+)
+
+func objectUploadPartSize(d sdkv2.ResourceDiffer) int64 {
+	if v, ok := d.GetOk("upload_part_size"); ok {
+		return int64(v.(int))
+	}
+
+	return manager.DefaultUploadPartSize
+}
+
+// clearMultipartObjectETagDiff clears the diff between a configured MD5 digest
+// and the ETag of an object uploaded in multiple parts, whose ETag is not the MD5 digest
+// of the object but the MD5 digest of the parts' digests followed by the part count.
+// The diff is only cleared if the local content still produces the object's ETag.
+// The local content is hashed at most once per plan.
+func clearMultipartObjectETagDiff(d *schema.ResourceDiff) error {
+	if !d.HasChange("etag") || !d.NewValueKnown("etag") {
+		return nil
+	}
+
+	o, n := d.GetChange("etag")
+	old, new := o.(string), n.(string)
+
+	if old == "" || new == "" || !isMultipartObjectETag(old) {
+		return nil
+	}
+
+	ok, err := localObjectContentHasETag(d, old)
+
+	if err != nil {
+		log.Printf("[WARN] Unable to compute multipart ETag of S3 object content: %s", err)
+		return nil
+	}
+
+	if !ok {
+		return nil
+	}
+
+	return d.Clear("etag")
+}
+
+// localObjectContentHasETag returns whether the configured content produces the specified multipart ETag.
+func localObjectContentHasETag(d *schema.ResourceDiff, etag string) (bool, error) {
+	body, closeBody, err := objectBody(d)
+	if err != nil {
+		return false, err
+	}
+	defer closeBody()
+
+	v, err := multipartObjectETag(body, objectUploadPartSize(d))
+	if err != nil {
+		return false, err
+	}
+
+	return v == etag, nil
+}
+
+func isMultipartObjectETag(etag string) bool {
+	return regexache.MustCompile(`^[0-9a-f]{32}-\d+$`).MatchString(etag)
+}
+
+// multipartObjectETag returns the ETag S3 assigns to content uploaded by the transfer manager with the specified part size.
+func multipartObjectETag(body io.ReadSeeker, partSize int64) (string, error) {
+	size, err := body.Seek(0, io.SeekEnd)
+	if err != nil {
+		return "", err
+	}
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	// Content that fits in a single part is uploaded with PutObject.
+	if size <= partSize {
+		h := md5.New()
+		if _, err := io.Copy(h, body); err != nil {
+			return "", err
+		}
+
+		return hex.EncodeToString(h.Sum(nil)), nil
+	}
+
+	// Mirror the transfer manager's adjustment of the part size for very large objects.
+	if size/partSize >= int64(manager.MaxUploadParts) {
+		partSize = (size / int64(manager.MaxUploadParts)) + 1
+	}
+
+	digests := md5.New()
+	var parts int64
+	for offset := int64(0); offset < size; offset += partSize {
+		h := md5.New()
+		if _, err := io.CopyN(h, body, min(partSize, size-offset)); err != nil {
+			return "", err
+		}
+		digests.Write(h.Sum(nil))
+		parts++
+	}
+
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(digests.Sum(nil)), parts), nil
+}
+
 func setObjectKMSKeyID(ctx context.Context, meta interface{}, d *schema.ResourceData, sseKMSKeyID string) error {
 	// Only set non-default KMS key ID (one that doesn't match default).
 	if sseKMSKeyID != "" {
@@ -633,6 +759,10 @@ func validateMetadataIsLowerCase(v interface{}, k string) (ws []string, errors [
 }
 
 func resourceObjectCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if err := clearMultipartObjectETagDiff(d); err != nil {
+		return err
+	}
+
 	if hasObjectContentChanges(d) {
 		return d.SetNewComputed("version_id")
 	}
@@ -646,7 +646,8 @@ func resourceObjectCopyDoCopy(ctx context.Context, d *schema.ResourceData, meta
 		input.TaggingDirective = types.TaggingDirective(v.(string))
 	}
 
-	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig(ctx)
+
 	tags := tftags.New(ctx, getContextTags(ctx))
 	tags = defaultTagsConfig.MergeTags(tags)
 	if len(tags) > 0 {
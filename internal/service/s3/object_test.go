@@ -11,6 +11,7 @@ import (
 	"io"
 	"os"
 	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -107,6 +108,59 @@ func TestSDKv1CompatibleCleanKey(t *testing.T) {
 	}
 }
 
+func TestMultipartObjectETag(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		content  string
+		partSize int64
+		want     string
+	}{
+		{
+			name:     "single part",
+			content:  "hello world!",
+			partSize: 12,
+			want:     "fc3ff98e8c6a0d3087d515c0473f8677",
+		},
+		{
+			name:     "even parts",
+			content:  "hello world!",
+			partSize: 6,
+			want:     "8ae2532e3bf1d4178d0aa9ca8a20f149-2",
+		},
+		{
+			name:     "short last part",
+			content:  "hello world!",
+			partSize: 5,
+			want:     "bf75f3a0356d639886fa9f195803a660-3",
+		},
+		{
+			name:     "empty",
+			content:  "",
+			partSize: 5,
+			want:     "d41d8cd98f00b204e9800998ecf8427e",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfs3.MultipartObjectETag(strings.NewReader(testCase.content), testCase.partSize)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("MultipartObjectETag(%q, %d) = %v, want %v", testCase.content, testCase.partSize, got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestAccS3Object_basic(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -281,6 +335,39 @@ func TestAccS3Object_source(t *testing.T) {
 	})
 }
 
+func TestAccS3Object_multipartUpload(t *testing.T) {
+	ctx := acctest.Context(t)
+	var obj s3.GetObjectOutput
+	resourceName := "aws_s3_object.object"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	// 11 MiB of content is uploaded in 3 parts of at most 5 MiB.
+	source := testAccObjectCreateTempFile(t, strings.Repeat("0123456789abcdef", 11*1024*1024/16))
+	defer os.Remove(source)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckObjectDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectConfig_multipartUpload(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckObjectExists(ctx, resourceName, &obj),
+					resource.TestMatchResourceAttr(resourceName, "etag", regexache.MustCompile(`^[0-9a-f]{32}-3$`)),
+					resource.TestCheckResourceAttr(resourceName, "upload_concurrency", acctest.Ct2),
+					resource.TestCheckResourceAttr(resourceName, "upload_part_size", "5242880"),
+				),
+			},
+			{
+				Config:   testAccObjectConfig_multipartUpload(rName, source),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccS3Object_content(t *testing.T) {
 	ctx := acctest.Context(t)
 	var obj s3.GetObjectOutput
@@ -2235,6 +2322,24 @@ resource "aws_s3_object" "object" {
 `, rName, source)
 }
 
+func testAccObjectConfig_multipartUpload(rName string, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_object" "object" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "test-key"
+  source = %[2]q
+  etag   = filemd5(%[2]q)
+
+  upload_concurrency = 2
+  upload_part_size   = 5242880
+}
+`, rName, source)
+}
+
 func testAccObjectConfig_contentCharacteristics(rName string, source string) string {
 	return fmt.Sprintf(`
 resource "aws_s3_bucket" "test" {
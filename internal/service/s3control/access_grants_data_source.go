@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3control"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3control/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Access Grants")
+func newAccessGrantsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &accessGrantsDataSource{}
+
+	return d, nil
+}
+
+type accessGrantsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*accessGrantsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_s3control_access_grants"
+}
+
+func (d *accessGrantsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_grants": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[accessGrantEntryModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[accessGrantEntryModel](ctx),
+				},
+			},
+			names.AttrAccountID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+			"application_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"grant_scope": schema.StringAttribute{
+				Optional: true,
+			},
+			"grantee_identifier": schema.StringAttribute{
+				Optional: true,
+			},
+			"grantee_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.GranteeType](),
+				Optional:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"permission": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Permission](),
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *accessGrantsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data accessGrantsDataSourceModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().S3ControlClient(ctx)
+
+	if data.AccountID.ValueString() == "" {
+		data.AccountID = types.StringValue(d.Meta().AccountID)
+	}
+	input := &s3control.ListAccessGrantsInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findAccessGrants(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading S3 Access Grants", err.Error())
+
+		return
+	}
+
+	for i, v := range output {
+		if isNullAccessGrantsLocationConfiguration(v.AccessGrantsLocationConfiguration) {
+			output[i].AccessGrantsLocationConfiguration = nil
+		}
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.AccessGrants)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = data.AccountID
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findAccessGrants(ctx context.Context, conn *s3control.Client, input *s3control.ListAccessGrantsInput) ([]awstypes.ListAccessGrantEntry, error) {
+	var output []awstypes.ListAccessGrantEntry
+
+	pages := s3control.NewListAccessGrantsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if tfawserr.ErrHTTPStatusCodeEquals(err, http.StatusNotFound) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AccessGrantsList...)
+	}
+
+	return output, nil
+}
+
+type accessGrantsDataSourceModel struct {
+	AccessGrants      fwtypes.ListNestedObjectValueOf[accessGrantEntryModel] `tfsdk:"access_grants"`
+	AccountID         types.String                                           `tfsdk:"account_id"`
+	ApplicationARN    fwtypes.ARN                                            `tfsdk:"application_arn"`
+	GrantScope        types.String                                           `tfsdk:"grant_scope"`
+	GranteeIdentifier types.String                                           `tfsdk:"grantee_identifier"`
+	GranteeType       fwtypes.StringEnum[awstypes.GranteeType]               `tfsdk:"grantee_type"`
+	ID                types.String                                           `tfsdk:"id"`
+	Permission        fwtypes.StringEnum[awstypes.Permission]                `tfsdk:"permission"`
+}
+
+type accessGrantEntryModel struct {
+	AccessGrantARN                    types.String                                                            `tfsdk:"access_grant_arn"`
+	AccessGrantID                     types.String                                                            `tfsdk:"access_grant_id"`
+	AccessGrantsLocationConfiguration fwtypes.ListNestedObjectValueOf[accessGrantsLocationConfigurationModel] `tfsdk:"access_grants_location_configuration"`
+	AccessGrantsLocationID            types.String                                                            `tfsdk:"access_grants_location_id"`
+	ApplicationARN                    types.String                                                            `tfsdk:"application_arn"`
+	CreatedAt                         timetypes.RFC3339                                                       `tfsdk:"created_at"`
+	Grantee                           fwtypes.ListNestedObjectValueOf[granteeModel]                           `tfsdk:"grantee"`
+	GrantScope                        types.String                                                            `tfsdk:"grant_scope"`
+	Permission                        fwtypes.StringEnum[awstypes.Permission]                                 `tfsdk:"permission"`
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3control_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccAccessGrantsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_s3control_access_grants.test"
+	resourceName := "aws_s3control_access_grant.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ControlServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAccessGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessGrantsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrAccountID(dataSourceName, names.AttrAccountID),
+					resource.TestCheckResourceAttr(dataSourceName, "access_grants.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grants.0.access_grant_arn", resourceName, "access_grant_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grants.0.access_grant_id", resourceName, "access_grant_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grants.0.access_grants_location_id", resourceName, "access_grants_location_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "access_grants.0.created_at"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grants.0.grant_scope", resourceName, "grant_scope"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_grants.0.grantee.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(dataSourceName, "access_grants.0.grantee.0.grantee_type", "IAM"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_grants.0.grantee.0.grantee_identifier", "aws_iam_user.test", names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "access_grants.0.permission", "READ"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessGrantsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccAccessGrantConfig_basic(rName), `
+data "aws_s3control_access_grants" "test" {
+  grantee_type       = "IAM"
+  grantee_identifier = aws_s3control_access_grant.test.grantee[0].grantee_identifier
+  permission         = "READ"
+}
+`)
+}
@@ -31,6 +31,9 @@ func TestAccS3ControlAccessGrants_serial(t *testing.T) {
 			"tags":                  testAccAccessGrant_tags,
 			"locationConfiguration": testAccAccessGrant_locationConfiguration,
 		},
+		"GrantsDataSource": {
+			acctest.CtBasic: testAccAccessGrantsDataSource_basic,
+		},
 		"InstanceResourcePolicy": {
 			acctest.CtBasic:      testAccAccessGrantsInstanceResourcePolicy_basic,
 			acctest.CtDisappears: testAccAccessGrantsInstanceResourcePolicy_disappears,
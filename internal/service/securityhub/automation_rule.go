@@ -34,6 +34,7 @@ import (
 
 // @FrameworkResource(name="Automation Rule")
 // @Tags(identifierAttribute="arn")
+// @ARNIdentity
 func newAutomationRuleResource(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &automationRuleResource{}, nil
 }
@@ -20,7 +20,6 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -41,6 +40,7 @@ const dataLakeMutexKey = "aws_securitylake_data_lake"
 
 // @FrameworkResource(name="Data Lake")
 // @Tags(identifierAttribute="arn")
+// @ARNIdentity
 func newDataLakeResource(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &dataLakeResource{}
 
@@ -69,9 +69,6 @@ func (r *dataLakeResource) Schema(ctx context.Context, request resource.SchemaRe
 			"meta_store_manager_role_arn": schema.StringAttribute{
 				CustomType: fwtypes.ARNType,
 				Required:   true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
 			},
 			"s3_bucket_arn":   framework.ARNAttributeComputedOnly(),
 			names.AttrTags:    tftags.TagsAttribute(),
@@ -292,7 +289,7 @@ func (r *dataLakeResource) Update(ctx context.Context, request resource.UpdateRe
 
 	conn := r.Meta().SecurityLakeClient(ctx)
 
-	if !new.Configurations.Equal(old.Configurations) {
+	if !new.Configurations.Equal(old.Configurations) || !new.MetaStoreManagerRoleARN.Equal(old.MetaStoreManagerRoleARN) {
 		input := &securitylake.UpdateDataLakeInput{}
 		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
 		if response.Diagnostics.HasError() {
@@ -309,11 +306,22 @@ func (r *dataLakeResource) Update(ctx context.Context, request resource.UpdateRe
 			return
 		}
 
-		if _, err := waitDataLakeUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+		dataLake, err := waitDataLakeUpdated(ctx, conn, new.ID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
 			response.Diagnostics.AddError(fmt.Sprintf("waiting for Security Lake Data Lake (%s) update", new.ID.ValueString()), err.Error())
 
 			return
 		}
+
+		// Lifecycle transitions and encryption settings are applied per Region; reflect what the API reports.
+		var configuration dataLakeConfigurationModel
+		response.Diagnostics.Append(fwflex.Flatten(ctx, dataLake, &configuration)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		new.Configurations = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &configuration)
 	}
 
 	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
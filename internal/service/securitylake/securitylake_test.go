@@ -49,6 +49,7 @@ func TestAccSecurityLake_serial(t *testing.T) {
 			"accessType":         testAccSubscriber_accessType,
 			acctest.CtBasic:      testAccSubscriber_basic,
 			"customLogs":         testAccSubscriber_customLogSource,
+			"dataSource":         testAccSubscriberDataSource_basic,
 			acctest.CtDisappears: testAccSubscriber_disappears,
 			"multipleSources":    testAccSubscriber_multipleSources,
 			"tags":               testAccSubscriber_tags,
@@ -62,6 +63,7 @@ func TestAccSecurityLake_serial(t *testing.T) {
 			"sqs_basic":          testAccSubscriberNotification_sqs_basic,
 			"apiKeyNameOnly":     testAccSubscriberNotification_https_apiKeyNameOnly,
 			"apiKey":             testAccSubscriberNotification_https_apiKey,
+			"apiKeyValueOnly":    testAccSubscriberNotification_https_apiKeyValueOnly,
 		},
 	}
 
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Subscriber")
+func newSubscriberDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &subscriberDataSource{}, nil
+}
+
+type subscriberDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *subscriberDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_securitylake_subscriber"
+}
+
+func (d *subscriberDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"access_types": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"resource_share_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"resource_share_name": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				Computed: true,
+			},
+			"s3_bucket_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			"subscriber_description": schema.StringAttribute{
+				Computed: true,
+			},
+			"subscriber_endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+			"subscriber_id": schema.StringAttribute{
+				Required: true,
+			},
+			"subscriber_identity": schema.ListAttribute{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[subscriberIdentityModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: map[string]attr.Type{
+						names.AttrExternalID: types.StringType,
+						names.AttrPrincipal:  types.StringType,
+					},
+				},
+			},
+			"subscriber_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"subscriber_status": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *subscriberDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data subscriberDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().SecurityLakeClient(ctx)
+
+	subscriberID := data.SubscriberID.ValueString()
+	subscriber, err := findSubscriberByID(ctx, conn, subscriberID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Security Lake Subscriber (%s)", subscriberID), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, subscriber, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = types.StringValue(subscriberID)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type subscriberDataSourceModel struct {
+	AccessTypes           fwtypes.ListValueOf[types.String]                        `tfsdk:"access_types"`
+	ID                    types.String                                             `tfsdk:"id"`
+	ResourceShareARN      types.String                                             `tfsdk:"resource_share_arn"`
+	ResourceShareName     types.String                                             `tfsdk:"resource_share_name"`
+	RoleARN               types.String                                             `tfsdk:"role_arn"`
+	S3BucketARN           types.String                                             `tfsdk:"s3_bucket_arn"`
+	SubscriberARN         types.String                                             `tfsdk:"arn"`
+	SubscriberDescription types.String                                             `tfsdk:"subscriber_description"`
+	SubscriberEndpoint    types.String                                             `tfsdk:"subscriber_endpoint"`
+	SubscriberID          types.String                                             `tfsdk:"subscriber_id"`
+	SubscriberIdentity    fwtypes.ListNestedObjectValueOf[subscriberIdentityModel] `tfsdk:"subscriber_identity"`
+	SubscriberName        types.String                                             `tfsdk:"subscriber_name"`
+	SubscriberStatus      types.String                                             `tfsdk:"subscriber_status"`
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package securitylake_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccSubscriberDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_securitylake_subscriber.test"
+	resourceName := "aws_securitylake_subscriber.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriberDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSubscriberDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "access_types.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(dataSourceName, "access_types.0", resourceName, "access_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_share_arn", resourceName, "resource_share_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrRoleARN, resourceName, names.AttrRoleARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "s3_bucket_arn", resourceName, "s3_bucket_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subscriber_identity.#", resourceName, "subscriber_identity.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subscriber_identity.0.external_id", resourceName, "subscriber_identity.0.external_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subscriber_name", resourceName, "subscriber_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subscriber_status", resourceName, "subscriber_status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSubscriberDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccSubscriberConfig_basic(rName), `
+data "aws_securitylake_subscriber" "test" {
+  subscriber_id = aws_securitylake_subscriber.test.id
+}
+`)
+}
@@ -9,11 +9,13 @@ import (
 	"fmt"
 	"net/url"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/securitylake"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/securitylake/types"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -91,9 +93,15 @@ func (r *subscriberNotificationResource) Schema(ctx context.Context, req resourc
 									"authorization_api_key_value": schema.StringAttribute{
 										Optional:  true,
 										Sensitive: true,
+										Validators: []validator.String{
+											stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("authorization_api_key_name")),
+										},
 									},
 									names.AttrEndpoint: schema.StringAttribute{
 										Required: true,
+										Validators: []validator.String{
+											stringvalidator.RegexMatches(regexache.MustCompile(`^https?://`), "must be an HTTP or HTTPS URL"),
+										},
 									},
 									"http_method": schema.StringAttribute{
 										CustomType: fwtypes.StringEnumType[awstypes.HttpMethod](),
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/securitylake/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -355,6 +356,28 @@ func testAccSubscriberNotification_https_apiKey(t *testing.T) {
 	})
 }
 
+func testAccSubscriberNotification_https_apiKeyValueOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := randomCustomLogSourceName()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.SecurityLake)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecurityLakeServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSubscriberNotificationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccSubscriberNotificationConfig_https_apiKeyValueOnly(rName, "example-value"),
+				ExpectError: regexache.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
 func testAccCheckSubscriberNotificationDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SecurityLakeClient(ctx)
@@ -611,3 +634,24 @@ resource "aws_apigatewayv2_api" "test" {
 }
 `, rName, keyName, keyValue))
 }
+
+func testAccSubscriberNotificationConfig_https_apiKeyValueOnly(rName, keyValue string) string {
+	return acctest.ConfigCompose(
+		testAccSubscriberNotification_config(rName), fmt.Sprintf(`
+resource "aws_securitylake_subscriber_notification" "test" {
+  subscriber_id = aws_securitylake_subscriber.test.id
+  configuration {
+    https_notification_configuration {
+      endpoint                    = aws_apigatewayv2_api.test.api_endpoint
+      target_role_arn             = aws_iam_role.event_bridge.arn
+      authorization_api_key_value = %[2]q
+    }
+  }
+}
+
+resource "aws_apigatewayv2_api" "test" {
+  name          = %[1]q
+  protocol_type = "HTTP"
+}
+`, rName, keyValue))
+}
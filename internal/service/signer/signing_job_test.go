@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/signer"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -50,6 +51,44 @@ func TestAccSignerSigningJob_basic(t *testing.T) {
 	})
 }
 
+func TestAccSignerSigningJob_triggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_signer_signing_job.test"
+
+	var job1, job2 signer.DescribeSigningJobOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSingerSigningProfile(ctx, t, "AWSLambda-SHA384-ECDSA")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, signer.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSigningJobConfig_triggers(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSigningJobExists(ctx, resourceName, &job1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "triggers.release", "1"),
+					resource.TestCheckResourceAttr(resourceName, "signed_object.#", acctest.Ct1),
+					resource.TestCheckResourceAttrPair(resourceName, "signed_object.0.s3.0.bucket", "aws_s3_bucket.destination", names.AttrBucket),
+				),
+			},
+			{
+				Config: testAccSigningJobConfig_triggers(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSigningJobExists(ctx, resourceName, &job2),
+					testAccCheckSigningJobRecreated(&job1, &job2),
+					resource.TestCheckResourceAttr(resourceName, "triggers.release", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccSigningJobConfig_basic(rName string) string {
 	return fmt.Sprintf(`
 data "aws_caller_identity" "current" {}
@@ -104,6 +143,62 @@ resource "aws_signer_signing_job" "test" {
 `, rName)
 }
 
+func testAccSigningJobConfig_triggers(rName, release string) string {
+	return fmt.Sprintf(`
+resource "aws_signer_signing_profile" "test" {
+  platform_id = "AWSLambda-SHA384-ECDSA"
+}
+
+resource "aws_s3_bucket" "source" {
+  bucket        = "%[1]s-source"
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_versioning" "source" {
+  bucket = aws_s3_bucket.source.id
+  versioning_configuration {
+    status = "Enabled"
+  }
+}
+
+resource "aws_s3_bucket" "destination" {
+  bucket        = "%[1]s"
+  force_destroy = true
+}
+
+resource "aws_s3_object" "source" {
+  # Must have bucket versioning enabled first
+  depends_on = [aws_s3_bucket_versioning.source]
+
+  bucket = aws_s3_bucket.source.bucket
+  key    = "lambdatest.zip"
+  source = "test-fixtures/lambdatest.zip"
+}
+
+resource "aws_signer_signing_job" "test" {
+  profile_name = aws_signer_signing_profile.test.name
+
+  source {
+    s3 {
+      bucket  = aws_s3_object.source.bucket
+      key     = aws_s3_object.source.key
+      version = aws_s3_object.source.version_id
+    }
+  }
+
+  destination {
+    s3 {
+      bucket = aws_s3_bucket.destination.bucket
+    }
+  }
+
+  triggers = {
+    release = %[2]q
+  }
+}
+`, rName, release)
+}
+
 func testAccCheckSigningJobExists(ctx context.Context, res string, job *signer.DescribeSigningJobOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[res]
@@ -127,3 +222,13 @@ func testAccCheckSigningJobExists(ctx context.Context, res string, job *signer.D
 		return nil
 	}
 }
+
+func testAccCheckSigningJobRecreated(before, after *signer.DescribeSigningJobOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.ToString(before.JobId) == aws.ToString(after.JobId) {
+			return fmt.Errorf("Signer Signing Job (%s) not recreated", aws.ToString(before.JobId))
+		}
+
+		return nil
+	}
+}
@@ -230,6 +230,41 @@ func TestAccSignerSigningProfile_signatureValidityPeriod(t *testing.T) {
 	})
 }
 
+func TestAccSignerSigningProfile_notation(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf signer.GetSigningProfileOutput
+	rName := fmt.Sprintf("tf_acc_test_%d", sdkacctest.RandInt())
+	resourceName := "aws_signer_signing_profile.test_sp"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckSingerSigningProfile(ctx, t, "Notation-OCI-SHA384-ECDSA")
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, signer.ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSigningProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSigningProfileConfig_notation(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSigningProfileExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "platform_id", "Notation-OCI-SHA384-ECDSA"),
+					resource.TestCheckResourceAttr(resourceName, "signature_validity_period.#", acctest.Ct1),
+					resource.TestCheckResourceAttr(resourceName, "signature_validity_period.0.type", "MONTHS"),
+					resource.TestCheckResourceAttr(resourceName, "signature_validity_period.0.value", "135"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccPreCheckSingerSigningProfile(ctx context.Context, t *testing.T, platformID string) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).SignerClient(ctx)
 
@@ -369,3 +404,17 @@ resource "aws_signer_signing_profile" "test_sp" {
 }
 `, rName)
 }
+
+func testAccSigningProfileConfig_notation(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_signer_signing_profile" "test_sp" {
+  platform_id = "Notation-OCI-SHA384-ECDSA"
+  name        = %[1]q
+
+  signature_validity_period {
+    value = 135
+    type  = "MONTHS"
+  }
+}
+`, rName)
+}
@@ -37,6 +37,7 @@ const (
 
 // @FrameworkResource(name="Rotation")
 // @Tags(identifierAttribute="arn")
+// @ARNIdentity
 func newResourceRotation(context.Context) (resource.ResourceWithConfigure, error) {
 	r := &resourceRotation{}
 
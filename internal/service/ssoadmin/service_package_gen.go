@@ -56,6 +56,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			Factory: newResourceTrustedTokenIssuer,
 			Name:    "Trusted Token Issuer",
 			Tags:    &types.ServicePackageResourceTags{},
+			Identity: &types.ServicePackageResourceIdentity{
+				ARNAttribute: names.AttrARN,
+			},
 		},
 	}
 }
@@ -38,6 +38,7 @@ import (
 
 // @FrameworkResource(name="Trusted Token Issuer")
 // @Tags
+// @ARNIdentity
 func newResourceTrustedTokenIssuer(_ context.Context) (resource.ResourceWithConfigure, error) {
 	return &resourceTrustedTokenIssuer{}, nil
 }
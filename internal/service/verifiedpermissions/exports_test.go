@@ -5,6 +5,7 @@ package verifiedpermissions
 
 // Exports for use in tests only.
 var (
+	ResourcePolicies       = newResourcePolicies
 	ResourcePolicy         = newResourcePolicy
 	ResourcePolicyStore    = newResourcePolicyStore
 	ResourcePolicyTemplate = newResourcePolicyTemplate
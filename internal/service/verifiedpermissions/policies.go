@@ -0,0 +1,398 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/verifiedpermissions"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/verifiedpermissions/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(aws_verifiedpermissions_policies, name="Policies")
+func newResourcePolicies(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourcePolicies{}
+
+	return r, nil
+}
+
+const (
+	ResNamePolicies = "Policies"
+)
+
+type resourcePolicies struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *resourcePolicies) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_verifiedpermissions_policies"
+}
+
+func (r *resourcePolicies) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policy_ids": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"policy_store_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"statements": schema.MapAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.ValueStringsAre(cedarPolicyStatement()),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourcePolicies) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	var plan resourcePoliciesData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID := plan.PolicyStoreID.ValueString()
+	statements := fwflex.ExpandFrameworkStringValueMap(ctx, plan.Statements)
+	policyIDs := make(map[string]string, len(statements))
+
+	for k, statement := range statements {
+		policyID, err := createStaticPolicy(ctx, conn, policyStoreID, statement)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionCreating, ResNamePolicies, policyStoreID, fmt.Errorf("policy (%s): %w", k, err)),
+				err.Error(),
+			)
+			// Record the policies that have been created so that they are tracked.
+			if len(policyIDs) > 0 {
+				plan.ID = plan.PolicyStoreID
+				plan.PolicyIDs = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, policyIDs)
+				plan.Statements = filterStatements(ctx, plan.Statements, policyIDs)
+				resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+			}
+			return
+		}
+
+		policyIDs[k] = policyID
+	}
+
+	plan.ID = plan.PolicyStoreID
+	plan.PolicyIDs = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, policyIDs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourcePolicies) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	var state resourcePoliciesData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID := state.ID.ValueString()
+
+	_, err := findPolicyStoreByID(ctx, conn, policyStoreID)
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNamePolicies, policyStoreID, err),
+			err.Error(),
+		)
+		return
+	}
+
+	policyIDs := fwflex.ExpandFrameworkStringValueMap(ctx, state.PolicyIDs)
+
+	// On import, all static policies in the policy store are managed, keyed by policy ID.
+	if state.PolicyIDs.IsNull() {
+		ids, err := findStaticPolicyIDsByPolicyStoreID(ctx, conn, policyStoreID)
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNamePolicies, policyStoreID, err),
+				err.Error(),
+			)
+			return
+		}
+
+		policyIDs = make(map[string]string, len(ids))
+		for _, v := range ids {
+			policyIDs[v] = v
+		}
+	}
+
+	statements := make(map[string]string, len(policyIDs))
+	for k, policyID := range policyIDs {
+		out, err := findPolicyByID(ctx, conn, policyID, policyStoreID)
+
+		if tfresource.NotFound(err) {
+			delete(policyIDs, k)
+			continue
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionReading, ResNamePolicies, policyStoreID, fmt.Errorf("policy (%s): %w", policyID, err)),
+				err.Error(),
+			)
+			return
+		}
+
+		if v, ok := out.Definition.(*awstypes.PolicyDefinitionDetailMemberStatic); ok && v != nil {
+			statements[k] = aws.ToString(v.Value.Statement)
+		} else {
+			delete(policyIDs, k)
+		}
+	}
+
+	state.PolicyStoreID = state.ID
+	state.PolicyIDs = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, policyIDs)
+	state.Statements = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, statements)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourcePolicies) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	var plan, state resourcePoliciesData
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID := state.ID.ValueString()
+	policyIDs := fwflex.ExpandFrameworkStringValueMap(ctx, state.PolicyIDs)
+	o, n := fwflex.ExpandFrameworkStringValueMap(ctx, state.Statements), fwflex.ExpandFrameworkStringValueMap(ctx, plan.Statements)
+
+	err := func() error {
+		for k, policyID := range policyIDs {
+			if _, ok := n[k]; ok {
+				continue
+			}
+
+			if err := deletePolicy(ctx, conn, policyStoreID, policyID); err != nil {
+				return fmt.Errorf("deleting policy (%s): %w", k, err)
+			}
+
+			delete(policyIDs, k)
+		}
+
+		for k, statement := range n {
+			policyID, ok := policyIDs[k]
+
+			if !ok {
+				v, err := createStaticPolicy(ctx, conn, policyStoreID, statement)
+
+				if err != nil {
+					return fmt.Errorf("creating policy (%s): %w", k, err)
+				}
+
+				policyIDs[k] = v
+
+				continue
+			}
+
+			if statement == o[k] {
+				continue
+			}
+
+			requiresReplace, err := cedarPolicyRequiresReplace(o[k], statement)
+
+			if err != nil {
+				return fmt.Errorf("parsing policy (%s): %w", k, err)
+			}
+
+			if !requiresReplace {
+				input := &verifiedpermissions.UpdatePolicyInput{
+					Definition: &awstypes.UpdatePolicyDefinitionMemberStatic{
+						Value: awstypes.UpdateStaticPolicyDefinition{
+							Statement: aws.String(statement),
+						},
+					},
+					PolicyId:      aws.String(policyID),
+					PolicyStoreId: aws.String(policyStoreID),
+				}
+
+				if _, err := conn.UpdatePolicy(ctx, input); err != nil {
+					return fmt.Errorf("updating policy (%s): %w", k, err)
+				}
+
+				continue
+			}
+
+			// The policy's effect, principal or resource has changed.
+			if err := deletePolicy(ctx, conn, policyStoreID, policyID); err != nil {
+				return fmt.Errorf("deleting policy (%s): %w", k, err)
+			}
+
+			delete(policyIDs, k)
+
+			policyID, err = createStaticPolicy(ctx, conn, policyStoreID, statement)
+
+			if err != nil {
+				return fmt.Errorf("creating policy (%s): %w", k, err)
+			}
+
+			policyIDs[k] = policyID
+		}
+
+		return nil
+	}()
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionUpdating, ResNamePolicies, policyStoreID, err),
+			err.Error(),
+		)
+		// Record the current set of policies so that they are tracked.
+		state.PolicyIDs = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, policyIDs)
+		state.Statements = filterStatements(ctx, state.Statements, policyIDs)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.PolicyIDs = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, policyIDs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourcePolicies) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().VerifiedPermissionsClient(ctx)
+
+	var state resourcePoliciesData
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyStoreID := state.ID.ValueString()
+
+	for k, policyID := range fwflex.ExpandFrameworkStringValueMap(ctx, state.PolicyIDs) {
+		if err := deletePolicy(ctx, conn, policyStoreID, policyID); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.VerifiedPermissions, create.ErrActionDeleting, ResNamePolicies, policyStoreID, fmt.Errorf("policy (%s): %w", k, err)),
+				err.Error(),
+			)
+			return
+		}
+	}
+}
+
+func createStaticPolicy(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, statement string) (string, error) {
+	input := &verifiedpermissions.CreatePolicyInput{
+		ClientToken: aws.String(id.UniqueId()),
+		Definition: &awstypes.PolicyDefinitionMemberStatic{
+			Value: awstypes.StaticPolicyDefinition{
+				Statement: aws.String(statement),
+			},
+		},
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	output, err := conn.CreatePolicy(ctx, input)
+
+	if err != nil {
+		return "", err
+	}
+
+	return aws.ToString(output.PolicyId), nil
+}
+
+func deletePolicy(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID, policyID string) error {
+	input := &verifiedpermissions.DeletePolicyInput{
+		PolicyId:      aws.String(policyID),
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+
+	_, err := conn.DeletePolicy(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil
+	}
+
+	return err
+}
+
+func findStaticPolicyIDsByPolicyStoreID(ctx context.Context, conn *verifiedpermissions.Client, policyStoreID string) ([]string, error) {
+	input := &verifiedpermissions.ListPoliciesInput{
+		Filter: &awstypes.PolicyFilter{
+			PolicyType: awstypes.PolicyTypeStatic,
+		},
+		PolicyStoreId: aws.String(policyStoreID),
+	}
+	var output []string
+
+	pages := verifiedpermissions.NewListPoliciesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Policies {
+			output = append(output, aws.ToString(v.PolicyId))
+		}
+	}
+
+	return output, nil
+}
+
+// filterStatements returns the statements for the specified policies.
+func filterStatements(ctx context.Context, statements types.Map, policyIDs map[string]string) types.Map {
+	m := fwflex.ExpandFrameworkStringValueMap(ctx, statements)
+
+	for k := range m {
+		if _, ok := policyIDs[k]; !ok {
+			delete(m, k)
+		}
+	}
+
+	return fwflex.FlattenFrameworkStringValueMapLegacy(ctx, m)
+}
+
+type resourcePoliciesData struct {
+	ID            types.String `tfsdk:"id"`
+	PolicyIDs     types.Map    `tfsdk:"policy_ids"`
+	PolicyStoreID types.String `tfsdk:"policy_store_id"`
+	Statements    types.Map    `tfsdk:"statements"`
+}
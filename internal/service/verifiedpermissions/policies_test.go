@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfverifiedpermissions "github.com/hashicorp/terraform-provider-aws/internal/service/verifiedpermissions"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccVerifiedPermissionsPolicies_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_verifiedpermissions_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoliciesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_ids.%", acctest.Ct2),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_ids.view"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_ids.write"),
+					resource.TestCheckResourceAttrPair(resourceName, "policy_store_id", "aws_verifiedpermissions_policy_store.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "statements.%", acctest.Ct2),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicies_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_verifiedpermissions_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoliciesExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfverifiedpermissions.ResourcePolicies, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicies_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_verifiedpermissions_policies.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPoliciesConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoliciesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_ids.%", acctest.Ct2),
+				),
+			},
+			{
+				Config: testAccPoliciesConfig_updated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPoliciesExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "policy_ids.%", acctest.Ct2),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_ids.view"),
+					resource.TestCheckResourceAttrSet(resourceName, "policy_ids.delete"),
+					resource.TestCheckResourceAttr(resourceName, "statements.view", "forbid (principal, action == Action::\"view\", resource in Album::\"test_album\");"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVerifiedPermissionsPolicies_invalidStatement(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.VerifiedPermissionsEndpointID)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.VerifiedPermissionsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckPoliciesDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPoliciesConfig_invalidStatement(rName),
+				ExpectError: regexache.MustCompile(`Invalid Cedar Policy Statement`),
+			},
+		},
+	})
+}
+
+func testAccCheckPoliciesDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_verifiedpermissions_policies" {
+				continue
+			}
+
+			for k, policyID := range testAccPoliciesPolicyIDs(rs) {
+				_, err := tfverifiedpermissions.FindPolicyByID(ctx, conn, policyID, rs.Primary.ID)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return create.Error(names.VerifiedPermissions, create.ErrActionCheckingDestroyed, tfverifiedpermissions.ResNamePolicies, rs.Primary.ID, err)
+				}
+
+				return create.Error(names.VerifiedPermissions, create.ErrActionCheckingDestroyed, tfverifiedpermissions.ResNamePolicies, rs.Primary.ID, fmt.Errorf("policy (%s) not destroyed", k))
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckPoliciesExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNamePolicies, name, errors.New("not found"))
+		}
+
+		if rs.Primary.ID == "" {
+			return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNamePolicies, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).VerifiedPermissionsClient(ctx)
+
+		for _, policyID := range testAccPoliciesPolicyIDs(rs) {
+			if _, err := tfverifiedpermissions.FindPolicyByID(ctx, conn, policyID, rs.Primary.ID); err != nil {
+				return create.Error(names.VerifiedPermissions, create.ErrActionCheckingExistence, tfverifiedpermissions.ResNamePolicies, rs.Primary.ID, err)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccPoliciesPolicyIDs(rs *terraform.ResourceState) map[string]string {
+	policyIDs := make(map[string]string)
+
+	for k, v := range rs.Primary.Attributes {
+		if k, ok := strings.CutPrefix(k, "policy_ids."); ok && k != "%" {
+			policyIDs[k] = v
+		}
+	}
+
+	return policyIDs
+}
+
+func testAccPoliciesConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_verifiedpermissions_policy_store" "test" {
+  description = %[1]q
+  validation_settings {
+    mode = "OFF"
+  }
+}
+`, rName)
+}
+
+func testAccPoliciesConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPoliciesConfig_base(rName), `
+resource "aws_verifiedpermissions_policies" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  statements = {
+    view  = "permit (principal, action == Action::\"view\", resource in Album::\"test_album\");"
+    write = "permit (principal, action == Action::\"write\", resource in Album::\"test_album\");"
+  }
+}
+`)
+}
+
+func testAccPoliciesConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccPoliciesConfig_base(rName), `
+resource "aws_verifiedpermissions_policies" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  statements = {
+    view   = "forbid (principal, action == Action::\"view\", resource in Album::\"test_album\");"
+    delete = "permit (principal, action == Action::\"delete\", resource in Album::\"test_album\");"
+  }
+}
+`)
+}
+
+func testAccPoliciesConfig_invalidStatement(rName string) string {
+	return acctest.ConfigCompose(testAccPoliciesConfig_base(rName), `
+resource "aws_verifiedpermissions_policies" "test" {
+  policy_store_id = aws_verifiedpermissions_policy_store.test.id
+
+  statements = {
+    view = "permit (principal, action == Action::\"view\", resource in Album::\"test_album\""
+  }
+}
+`)
+}
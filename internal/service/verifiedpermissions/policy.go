@@ -95,6 +95,9 @@ func (r *resourcePolicy) Schema(ctx context.Context, req resource.SchemaRequest,
 									},
 									"statement": schema.StringAttribute{
 										Required: true,
+										Validators: []validator.String{
+											cedarPolicyStatement(),
+										},
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.RequiresReplaceIf(
 												statementReplaceIf, "Replace cedar statement diff", "Replace cedar statement diff",
@@ -188,28 +191,36 @@ func statementReplaceIf(_ context.Context, req planmodifier.StringRequest, resp
 		return
 	}
 
-	cedarPlan, err := cedar.Tokenize([]byte(req.PlanValue.ValueString()))
+	requiresReplace, err := cedarPolicyRequiresReplace(req.StateValue.ValueString(), req.PlanValue.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(err.Error(), err.Error())
 		return
 	}
 
-	cedarState, err := cedar.Tokenize([]byte(req.StateValue.ValueString()))
+	resp.RequiresReplace = requiresReplace
+}
+
+// cedarPolicyRequiresReplace returns whether a static policy's statement can't be updated in place.
+// A policy's effect, principal and resource can't be changed by UpdatePolicy.
+func cedarPolicyRequiresReplace(old, new string) (bool, error) {
+	cedarPlan, err := cedar.Tokenize([]byte(new))
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
-		return
+		return false, err
+	}
+
+	cedarState, err := cedar.Tokenize([]byte(old))
+	if err != nil {
+		return false, err
 	}
 
 	policyPlan, err := cedar.Parse(cedarPlan)
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
-		return
+		return false, err
 	}
 
 	policyState, err := cedar.Parse(cedarState)
 	if err != nil {
-		resp.Diagnostics.AddError(err.Error(), err.Error())
-		return
+		return false, err
 	}
 
 	var policyPrincipal bool
@@ -227,7 +238,7 @@ func statementReplaceIf(_ context.Context, req planmodifier.StringRequest, resp
 		policyEffect = policyPlan[0].Effect != policyState[0].Effect
 	}
 
-	resp.RequiresReplace = policyEffect || policyResource || policyPrincipal
+	return policyEffect || policyResource || policyPrincipal, nil
 }
 
 const (
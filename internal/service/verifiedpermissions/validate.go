@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"fmt"
+
+	cedar "github.com/cedar-policy/cedar-go/x/exp/parser"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// cedarPolicyStatementValidator validates that a string Attribute's value is a syntactically valid Cedar policy.
+type cedarPolicyStatementValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator cedarPolicyStatementValidator) Description(_ context.Context) string {
+	return "value must be a valid Cedar policy statement"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator cedarPolicyStatementValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (validator cedarPolicyStatementValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	configValue := request.ConfigValue
+
+	if configValue.IsNull() || configValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseCedarPolicy(configValue.ValueString()); err != nil {
+		response.Diagnostics.AddAttributeError(
+			request.Path,
+			"Invalid Cedar Policy Statement",
+			fmt.Sprintf("Attribute %s %s: %s", request.Path, validator.Description(ctx), err),
+		)
+		return
+	}
+}
+
+// cedarPolicyStatement returns a string validator which ensures that any configured
+// attribute value is a single, syntactically valid Cedar policy.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func cedarPolicyStatement() validator.String {
+	return cedarPolicyStatementValidator{}
+}
+
+// parseCedarPolicy parses a string containing a single Cedar policy.
+func parseCedarPolicy(s string) (*cedar.Policy, error) {
+	tokens, err := cedar.Tokenize([]byte(s))
+	if err != nil {
+		return nil, err
+	}
+
+	policies, err := cedar.Parse(tokens)
+	if err != nil {
+		return nil, err
+	}
+
+	if n := len(policies); n != 1 {
+		return nil, fmt.Errorf("expected exactly 1 policy, got %d", n)
+	}
+
+	return &policies[0], nil
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package verifiedpermissions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCedarPolicyStatementValidator(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         types.String
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown String": {
+			val: types.StringUnknown(),
+		},
+		"null String": {
+			val: types.StringNull(),
+		},
+		"valid": {
+			val: types.StringValue(`permit (principal, action == Action::"view", resource in Album:: "test_album");`),
+		},
+		"valid with conditions": {
+			val: types.StringValue(`forbid (principal, action, resource) unless { resource.owner == principal };`),
+		},
+		"missing semicolon": {
+			val:         types.StringValue(`permit (principal, action, resource)`),
+			expectError: true,
+		},
+		"invalid effect": {
+			val:         types.StringValue(`allow (principal, action, resource);`),
+			expectError: true,
+		},
+		"multiple policies": {
+			val:         types.StringValue(`permit (principal, action, resource); forbid (principal, action, resource);`),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			request := validator.StringRequest{
+				Path:           path.Root("test"),
+				PathExpression: path.MatchRoot("test"),
+				ConfigValue:    test.val,
+			}
+			response := validator.StringResponse{}
+			cedarPolicyStatement().ValidateString(ctx, request, &response)
+
+			if got, want := response.Diagnostics.HasError(), test.expectError; got != want {
+				t.Errorf("got error %t, expected %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestCedarPolicyRequiresReplace(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		old, new string
+		expected bool
+	}
+	tests := map[string]testCase{
+		"same": {
+			old: `permit (principal, action == Action::"view", resource in Album::"test_album");`,
+			new: `permit (principal, action == Action::"view", resource in Album::"test_album");`,
+		},
+		"action changed": {
+			old: `permit (principal, action == Action::"view", resource in Album::"test_album");`,
+			new: `permit (principal, action == Action::"write", resource in Album::"test_album");`,
+		},
+		"effect changed": {
+			old:      `permit (principal, action == Action::"view", resource in Album::"test_album");`,
+			new:      `forbid (principal, action == Action::"view", resource in Album::"test_album");`,
+			expected: true,
+		},
+		"resource changed": {
+			old:      `permit (principal, action == Action::"view", resource in Album::"test_album");`,
+			new:      `permit (principal, action == Action::"view", resource in Album::"other_album");`,
+			expected: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := cedarPolicyRequiresReplace(test.old, test.new)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != test.expected {
+				t.Errorf("got %t, expected %t", got, test.expected)
+			}
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/url"
 	"reflect"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -33,6 +34,10 @@ const (
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
 	Tags KeyValueTags
+	// CaseInsensitiveKeys causes a resource tag to override any default tag whose key differs only by case.
+	CaseInsensitiveKeys bool
+	// ExcludedResources holds the type names of resources that default tags are not applied to.
+	ExcludedResources []string
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -56,14 +61,29 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 	return dc.Tags
 }
 
+// ForResource returns the DefaultConfig to use for the given resource type name.
+// Returns nil if the resource type is excluded from default tagging.
+func (dc *DefaultConfig) ForResource(typeName string) *DefaultConfig {
+	if dc == nil || slices.Contains(dc.ExcludedResources, typeName) {
+		return nil
+	}
+
+	return dc
+}
+
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
+// If CaseInsensitiveKeys is set, keys are matched without regard to case.
 func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
 	if dc == nil || dc.Tags == nil {
 		return tags
 	}
 
+	if dc.CaseInsensitiveKeys {
+		return dc.Tags.IgnoreFold(tags).Merge(tags)
+	}
+
 	return dc.Tags.Merge(tags)
 }
 
@@ -134,6 +154,21 @@ func (tags KeyValueTags) IgnoreElasticbeanstalk() KeyValueTags {
 	return result
 }
 
+// IgnoreFold returns tags whose keys do not match, without regard to case, any of the given tag keys.
+func (tags KeyValueTags) IgnoreFold(ignoreTags KeyValueTags) KeyValueTags {
+	result := make(KeyValueTags)
+
+	for k, v := range tags {
+		if slices.ContainsFunc(ignoreTags.Keys(), func(key string) bool { return strings.EqualFold(k, key) }) {
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
 // IgnorePrefixes returns non-matching tag key prefixes.
 func (tags KeyValueTags) IgnorePrefixes(ignoreTagPrefixes KeyValueTags) KeyValueTags {
 	result := make(KeyValueTags)
@@ -190,6 +190,44 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 				"key6": "value6",
 			},
 		},
+		{
+			name: "keys differing by case",
+			tags: New(ctx, map[string]string{
+				"key1": "value2",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"Key1": "value1",
+					"key3": "value3",
+				}),
+			},
+			want: map[string]string{
+				"Key1": "value1",
+				"key1": "value2",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
+		{
+			name: "keys differing by case overridden",
+			tags: New(ctx, map[string]string{
+				"key1": "value2",
+				"key2": "value2",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"Key1": "value1",
+					"key3": "value3",
+				}),
+				CaseInsensitiveKeys: true,
+			},
+			want: map[string]string{
+				"key1": "value2",
+				"key2": "value2",
+				"key3": "value3",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -203,6 +241,56 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 	}
 }
 
+func TestKeyValueTagsDefaultConfigForResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	defaultConfig := &DefaultConfig{
+		Tags: New(ctx, map[string]string{
+			"key1": "value1",
+		}),
+		ExcludedResources: []string{"aws_secretsmanager_secret"},
+	}
+	testCases := []struct {
+		name          string
+		defaultConfig *DefaultConfig
+		typeName      string
+		want          *DefaultConfig
+	}{
+		{
+			name:          "no config",
+			defaultConfig: nil,
+			typeName:      "aws_vpc",
+			want:          nil,
+		},
+		{
+			name:          "not excluded",
+			defaultConfig: defaultConfig,
+			typeName:      "aws_vpc",
+			want:          defaultConfig,
+		},
+		{
+			name:          "excluded",
+			defaultConfig: defaultConfig,
+			typeName:      "aws_secretsmanager_secret",
+			want:          nil,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.defaultConfig.ForResource(testCase.typeName)
+
+			if got != testCase.want {
+				t.Errorf("got %v, expected %v", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestKeyValueTagsDefaultConfigTagsEqual(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// PolicyConfig contains rules that resource tags must satisfy across all resources.
+type PolicyConfig struct {
+	RequiredKeys []string
+	Rules        []PolicyRule
+}
+
+// PolicyRule constrains the value of a single tag key.
+// A rule only applies when the tag is present.
+type PolicyRule struct {
+	Key           string
+	AllowedValues []string
+	ValueRegex    *regexp.Regexp
+}
+
+// Validate returns an error describing every violation of the policy by the given tags.
+// Returns nil if the tags satisfy the policy or if no policy is configured.
+func (pc *PolicyConfig) Validate(tags KeyValueTags) error {
+	if pc == nil {
+		return nil
+	}
+
+	var errs []error
+
+	for _, key := range pc.RequiredKeys {
+		if !tags.KeyExists(key) {
+			errs = append(errs, fmt.Errorf("required tag %q is missing", key))
+		}
+	}
+
+	for _, rule := range pc.Rules {
+		v := tags.KeyValue(rule.Key)
+
+		if v == nil {
+			continue
+		}
+
+		value := *v
+
+		if len(rule.AllowedValues) > 0 && !slices.Contains(rule.AllowedValues, value) {
+			errs = append(errs, fmt.Errorf("tag %q value %q is not one of the allowed values: %s", rule.Key, value, strings.Join(rule.AllowedValues, ", ")))
+		}
+
+		if rule.ValueRegex != nil && !rule.ValueRegex.MatchString(value) {
+			errs = append(errs, fmt.Errorf("tag %q value %q does not match %q", rule.Key, value, rule.ValueRegex.String()))
+		}
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return fmt.Errorf("tag policy violated: %w", errors.Join(errs...))
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"regexp"
+	"testing"
+)
+
+func TestPolicyConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policyConfig := &PolicyConfig{
+		RequiredKeys: []string{"Owner", "Environment"},
+		Rules: []PolicyRule{
+			{
+				Key:           "Environment",
+				AllowedValues: []string{"dev", "prod"},
+			},
+			{
+				Key:        "Owner",
+				ValueRegex: regexp.MustCompile(`^[a-z]+@example\.com$`),
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		policyConfig *PolicyConfig
+		tags         KeyValueTags
+		wantErr      bool
+	}{
+		{
+			name:         "nil config",
+			policyConfig: nil,
+			tags:         New(ctx, map[string]string{}),
+		},
+		{
+			name:         "empty config",
+			policyConfig: &PolicyConfig{},
+			tags:         New(ctx, map[string]string{"key1": "value1"}),
+		},
+		{
+			name:         "compliant",
+			policyConfig: policyConfig,
+			tags: New(ctx, map[string]string{
+				"Environment": "dev",
+				"Owner":       "team@example.com",
+				"key1":        "value1",
+			}),
+		},
+		{
+			name:         "missing required key",
+			policyConfig: policyConfig,
+			tags: New(ctx, map[string]string{
+				"Owner": "team@example.com",
+			}),
+			wantErr: true,
+		},
+		{
+			name:         "value not allowed",
+			policyConfig: policyConfig,
+			tags: New(ctx, map[string]string{
+				"Environment": "qa",
+				"Owner":       "team@example.com",
+			}),
+			wantErr: true,
+		},
+		{
+			name:         "value does not match regex",
+			policyConfig: policyConfig,
+			tags: New(ctx, map[string]string{
+				"Environment": "prod",
+				"Owner":       "Team",
+			}),
+			wantErr: true,
+		},
+		{
+			name: "rule for absent optional key",
+			policyConfig: &PolicyConfig{
+				Rules: []PolicyRule{
+					{
+						Key:           "CostCenter",
+						AllowedValues: []string{"1234"},
+					},
+				},
+			},
+			tags: New(ctx, map[string]string{"key1": "value1"}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.policyConfig.Validate(testCase.tags)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("got error %v, want error %t", err, want)
+			}
+		})
+	}
+}
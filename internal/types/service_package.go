@@ -17,6 +17,11 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceIdentity represents resource-level identity information.
+type ServicePackageResourceIdentity struct {
+	ARNAttribute string // The attribute containing the resource's ARN.
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
@@ -28,9 +33,10 @@ type ServicePackageFrameworkDataSource struct {
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
 // implemented by a service package.
 type ServicePackageFrameworkResource struct {
-	Factory func(context.Context) (resource.ResourceWithConfigure, error)
-	Name    string
-	Tags    *ServicePackageResourceTags
+	Factory  func(context.Context) (resource.ResourceWithConfigure, error)
+	Name     string
+	Tags     *ServicePackageResourceTags
+	Identity *ServicePackageResourceIdentity
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
@@ -53,6 +53,42 @@ func (t Timestamp) ValidateOnceAWeekWindowFormat() error {
 	return nil
 }
 
+// OnceAWeekWindowContains returns whether the specified time falls within the
+// "ddd:hh24:mi-ddd:hh24:mi" window. The window is interpreted in UTC and may wrap
+// around the end of the week.
+func (t Timestamp) OnceAWeekWindowContains(v time.Time) (bool, error) {
+	if err := t.ValidateOnceAWeekWindowFormat(); err != nil {
+		return false, err
+	}
+
+	val := strings.ToLower(t.String())
+	if val == "" {
+		return true, nil
+	}
+
+	start, end, _ := strings.Cut(val, "-")
+	startMinute, endMinute := minuteOfWeek(start), minuteOfWeek(end)
+	v = v.UTC()
+	minute := int(v.Weekday())*24*60 + v.Hour()*60 + v.Minute()
+
+	if startMinute <= endMinute {
+		return minute >= startMinute && minute < endMinute, nil
+	}
+
+	return minute >= startMinute || minute < endMinute, nil
+}
+
+// minuteOfWeek returns the number of minutes since the start of the week (Sunday 00:00)
+// for a validated "ddd:hh24:mi" value.
+func minuteOfWeek(s string) int {
+	days := map[string]int{"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6}
+
+	var hour, minute int
+	fmt.Sscanf(s[4:], "%d:%d", &hour, &minute) //nolint:errcheck // Format has already been validated.
+
+	return days[s[:3]]*24*60 + hour*60 + minute
+}
+
 // ValidateUTCFormat parses timestamp in RFC3339 format
 func (t Timestamp) ValidateUTCFormat() error {
 	_, err := time.Parse(time.RFC3339, t.String())
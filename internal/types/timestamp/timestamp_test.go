@@ -3,7 +3,10 @@
 
 package timestamp
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestValidateOnceADayWindowFormat(t *testing.T) {
 	t.Parallel()
@@ -96,6 +99,81 @@ func TestValidateOnceAWeekWindowFormat(t *testing.T) {
 	}
 }
 
+func TestOnceAWeekWindowContains(t *testing.T) {
+	t.Parallel()
+	type tc struct {
+		value       string
+		time        string
+		expected    bool
+		expectError bool
+	}
+	tests := map[string]tc{
+		"invalid": {
+			value:       "wed:25:00-wed:26:00",
+			time:        "2024-01-03T10:30:00Z",
+			expectError: true,
+		},
+		"empty": {
+			value:    "",
+			time:     "2024-01-03T10:30:00Z",
+			expected: true,
+		},
+		"within": {
+			value:    "wed:10:00-wed:11:00",
+			time:     "2024-01-03T10:30:00Z",
+			expected: true,
+		},
+		"before": {
+			value: "wed:10:00-wed:11:00",
+			time:  "2024-01-03T09:59:00Z",
+		},
+		"at end": {
+			value: "wed:10:00-wed:11:00",
+			time:  "2024-01-03T11:00:00Z",
+		},
+		"other time zone": {
+			value:    "wed:10:00-wed:11:00",
+			time:     "2024-01-03T12:30:00+02:00",
+			expected: true,
+		},
+		"wraps within": {
+			value:    "sat:22:00-sun:02:00",
+			time:     "2024-01-07T01:00:00Z",
+			expected: true,
+		},
+		"wraps outside": {
+			value: "sat:22:00-sun:02:00",
+			time:  "2024-01-03T10:30:00Z",
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := time.Parse(time.RFC3339, test.time)
+			if err != nil {
+				t.Fatalf("parsing time: %s", err)
+			}
+
+			got, err := New(test.value).OnceAWeekWindowContains(v)
+
+			if err == nil && test.expectError {
+				t.Fatal("expected error, got no error")
+			}
+
+			if err != nil && !test.expectError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if got != test.expected {
+				t.Errorf("got %t, expected %t", got, test.expected)
+			}
+		})
+	}
+}
+
 func TestValidateUTCFormat(t *testing.T) {
 	t.Parallel()
 	type tc struct {